@@ -0,0 +1,126 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"time"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+type EventsCmd struct {
+	Count    int           `kong:"optional,short='n',default='10',help='Number of recent events to show.'"`
+	Follow   bool          `kong:"optional,short='f',help='Follow new events as they occur.'"`
+	Interval time.Duration `kong:"optional,default='2s',help='Interval at which new events are polled when following.'"`
+}
+
+func (cmd *EventsCmd) Run(ctx *Context) error {
+	collection, err := ctx.Client.Events(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The collection is ordered oldest to newest; show the most recent of them and remember every
+	// event so only new events are shown when following.
+	seen := map[string]bool{}
+	refs := []sf.OdataV4IdRef{}
+	for idx, ref := range collection.Members {
+		seen[ref.OdataId] = true
+		if idx >= len(collection.Members)-cmd.Count {
+			refs = append(refs, ref)
+		}
+	}
+
+	if err := cmd.print(ctx, refs, true); err != nil {
+		return err
+	}
+
+	if !cmd.Follow {
+		return nil
+	}
+
+	// Following runs until interrupted rather than for the timeout of a single command.
+	ctx.Context = context.Background()
+
+	ticker := time.NewTicker(cmd.Interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		collection, err := ctx.Client.Events(ctx)
+		if err != nil {
+			return err
+		}
+
+		refs := []sf.OdataV4IdRef{}
+		for _, ref := range collection.Members {
+			if !seen[ref.OdataId] {
+				seen[ref.OdataId] = true
+				refs = append(refs, ref)
+			}
+		}
+
+		if err := cmd.print(ctx, refs, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd *EventsCmd) print(ctx *Context, refs []sf.OdataV4IdRef, headers bool) error {
+	events, err := members[sf.EventV161Event](ctx, refs)
+	if err != nil {
+		return err
+	}
+
+	if ctx.Out.json {
+		// Each event is written on its own so followed events form a stream of JSON objects
+		for _, event := range events {
+			if err := ctx.Out.JSON(event); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if len(events) == 0 && !headers {
+		return nil
+	}
+
+	rows := make([][]string, len(events))
+	for idx, event := range events {
+		rows[idx] = []string{
+			event.EventTimestamp,
+			event.EventId,
+			string(event.MessageSeverity),
+			event.MessageId,
+			event.OriginOfCondition.OdataId,
+			event.Message,
+		}
+	}
+
+	if !headers {
+		return ctx.Out.Rows(rows)
+	}
+
+	return ctx.Out.Table([]string{"TIMESTAMP", "ID", "SEVERITY", "MESSAGE ID", "ORIGIN", "MESSAGE"}, rows, nil)
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// nnf-ctl is the command-line client of the NNF element controller. It manages the storage pools, storage
+// groups, file systems and file shares of the storage service, and shows the status of the drives and
+// fabric, events and metric reports. The element controller is reached at a URL or, for clients on the
+// Rabbit, its Unix domain socket.
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/NearNodeFlash/nnf-ec/pkg/client"
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+)
+
+var cli struct {
+	Url      string        `kong:"optional,env='NNF_EC_URL',help='URL of the element controller. Defaults to the Unix domain socket at ${socket} if it exists, otherwise ${url}.'"`
+	Socket   string        `kong:"optional,env='NNF_EC_SOCKET',help='Unix domain socket of the element controller; used instead of the URL.'"`
+	User     string        `kong:"optional,env='NNF_EC_USER',help='User name for basic authentication.'"`
+	Password string        `kong:"optional,env='NNF_EC_PASSWORD',help='Password for basic authentication.'"`
+	Timeout  time.Duration `kong:"optional,default='5m',help='Time allowed for each command.'"`
+	Output   string        `kong:"optional,short='o',enum='table,json',default='table',help='Output format (table, json).'"`
+
+	Pool    PoolCmd       `kong:"cmd,help='Storage pool commands.'"`
+	Group   GroupCmd      `kong:"cmd,help='Storage group commands.'"`
+	Fs      FileSystemCmd `kong:"cmd,help='File system commands.'"`
+	Share   ShareCmd      `kong:"cmd,help='Exported file share commands.'"`
+	Drive   DriveCmd      `kong:"cmd,help='Show the status of the NVMe drives.'"`
+	Fabric  FabricCmd     `kong:"cmd,help='Show the status of the fabric switches and ports.'"`
+	Events  EventsCmd     `kong:"cmd,help='Show recent events, optionally following new events.'"`
+	Metrics MetricsCmd    `kong:"cmd,help='Fetch telemetry metric reports.'"`
+}
+
+// Context provides the client and output of the CLI to all commands
+type Context struct {
+	context.Context
+	Client *client.Client
+	Out    *Printer
+}
+
+func main() {
+	k := kong.Parse(&cli,
+		kong.Name("nnf-ctl"),
+		kong.Description("Command-line client of the NNF element controller."),
+		kong.UsageOnError(),
+		kong.Vars{"socket": ec.DefaultSocketPath, "url": client.DefaultUrl},
+	)
+
+	opts := []client.Option{}
+	if len(cli.User) != 0 {
+		opts = append(opts, client.WithBasicAuth(cli.User, cli.Password))
+	}
+
+	var c *client.Client
+	switch {
+	case len(cli.Socket) != 0:
+		c = client.NewSocket(cli.Socket, opts...)
+	case len(cli.Url) != 0:
+		c = client.New(cli.Url, opts...)
+	case isSocket(ec.DefaultSocketPath):
+		c = client.NewSocket(ec.DefaultSocketPath, opts...)
+	default:
+		c = client.New(client.DefaultUrl, opts...)
+	}
+
+	ctx := context.Background()
+	if cli.Timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cli.Timeout)
+		defer cancel()
+	}
+
+	err := k.Run(&Context{
+		Context: ctx,
+		Client:  c,
+		Out:     NewPrinter(os.Stdout, cli.Output == "json"),
+	})
+	k.FatalIfErrorf(err)
+}
+
+// isSocket returns true if the path is a Unix domain socket
+func isSocket(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode()&os.ModeSocket != 0
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+type MetricsCmd struct {
+	List MetricsListCmd `kong:"cmd,default='1',help='List the metric reports.'"`
+	Show MetricsShowCmd `kong:"cmd,help='Show the values of a metric report.'"`
+}
+
+type MetricsListCmd struct{}
+
+func (cmd *MetricsListCmd) Run(ctx *Context) error {
+	collection, err := ctx.Client.MetricReports(ctx)
+	if err != nil {
+		return err
+	}
+
+	reports, err := members[sf.MetricReportV140MetricReport](ctx, collection.Members)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, len(reports))
+	for idx, report := range reports {
+		rows[idx] = []string{
+			report.Id,
+			report.Name,
+			timestamp(report.Timestamp),
+			fmt.Sprint(len(report.MetricValues)),
+		}
+	}
+
+	return ctx.Out.Table([]string{"ID", "NAME", "TIMESTAMP", "VALUES"}, rows, reports)
+}
+
+type MetricsShowCmd struct {
+	Id string `kong:"arg,help='Identifier of the metric report.'"`
+}
+
+func (cmd *MetricsShowCmd) Run(ctx *Context) error {
+	report, err := ctx.Client.MetricReport(ctx, cmd.Id)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, len(report.MetricValues))
+	for idx, value := range report.MetricValues {
+		rows[idx] = []string{
+			value.MetricId,
+			value.MetricProperty,
+			value.MetricValue,
+			timestamp(value.Timestamp),
+		}
+	}
+
+	return ctx.Out.Table([]string{"METRIC", "PROPERTY", "VALUE", "TIMESTAMP"}, rows, report)
+}
+
+func timestamp(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,206 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// Printer writes the output of a command as a table or as JSON
+type Printer struct {
+	w    io.Writer
+	json bool
+}
+
+func NewPrinter(w io.Writer, json bool) *Printer {
+	return &Printer{w: w, json: json}
+}
+
+// Table writes the rows under the headers, or the models as JSON
+func (p *Printer) Table(headers []string, rows [][]string, models interface{}) error {
+	if p.json {
+		return p.JSON(models)
+	}
+
+	return p.Rows(append([][]string{headers}, rows...))
+}
+
+// Rows writes the rows without headers, such as rows that follow an earlier table
+func (p *Printer) Rows(rows [][]string) error {
+	tw := tabwriter.NewWriter(p.w, 0, 8, 2, ' ', 0)
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+// Describe writes the properties of the model, one per line with nested properties named by their path,
+// or the model as JSON
+func (p *Printer) Describe(model interface{}) error {
+	if p.json {
+		return p.JSON(model)
+	}
+
+	data, err := json.Marshal(model)
+	if err != nil {
+		return err
+	}
+
+	// Numbers are decoded as written so large capacities are not shown in exponent form
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return err
+	}
+
+	properties := map[string]string{}
+	flatten("", v, properties)
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tw := tabwriter.NewWriter(p.w, 0, 8, 2, ' ', 0)
+	for _, name := range names {
+		fmt.Fprintf(tw, "%s\t%s\n", name, properties[name])
+	}
+
+	return tw.Flush()
+}
+
+func (p *Printer) JSON(v interface{}) error {
+	encoder := json.NewEncoder(p.w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// Printf writes a message; messages are not written for JSON output
+func (p *Printer) Printf(format string, a ...interface{}) {
+	if !p.json {
+		fmt.Fprintf(p.w, format, a...)
+	}
+}
+
+// flatten records the non-empty scalar values of v by their path
+func flatten(prefix string, v interface{}, properties map[string]string) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			name := key
+			if len(prefix) != 0 {
+				name = prefix + "/" + key
+			}
+			flatten(name, value, properties)
+		}
+	case []interface{}:
+		for idx, value := range v {
+			flatten(fmt.Sprintf("%s/%d", prefix, idx), value, properties)
+		}
+	case nil:
+	case string:
+		if len(v) != 0 {
+			properties[prefix] = v
+		}
+	default:
+		properties[prefix] = fmt.Sprint(v)
+	}
+}
+
+// id returns the identifier of the resource referenced by the link
+func id(ref sf.OdataV4IdRef) string {
+	if len(ref.OdataId) == 0 {
+		return "-"
+	}
+
+	return path.Base(ref.OdataId)
+}
+
+func status(s sf.ResourceStatus) string {
+	if len(s.State) == 0 && len(s.Health) == 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%s/%s", s.State, s.Health)
+}
+
+func oemString(oem map[string]interface{}, key string) string {
+	if s, ok := oem[key].(string); ok && len(s) != 0 {
+		return s
+	}
+
+	return "-"
+}
+
+var byteUnits = []struct {
+	suffix string
+	size   int64
+}{
+	{"TiB", 1 << 40}, {"GiB", 1 << 30}, {"MiB", 1 << 20}, {"KiB", 1 << 10},
+	{"TB", 1000 * 1000 * 1000 * 1000}, {"GB", 1000 * 1000 * 1000}, {"MB", 1000 * 1000}, {"KB", 1000},
+	{"B", 1},
+}
+
+// formatBytes returns the size in the largest binary unit in which it is at least one
+func formatBytes(bytes int64) string {
+	for _, unit := range byteUnits[:4] {
+		if bytes >= unit.size {
+			return strconv.FormatFloat(float64(bytes)/float64(unit.size), 'f', 1, 64) + unit.suffix
+		}
+	}
+
+	return strconv.FormatInt(bytes, 10) + "B"
+}
+
+// parseBytes parses a size in bytes with an optional unit, such as 512GiB or 1TB
+func parseBytes(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	for _, unit := range byteUnits {
+		if strings.HasSuffix(s, unit.suffix) {
+			value, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(s, unit.suffix)), 64)
+			if err != nil || value <= 0 {
+				return 0, fmt.Errorf("size %s not valid", s)
+			}
+
+			return int64(value * float64(unit.size)), nil
+		}
+	}
+
+	value, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("size %s not valid", s)
+	}
+
+	return value, nil
+}
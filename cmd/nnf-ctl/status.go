@@ -0,0 +1,139 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+type DriveCmd struct {
+	List     DriveListCmd     `kong:"cmd,default='1',help='List the drives and their status.'"`
+	Describe DriveDescribeCmd `kong:"cmd,help='Describe a drive.'"`
+}
+
+type DriveListCmd struct{}
+
+func (cmd *DriveListCmd) Run(ctx *Context) error {
+	collection, err := ctx.Client.Storages(ctx)
+	if err != nil {
+		return err
+	}
+
+	storages, err := members[sf.StorageV190Storage](ctx, collection.Members)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, len(storages))
+	for idx, storage := range storages {
+		rows[idx] = []string{
+			storage.Id,
+			fmt.Sprint(storage.Location.PartLocation.LocationOrdinalValue),
+			status(storage.Status),
+		}
+	}
+
+	return ctx.Out.Table([]string{"ID", "SLOT", "STATUS"}, rows, storages)
+}
+
+type DriveDescribeCmd struct {
+	Id string `kong:"arg,help='Identifier of the drive.'"`
+}
+
+func (cmd *DriveDescribeCmd) Run(ctx *Context) error {
+	storage, err := ctx.Client.Storage(ctx, cmd.Id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Out.Describe(storage)
+}
+
+type FabricCmd struct{}
+
+// fabricStatus is the JSON output of the fabric command
+type fabricStatus struct {
+	Switches []sf.SwitchV140Switch
+	Ports    []sf.PortV130Port
+}
+
+func (cmd *FabricCmd) Run(ctx *Context) error {
+	collection, err := ctx.Client.Switches(ctx)
+	if err != nil {
+		return err
+	}
+
+	switches, err := members[sf.SwitchV140Switch](ctx, collection.Members)
+	if err != nil {
+		return err
+	}
+
+	fabric := fabricStatus{Switches: switches, Ports: []sf.PortV130Port{}}
+
+	switchRows := make([][]string, len(switches))
+	portRows := [][]string{}
+	for idx, s := range switches {
+		switchRows[idx] = []string{s.Id, s.Model, s.SerialNumber, s.FirmwareVersion, status(s.Status)}
+
+		collection, err := ctx.Client.Ports(ctx, s.Id)
+		if err != nil {
+			return err
+		}
+
+		ports, err := members[sf.PortV130Port](ctx, collection.Members)
+		if err != nil {
+			return err
+		}
+
+		for _, port := range ports {
+			endpoint := "-"
+			if len(port.Links.AssociatedEndpoints) != 0 {
+				endpoint = id(port.Links.AssociatedEndpoints[0])
+			}
+
+			portRows = append(portRows, []string{
+				s.Id,
+				port.Id,
+				port.Name,
+				string(port.PortType),
+				fmt.Sprintf("x%d/x%d", port.ActiveWidth, port.Width),
+				fmt.Sprintf("%g/%g", port.CurrentSpeedGbps, port.MaxSpeedGbps),
+				string(port.LinkStatus),
+				endpoint,
+				status(port.Status),
+			})
+		}
+
+		fabric.Ports = append(fabric.Ports, ports...)
+	}
+
+	if ctx.Out.json {
+		return ctx.Out.JSON(fabric)
+	}
+
+	if err := ctx.Out.Table([]string{"SWITCH", "MODEL", "SERIAL", "FIRMWARE", "STATUS"}, switchRows, nil); err != nil {
+		return err
+	}
+
+	ctx.Out.Printf("\n")
+	return ctx.Out.Table([]string{"SWITCH", "PORT", "NAME", "TYPE", "WIDTH", "SPEED (GBPS)", "LINK", "ENDPOINT", "STATUS"}, portRows, nil)
+}
@@ -0,0 +1,410 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// members returns the resources referenced by the members of a collection
+func members[T any](ctx *Context, refs []sf.OdataV4IdRef) ([]T, error) {
+	models := make([]T, 0, len(refs))
+	for _, ref := range refs {
+		var model T
+		if err := ctx.Client.Do(ctx, http.MethodGet, ref.OdataId, nil, &model); err != nil {
+			return nil, err
+		}
+
+		models = append(models, model)
+	}
+
+	return models, nil
+}
+
+type PoolCmd struct {
+	List     PoolListCmd     `kong:"cmd,help='List the storage pools.'"`
+	Create   PoolCreateCmd   `kong:"cmd,help='Create a storage pool.'"`
+	Describe PoolDescribeCmd `kong:"cmd,help='Describe a storage pool.'"`
+	Delete   PoolDeleteCmd   `kong:"cmd,help='Delete storage pools.'"`
+}
+
+type PoolListCmd struct{}
+
+func (cmd *PoolListCmd) Run(ctx *Context) error {
+	collection, err := ctx.Client.StoragePools(ctx)
+	if err != nil {
+		return err
+	}
+
+	pools, err := members[sf.StoragePoolV150StoragePool](ctx, collection.Members)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, len(pools))
+	for idx, pool := range pools {
+		rows[idx] = []string{
+			pool.Id,
+			formatBytes(pool.CapacityBytes),
+			formatBytes(pool.Capacity.Data.AllocatedBytes),
+			fmt.Sprint(len(pool.Links.StorageGroups)),
+			id(pool.Links.FileSystem),
+			status(pool.Status),
+		}
+	}
+
+	return ctx.Out.Table([]string{"ID", "CAPACITY", "ALLOCATED", "GROUPS", "FILE SYSTEM", "STATUS"}, rows, pools)
+}
+
+type PoolCreateCmd struct {
+	Capacity   string `kong:"required,help='Capacity of the pool in bytes, with an optional unit such as GiB or TB.'"`
+	Policy     string `kong:"optional,default='spare',enum='spare,global,switch-local,compute-local',help='Allocation policy of the pool (spare, global, switch-local, compute-local).'"`
+	Compliance string `kong:"optional,default='strict',enum='strict,relaxed',help='Compliance of the allocation policy (strict, relaxed).'"`
+	Name       string `kong:"optional,help='Name of the pool.'"`
+}
+
+func (cmd *PoolCreateCmd) Run(ctx *Context) error {
+	capacity, err := parseBytes(cmd.Capacity)
+	if err != nil {
+		return err
+	}
+
+	pool, err := ctx.Client.CreateStoragePool(ctx, &sf.StoragePoolV150StoragePool{
+		Name:          cmd.Name,
+		CapacityBytes: capacity,
+		Oem: map[string]interface{}{
+			"Policy":     cmd.Policy,
+			"Compliance": cmd.Compliance,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.Out.Printf("Created storage pool %s\n", pool.Id)
+	return ctx.Out.Describe(pool)
+}
+
+type PoolDescribeCmd struct {
+	Id string `kong:"arg,help='Identifier of the pool.'"`
+}
+
+func (cmd *PoolDescribeCmd) Run(ctx *Context) error {
+	pool, err := ctx.Client.StoragePool(ctx, cmd.Id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Out.Describe(pool)
+}
+
+type PoolDeleteCmd struct {
+	Ids []string `kong:"arg,help='Identifiers of the pools.'"`
+}
+
+func (cmd *PoolDeleteCmd) Run(ctx *Context) error {
+	for _, id := range cmd.Ids {
+		if err := ctx.Client.DeleteStoragePool(ctx, id); err != nil {
+			return fmt.Errorf("delete storage pool %s: %w", id, err)
+		}
+
+		ctx.Out.Printf("Deleted storage pool %s\n", id)
+	}
+
+	return nil
+}
+
+type GroupCmd struct {
+	List     GroupListCmd     `kong:"cmd,help='List the storage groups.'"`
+	Create   GroupCreateCmd   `kong:"cmd,help='Create a storage group, giving a server endpoint access to a storage pool.'"`
+	Describe GroupDescribeCmd `kong:"cmd,help='Describe a storage group.'"`
+	Delete   GroupDeleteCmd   `kong:"cmd,help='Delete storage groups.'"`
+}
+
+type GroupListCmd struct{}
+
+func (cmd *GroupListCmd) Run(ctx *Context) error {
+	collection, err := ctx.Client.StorageGroups(ctx)
+	if err != nil {
+		return err
+	}
+
+	groups, err := members[sf.StorageGroupV150StorageGroup](ctx, collection.Members)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, len(groups))
+	for idx, group := range groups {
+		rows[idx] = []string{
+			group.Id,
+			id(group.Links.StoragePool),
+			id(group.Links.ServerEndpoint),
+			status(group.Status),
+		}
+	}
+
+	return ctx.Out.Table([]string{"ID", "POOL", "ENDPOINT", "STATUS"}, rows, groups)
+}
+
+type GroupCreateCmd struct {
+	Pool     string `kong:"required,help='Identifier of the storage pool.'"`
+	Endpoint string `kong:"required,help='Identifier of the server endpoint.'"`
+}
+
+func (cmd *GroupCreateCmd) Run(ctx *Context) error {
+	pool, err := ctx.Client.StoragePool(ctx, cmd.Pool)
+	if err != nil {
+		return err
+	}
+
+	endpoint, err := ctx.Client.Endpoint(ctx, cmd.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	group, err := ctx.Client.CreateStorageGroup(ctx, &sf.StorageGroupV150StorageGroup{
+		Links: sf.StorageGroupV150Links{
+			StoragePool:    sf.OdataV4IdRef{OdataId: pool.OdataId},
+			ServerEndpoint: sf.OdataV4IdRef{OdataId: endpoint.OdataId},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.Out.Printf("Created storage group %s\n", group.Id)
+	return ctx.Out.Describe(group)
+}
+
+type GroupDescribeCmd struct {
+	Id string `kong:"arg,help='Identifier of the group.'"`
+}
+
+func (cmd *GroupDescribeCmd) Run(ctx *Context) error {
+	group, err := ctx.Client.StorageGroup(ctx, cmd.Id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Out.Describe(group)
+}
+
+type GroupDeleteCmd struct {
+	Ids []string `kong:"arg,help='Identifiers of the groups.'"`
+}
+
+func (cmd *GroupDeleteCmd) Run(ctx *Context) error {
+	for _, id := range cmd.Ids {
+		if err := ctx.Client.DeleteStorageGroup(ctx, id); err != nil {
+			return fmt.Errorf("delete storage group %s: %w", id, err)
+		}
+
+		ctx.Out.Printf("Deleted storage group %s\n", id)
+	}
+
+	return nil
+}
+
+type FileSystemCmd struct {
+	List     FileSystemListCmd     `kong:"cmd,help='List the file systems.'"`
+	Create   FileSystemCreateCmd   `kong:"cmd,help='Create a file system on a storage pool.'"`
+	Describe FileSystemDescribeCmd `kong:"cmd,help='Describe a file system.'"`
+	Delete   FileSystemDeleteCmd   `kong:"cmd,help='Delete file systems.'"`
+}
+
+type FileSystemListCmd struct{}
+
+func (cmd *FileSystemListCmd) Run(ctx *Context) error {
+	collection, err := ctx.Client.FileSystems(ctx)
+	if err != nil {
+		return err
+	}
+
+	fileSystems, err := members[sf.FileSystemV122FileSystem](ctx, collection.Members)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, len(fileSystems))
+	for idx, fs := range fileSystems {
+		rows[idx] = []string{
+			fs.Id,
+			oemString(fs.Oem, "Type"),
+			oemString(fs.Oem, "Name"),
+			id(fs.StoragePool),
+		}
+	}
+
+	return ctx.Out.Table([]string{"ID", "TYPE", "NAME", "POOL"}, rows, fileSystems)
+}
+
+type FileSystemCreateCmd struct {
+	Pool string `kong:"required,help='Identifier of the storage pool.'"`
+	Type string `kong:"required,help='Type of the file system, such as raw, lvm, xfs, gfs2, zfs or lustre.'"`
+	Name string `kong:"required,help='Name of the file system.'"`
+}
+
+func (cmd *FileSystemCreateCmd) Run(ctx *Context) error {
+	pool, err := ctx.Client.StoragePool(ctx, cmd.Pool)
+	if err != nil {
+		return err
+	}
+
+	fs, err := ctx.Client.CreateFileSystem(ctx, &sf.FileSystemV122FileSystem{
+		Links: sf.FileSystemV122Links{
+			StoragePool: sf.OdataV4IdRef{OdataId: pool.OdataId},
+		},
+		Oem: map[string]interface{}{
+			"Type": cmd.Type,
+			"Name": cmd.Name,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.Out.Printf("Created file system %s\n", fs.Id)
+	return ctx.Out.Describe(fs)
+}
+
+type FileSystemDescribeCmd struct {
+	Id string `kong:"arg,help='Identifier of the file system.'"`
+}
+
+func (cmd *FileSystemDescribeCmd) Run(ctx *Context) error {
+	fs, err := ctx.Client.FileSystem(ctx, cmd.Id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Out.Describe(fs)
+}
+
+type FileSystemDeleteCmd struct {
+	Ids []string `kong:"arg,help='Identifiers of the file systems.'"`
+}
+
+func (cmd *FileSystemDeleteCmd) Run(ctx *Context) error {
+	for _, id := range cmd.Ids {
+		if err := ctx.Client.DeleteFileSystem(ctx, id); err != nil {
+			return fmt.Errorf("delete file system %s: %w", id, err)
+		}
+
+		ctx.Out.Printf("Deleted file system %s\n", id)
+	}
+
+	return nil
+}
+
+type ShareCmd struct {
+	List     ShareListCmd     `kong:"cmd,help='List the file shares of a file system.'"`
+	Create   ShareCreateCmd   `kong:"cmd,help='Create a file share, mounting a file system on a server endpoint.'"`
+	Describe ShareDescribeCmd `kong:"cmd,help='Describe a file share.'"`
+	Delete   ShareDeleteCmd   `kong:"cmd,help='Delete file shares.'"`
+}
+
+type ShareListCmd struct {
+	FileSystem string `kong:"arg,help='Identifier of the file system.'"`
+}
+
+func (cmd *ShareListCmd) Run(ctx *Context) error {
+	collection, err := ctx.Client.FileShares(ctx, cmd.FileSystem)
+	if err != nil {
+		return err
+	}
+
+	shares, err := members[sf.FileShareV120FileShare](ctx, collection.Members)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, len(shares))
+	for idx, share := range shares {
+		rows[idx] = []string{
+			share.Id,
+			id(share.Links.Endpoint),
+			share.FileSharePath,
+			status(share.Status),
+		}
+	}
+
+	return ctx.Out.Table([]string{"ID", "ENDPOINT", "PATH", "STATUS"}, rows, shares)
+}
+
+type ShareCreateCmd struct {
+	FileSystem string `kong:"arg,help='Identifier of the file system.'"`
+	Endpoint   string `kong:"required,help='Identifier of the server endpoint.'"`
+	Path       string `kong:"required,help='Path at which the file system is mounted on the server.'"`
+}
+
+func (cmd *ShareCreateCmd) Run(ctx *Context) error {
+	endpoint, err := ctx.Client.Endpoint(ctx, cmd.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	share, err := ctx.Client.CreateFileShare(ctx, cmd.FileSystem, &sf.FileShareV120FileShare{
+		FileSharePath: cmd.Path,
+		Links: sf.FileShareV120Links{
+			Endpoint: sf.OdataV4IdRef{OdataId: endpoint.OdataId},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.Out.Printf("Created file share %s\n", share.Id)
+	return ctx.Out.Describe(share)
+}
+
+type ShareDescribeCmd struct {
+	FileSystem string `kong:"arg,help='Identifier of the file system.'"`
+	Id         string `kong:"arg,help='Identifier of the file share.'"`
+}
+
+func (cmd *ShareDescribeCmd) Run(ctx *Context) error {
+	share, err := ctx.Client.FileShare(ctx, cmd.FileSystem, cmd.Id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Out.Describe(share)
+}
+
+type ShareDeleteCmd struct {
+	FileSystem string   `kong:"arg,help='Identifier of the file system.'"`
+	Ids        []string `kong:"arg,help='Identifiers of the file shares.'"`
+}
+
+func (cmd *ShareDeleteCmd) Run(ctx *Context) error {
+	for _, id := range cmd.Ids {
+		if err := ctx.Client.DeleteFileShare(ctx, cmd.FileSystem, id); err != nil {
+			return fmt.Errorf("delete file share %s: %w", id, err)
+		}
+
+		ctx.Out.Printf("Deleted file share %s\n", id)
+	}
+
+	return nil
+}
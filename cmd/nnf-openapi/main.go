@@ -0,0 +1,52 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// nnf-openapi writes the OpenAPI document describing the routes served by the NNF element controller.
+// The document is generated from the routers of the controller, which are not initialized, so no
+// hardware is required.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	nnfec "github.com/NearNodeFlash/nnf-ec/pkg"
+)
+
+func main() {
+	output := flag.String("o", "", "File to write the document to; standard output if empty")
+	flag.Parse()
+
+	data, err := nnfec.NewController(nnfec.NewMockOptions(false)).OpenAPI().YAML()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "nnf-openapi: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(*output) == 0 {
+		os.Stdout.Write(data)
+		return
+	}
+
+	if err := os.WriteFile(*output, data, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "nnf-openapi: %v\n", err)
+		os.Exit(1)
+	}
+}
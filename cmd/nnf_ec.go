@@ -20,7 +20,11 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"go.uber.org/zap/zapcore"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -53,12 +57,40 @@ func main() {
 
 	c := nnf.NewController(nnfOpts).WithLogger(logger)
 
-	c.Init(ecOpts)
+	// A controller that fails to initialize has not started its managers, so it must not serve requests
+	if err := c.Init(ecOpts); err != nil {
+		logger.Error(err, "nnf-ec: initialization failed")
+		os.Exit(1)
+	}
 
 	if nnfOpts.InitializeAndExit {
 		logger.Info("nnf-ec: hardware initialized, exiting as requested")
 		return
 	}
 
-	c.Run()
+	// Stop gracefully on SIGTERM or SIGINT so requests in flight, such as a storage pool
+	// creation, are allowed to complete before the persistent stores are closed.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	errs := make(chan error, 1)
+	go func() { errs <- c.Run() }()
+
+	select {
+	case err := <-errs:
+		if err != nil {
+			logger.Error(err, "nnf-ec: run failed")
+			os.Exit(1)
+		}
+	case <-ctx.Done():
+		logger.Info("nnf-ec: shutdown requested", "timeout", ecOpts.ShutdownTimeout)
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), ecOpts.ShutdownTimeout)
+		defer cancel()
+
+		if err := c.Shutdown(shutdownCtx); err != nil {
+			logger.Error(err, "nnf-ec: shutdown incomplete")
+			os.Exit(1)
+		}
+	}
 }
@@ -19,6 +19,7 @@ require (
 	github.com/sirupsen/logrus v1.9.3
 	go.chromium.org/luci v0.0.0-20230227223707-c4460eb434d8
 	go.uber.org/zap v1.25.0
+	golang.org/x/crypto v0.42.0
 	golang.org/x/sys v0.36.0
 	gopkg.in/yaml.v2 v2.4.0
 	k8s.io/mount-utils v0.26.8
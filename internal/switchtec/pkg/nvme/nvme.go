@@ -151,12 +151,18 @@ const (
 
 // Status codes
 const (
+	NamespaceNotReady        StatusCode = 0x082
+	FormatInProgress         StatusCode = 0x084
 	NamespaceAlreadyAttached StatusCode = 0x118
 	NamespaceNotAttached     StatusCode = 0x11a
 )
 
 func (sc StatusCode) String() string {
 	switch sc {
+	case NamespaceNotReady:
+		return "Namespace Not Ready"
+	case FormatInProgress:
+		return "Format In Progress"
 	case NamespaceAlreadyAttached:
 		return "Namespace Already Attached"
 	case NamespaceNotAttached:
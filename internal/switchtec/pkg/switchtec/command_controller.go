@@ -21,6 +21,7 @@ package switchtec
 
 import (
 	"sync"
+	"time"
 
 	"go.chromium.org/luci/common/runtime/goroutine"
 )
@@ -53,6 +54,7 @@ type commandController struct {
 	cond      *sync.Cond   // Conditional used to signal when the lock /might/ be available
 	id        goroutine.ID // The is the owning goroutine id
 	count     int          // Current number of nested locks owned by the locker
+	lockedAt  time.Time    // Time the current owner acquired the lock
 
 }
 
@@ -99,7 +101,7 @@ func (c *commandController) Lock() {
 		// Note: Technically this is not needed since the inner-lock controls all the magic;
 		// but it also acts as a useful tool to validate the main recursive locking behavior.
 		c.mainLock.Lock()
-
+		c.lockedAt = time.Now()
 	}
 
 	c.innerLock.Unlock()
@@ -113,9 +115,23 @@ func (c *commandController) Unlock() {
 	// the availablity of controller.
 	c.count--
 	if c.count == 0 {
+		c.lockedAt = time.Time{}
 		c.mainLock.Unlock()
 		c.cond.Signal()
 	}
 
 	c.innerLock.Unlock()
 }
+
+// HeldFor returns how long the current owner has held the lock, or zero if the lock is free.
+// A lock held well beyond the duration of any command indicates a wedged device or owner.
+func (c *commandController) HeldFor() time.Duration {
+	c.innerLock.Lock()
+	defer c.innerLock.Unlock()
+
+	if c.count == 0 {
+		return 0
+	}
+
+	return time.Since(c.lockedAt)
+}
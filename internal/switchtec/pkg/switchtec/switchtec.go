@@ -25,6 +25,7 @@ import (
 	"os"
 	"sort"
 	"syscall"
+	"time"
 
 	"github.com/mattn/go-isatty"
 
@@ -110,6 +111,9 @@ func Open(path string) (*Device, error) {
 func (dev *Device) Lock()   { dev.ctrl.Lock() }
 func (dev *Device) Unlock() { dev.ctrl.Unlock() }
 
+// CommandLockHeldFor returns how long the device command lock has been held, or zero if it is free
+func (dev *Device) CommandLockHeldFor() time.Duration { return dev.ctrl.HeldFor() }
+
 // Close -
 func (dev *Device) Close() error {
 
@@ -0,0 +1,311 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package client provides typed access to the Redfish / Swordfish API of the NNF element controller.
+// Unsuccessful responses are returned as an *ec.ControllerError carrying the status code, cause and
+// extended information of the error response, so the errors of a remote element controller can be
+// inspected with the same functions, such as ec.IsRetryable, as errors of the element controller itself.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+)
+
+const (
+	// Identifiers of the storage service and fabric served by the element controller
+	DefaultStorageServiceId = "NNF"
+	DefaultFabricId         = "Rabbit"
+
+	// URL of an element controller on the local host serving the default port
+	DefaultUrl = "http://localhost:50057"
+
+	defaultRetries       = 3
+	defaultMaxRetryDelay = 30 * time.Second
+
+	// Error responses larger than this are truncated
+	maxErrorResponseSize = 64 * 1024
+)
+
+// Client - A client of an NNF element controller
+type Client struct {
+	url        string
+	httpClient *http.Client
+	header     http.Header
+
+	retries       int
+	maxRetryDelay time.Duration
+
+	storageServiceId string
+	fabricId         string
+}
+
+// Option configures a client
+type Option func(*Client)
+
+// WithHTTPClient sets the http client used to issue requests
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) { c.httpClient = httpClient }
+}
+
+// WithBasicAuth authenticates each request with the user name and password
+func WithBasicAuth(userName, password string) Option {
+	return func(c *Client) {
+		c.header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(userName+":"+password)))
+	}
+}
+
+// WithSessionToken authenticates each request with the token of a Redfish session
+func WithSessionToken(token string) Option {
+	return func(c *Client) { c.header.Set("X-Auth-Token", token) }
+}
+
+// WithRetries sets the number of times a request is retried when the element controller responds with
+// a Retry-After header, such as while it is starting or its storage devices are busy. Retries wait for
+// the delay requested by the element controller, up to the maximum delay.
+func WithRetries(retries int, maxDelay time.Duration) Option {
+	return func(c *Client) { c.retries, c.maxRetryDelay = retries, maxDelay }
+}
+
+// WithStorageServiceId sets the identifier of the storage service
+func WithStorageServiceId(id string) Option {
+	return func(c *Client) { c.storageServiceId = id }
+}
+
+// WithFabricId sets the identifier of the fabric
+func WithFabricId(id string) Option {
+	return func(c *Client) { c.fabricId = id }
+}
+
+// New returns a client of the element controller at the URL, such as http://localhost:50057
+func New(url string, opts ...Option) *Client {
+	c := &Client{
+		url:              strings.TrimSuffix(url, "/"),
+		httpClient:       &http.Client{Timeout: 5 * time.Minute},
+		header:           make(http.Header),
+		retries:          defaultRetries,
+		maxRetryDelay:    defaultMaxRetryDelay,
+		storageServiceId: DefaultStorageServiceId,
+		fabricId:         DefaultFabricId,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// NewSocket returns a client of the element controller serving the Unix domain socket at the path
+func NewSocket(path string, opts ...Option) *Client {
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", path)
+		},
+	}
+
+	return New("http://localhost", append([]Option{WithHTTPClient(&http.Client{Transport: transport})}, opts...)...)
+}
+
+// NewInProcess returns a client that services requests by calling the handler of the initialized element
+// controller directly, without a network connection. It is intended for tests.
+func NewInProcess(c *ec.Controller, opts ...Option) *Client {
+	transport := &InProcessTransport{Handler: c.Handler()}
+
+	return New("http://localhost", append([]Option{WithHTTPClient(&http.Client{Transport: transport})}, opts...)...)
+}
+
+// Do issues the request and decodes the response into the model, if provided. Requests are retried
+// while the element controller responds with a Retry-After header, up to the configured retries.
+func (c *Client) Do(ctx context.Context, method, path string, body, model interface{}) error {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := c.do(ctx, method, path, data, model)
+		if err == nil {
+			return nil
+		}
+
+		retryable, delay := ec.IsRetryable(err)
+		if !retryable || attempt >= c.retries {
+			return err
+		}
+
+		if delay > c.maxRetryDelay {
+			delay = c.maxRetryDelay
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (c *Client) do(ctx context.Context, method, path string, data []byte, model interface{}) error {
+	var body io.Reader
+	if data != nil {
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, body)
+	if err != nil {
+		return err
+	}
+
+	for key, values := range c.header {
+		req.Header[key] = values
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if data != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	rsp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode >= http.StatusMultipleChoices {
+		return responseError(rsp)
+	}
+
+	if model == nil || rsp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	content, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+
+	if len(content) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(content, model); err != nil {
+		return fmt.Errorf("%s %s: response not valid: %w", method, path, err)
+	}
+
+	return nil
+}
+
+// responseError returns the error described by an unsuccessful response
+func responseError(rsp *http.Response) error {
+	e := ec.NewControllerError(rsp.StatusCode)
+
+	content, _ := io.ReadAll(io.LimitReader(rsp.Body, maxErrorResponseSize))
+
+	er := ec.ErrorResponse{}
+	if err := json.Unmarshal(content, &er); err == nil && er.Status != 0 {
+		e.WithCause(er.Cause).WithExtendedInfo(er.ExtendedInfo...)
+		if len(er.Details) != 0 {
+			e.WithError(errors.New(er.Details))
+		}
+	} else if text := strings.TrimSpace(string(content)); len(text) != 0 {
+		e.WithCause(text)
+	}
+
+	if delay, ok := parseRetryAfter(rsp.Header.Get("Retry-After")); ok {
+		e.WithRetryDelay(delay)
+	}
+
+	return e
+}
+
+// parseRetryAfter parses a Retry-After header of either delay seconds or an http date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		delay := time.Until(t)
+		if delay <= 0 {
+			delay = time.Second
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// StatusCode returns the http status code of an error returned by the client, or zero if the error does
+// not describe an unsuccessful response
+func StatusCode(err error) int {
+	var e *ec.ControllerError
+	if errors.As(err, &e) {
+		return e.StatusCode()
+	}
+
+	return 0
+}
+
+// IsNotFound returns true if the error is the response to a request for a resource that does not exist
+func IsNotFound(err error) bool {
+	return StatusCode(err) == http.StatusNotFound
+}
+
+func get[T any](ctx context.Context, c *Client, path string) (*T, error) {
+	model := new(T)
+	if err := c.Do(ctx, http.MethodGet, path, nil, model); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+func create[T any](ctx context.Context, c *Client, path string, body *T) (*T, error) {
+	model := new(T)
+	if err := c.Do(ctx, http.MethodPost, path, body, model); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+func remove(ctx context.Context, c *Client, path string) error {
+	return c.Do(ctx, http.MethodDelete, path, nil, nil)
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	nnfec "github.com/NearNodeFlash/nnf-ec/pkg"
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	nnf "github.com/NearNodeFlash/nnf-ec/pkg/manager-nnf"
+	openapi "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/common"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+func TestInProcess(t *testing.T) {
+	c := nnfec.NewController(nnfec.NewMockOptions(false))
+	if err := c.Init(ec.NewDefaultTestOptions()); err != nil {
+		t.Fatalf("Failed to initialize nnf controller: %v", err)
+	}
+	defer c.Close()
+
+	ctx := context.Background()
+	client := NewInProcess(c)
+
+	ep, err := client.Endpoint(ctx, "0")
+	if err != nil {
+		t.Fatalf("Failed to get endpoint: %v", err)
+	}
+
+	sp, err := client.CreateStoragePool(ctx, &sf.StoragePoolV150StoragePool{
+		CapacityBytes: 1024 * 1024,
+		Oem: openapi.MarshalOem(nnf.AllocationPolicyOem{
+			Policy:     nnf.SpareAllocationPolicyType,
+			Compliance: nnf.RelaxedAllocationComplianceType,
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create storage pool: %v", err)
+	}
+
+	pools, err := client.StoragePools(ctx)
+	if err != nil || pools.MembersodataCount != 1 || pools.Members[0].OdataId != sp.OdataId {
+		t.Errorf("Unexpected storage pools %+v: %v", pools, err)
+	}
+
+	sg, err := client.CreateStorageGroup(ctx, &sf.StorageGroupV150StorageGroup{
+		Links: sf.StorageGroupV150Links{
+			StoragePool:    sf.OdataV4IdRef{OdataId: sp.OdataId},
+			ServerEndpoint: sf.OdataV4IdRef{OdataId: ep.OdataId},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create storage group: %v", err)
+	}
+
+	if err := client.DeleteStorageGroup(ctx, sg.Id); err != nil {
+		t.Errorf("Failed to delete storage group: %v", err)
+	}
+
+	if err := client.DeleteStoragePool(ctx, sp.Id); err != nil {
+		t.Errorf("Failed to delete storage pool: %v", err)
+	}
+
+	// Error responses are returned as controller errors
+	_, err = client.StoragePool(ctx, sp.Id)
+	if !IsNotFound(err) {
+		t.Fatalf("Expected deleted storage pool to be not found: %v", err)
+	}
+
+	var e *ec.ControllerError
+	if !errors.As(err, &e) || len(e.ExtendedInfo()) == 0 || e.ExtendedInfo()[0].MessageId == "" {
+		t.Errorf("Expected error with extended info: %v", err)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			ec.EncodeResponse(nil, ec.NewErrServiceUnavailable().WithRetryDelay(time.Second).WithCause("Storage devices busy"), w)
+			return
+		}
+
+		ec.EncodeResponse(sf.StoragePoolV150StoragePool{Id: "1"}, nil, w)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	client := New(server.URL, WithRetries(3, time.Millisecond))
+	if sp, err := client.StoragePool(ctx, "1"); err != nil || sp.Id != "1" || requests != 3 {
+		t.Errorf("Expected success after retries, got %+v after %d requests: %v", sp, requests, err)
+	}
+
+	atomic.StoreInt32(&requests, 0)
+
+	client = New(server.URL, WithRetries(0, time.Millisecond))
+	_, err := client.StoragePool(ctx, "1")
+	if retryable, delay := ec.IsRetryable(err); !retryable || delay != time.Second || StatusCode(err) != http.StatusServiceUnavailable {
+		t.Errorf("Expected retryable error with delay, got %v", err)
+	}
+}
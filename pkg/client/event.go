@@ -0,0 +1,55 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// Events - Events recorded by the element controller and the subscriptions delivering them
+
+const eventServicePath = "/redfish/v1/EventService"
+
+func (c *Client) Events(ctx context.Context) (*sf.EventCollectionEventCollection, error) {
+	return get[sf.EventCollectionEventCollection](ctx, c, eventServicePath+"/Events")
+}
+
+func (c *Client) Event(ctx context.Context, id string) (*sf.EventV161Event, error) {
+	return get[sf.EventV161Event](ctx, c, eventServicePath+"/Events/"+id)
+}
+
+func (c *Client) Subscriptions(ctx context.Context) (*sf.EventDestinationCollectionEventDestinationCollection, error) {
+	return get[sf.EventDestinationCollectionEventDestinationCollection](ctx, c, eventServicePath+"/Subscriptions")
+}
+
+func (c *Client) Subscription(ctx context.Context, id string) (*sf.EventDestinationV190EventDestination, error) {
+	return get[sf.EventDestinationV190EventDestination](ctx, c, eventServicePath+"/Subscriptions/"+id)
+}
+
+// CreateSubscription subscribes the destination to events, returning the created subscription
+func (c *Client) CreateSubscription(ctx context.Context, subscription *sf.EventDestinationV190EventDestination) (*sf.EventDestinationV190EventDestination, error) {
+	return create(ctx, c, eventServicePath+"/Subscriptions", subscription)
+}
+
+func (c *Client) DeleteSubscription(ctx context.Context, id string) error {
+	return remove(ctx, c, eventServicePath+"/Subscriptions/"+id)
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// Fabric - The PCIe fabric of switches connecting the storage devices to the servers
+
+func (c *Client) fabricPath() string {
+	return fmt.Sprintf("/redfish/v1/Fabrics/%s", c.fabricId)
+}
+
+func (c *Client) Fabric(ctx context.Context) (*sf.FabricV120Fabric, error) {
+	return get[sf.FabricV120Fabric](ctx, c, c.fabricPath())
+}
+
+func (c *Client) Switches(ctx context.Context) (*sf.SwitchCollectionSwitchCollection, error) {
+	return get[sf.SwitchCollectionSwitchCollection](ctx, c, c.fabricPath()+"/Switches")
+}
+
+func (c *Client) Switch(ctx context.Context, id string) (*sf.SwitchV140Switch, error) {
+	return get[sf.SwitchV140Switch](ctx, c, c.fabricPath()+"/Switches/"+id)
+}
+
+func (c *Client) Ports(ctx context.Context, switchId string) (*sf.PortCollectionPortCollection, error) {
+	return get[sf.PortCollectionPortCollection](ctx, c, c.fabricPath()+"/Switches/"+switchId+"/Ports")
+}
+
+func (c *Client) Port(ctx context.Context, switchId, id string) (*sf.PortV130Port, error) {
+	return get[sf.PortV130Port](ctx, c, c.fabricPath()+"/Switches/"+switchId+"/Ports/"+id)
+}
+
+func (c *Client) FabricEndpoints(ctx context.Context) (*sf.EndpointCollectionEndpointCollection, error) {
+	return get[sf.EndpointCollectionEndpointCollection](ctx, c, c.fabricPath()+"/Endpoints")
+}
+
+func (c *Client) FabricEndpoint(ctx context.Context, id string) (*sf.EndpointV150Endpoint, error) {
+	return get[sf.EndpointV150Endpoint](ctx, c, c.fabricPath()+"/Endpoints/"+id)
+}
+
+func (c *Client) Connections(ctx context.Context) (*sf.ConnectionCollectionConnectionCollection, error) {
+	return get[sf.ConnectionCollectionConnectionCollection](ctx, c, c.fabricPath()+"/Connections")
+}
+
+func (c *Client) Connection(ctx context.Context, id string) (*sf.ConnectionV100Connection, error) {
+	return get[sf.ConnectionV100Connection](ctx, c, c.fabricPath()+"/Connections/"+id)
+}
+
+// UpdateConnection patches the connection, returning the updated connection. Element controllers that
+// do not support modifying connections return an error with status 501 Not Implemented.
+func (c *Client) UpdateConnection(ctx context.Context, id string, connection *sf.ConnectionV100Connection) (*sf.ConnectionV100Connection, error) {
+	model := &sf.ConnectionV100Connection{}
+	if err := c.Do(ctx, http.MethodPatch, c.fabricPath()+"/Connections/"+id, connection, model); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
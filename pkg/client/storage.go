@@ -0,0 +1,59 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// Storage - The NVMe storage devices managed by the element controller and their volumes
+
+const storagePath = "/redfish/v1/Storage"
+
+func (c *Client) Storages(ctx context.Context) (*sf.StorageCollectionStorageCollection, error) {
+	return get[sf.StorageCollectionStorageCollection](ctx, c, storagePath)
+}
+
+func (c *Client) Storage(ctx context.Context, id string) (*sf.StorageV190Storage, error) {
+	return get[sf.StorageV190Storage](ctx, c, storagePath+"/"+id)
+}
+
+func (c *Client) StorageControllers(ctx context.Context, storageId string) (*sf.StorageControllerCollectionStorageControllerCollection, error) {
+	return get[sf.StorageControllerCollectionStorageControllerCollection](ctx, c, storagePath+"/"+storageId+"/Controllers")
+}
+
+func (c *Client) Volumes(ctx context.Context, storageId string) (*sf.VolumeCollectionVolumeCollection, error) {
+	return get[sf.VolumeCollectionVolumeCollection](ctx, c, storagePath+"/"+storageId+"/Volumes")
+}
+
+func (c *Client) Volume(ctx context.Context, storageId, id string) (*sf.VolumeV161Volume, error) {
+	return get[sf.VolumeV161Volume](ctx, c, storagePath+"/"+storageId+"/Volumes/"+id)
+}
+
+// CreateVolume creates a volume on the storage device, returning the created volume
+func (c *Client) CreateVolume(ctx context.Context, storageId string, volume *sf.VolumeV161Volume) (*sf.VolumeV161Volume, error) {
+	return create(ctx, c, storagePath+"/"+storageId+"/Volumes", volume)
+}
+
+func (c *Client) DeleteVolume(ctx context.Context, storageId, id string) error {
+	return remove(ctx, c, storagePath+"/"+storageId+"/Volumes/"+id)
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// Storage Service - Storage pools, storage groups, file systems and exported file shares of the NNF
+// storage service
+
+func (c *Client) storageServicePath() string {
+	return fmt.Sprintf("/redfish/v1/StorageServices/%s", c.storageServiceId)
+}
+
+func (c *Client) StorageService(ctx context.Context) (*sf.StorageServiceV150StorageService, error) {
+	return get[sf.StorageServiceV150StorageService](ctx, c, c.storageServicePath())
+}
+
+func (c *Client) StoragePools(ctx context.Context) (*sf.StoragePoolCollectionStoragePoolCollection, error) {
+	return get[sf.StoragePoolCollectionStoragePoolCollection](ctx, c, c.storageServicePath()+"/StoragePools")
+}
+
+func (c *Client) StoragePool(ctx context.Context, id string) (*sf.StoragePoolV150StoragePool, error) {
+	return get[sf.StoragePoolV150StoragePool](ctx, c, c.storageServicePath()+"/StoragePools/"+id)
+}
+
+// CreateStoragePool creates a storage pool, returning the created storage pool
+func (c *Client) CreateStoragePool(ctx context.Context, pool *sf.StoragePoolV150StoragePool) (*sf.StoragePoolV150StoragePool, error) {
+	return create(ctx, c, c.storageServicePath()+"/StoragePools", pool)
+}
+
+func (c *Client) DeleteStoragePool(ctx context.Context, id string) error {
+	return remove(ctx, c, c.storageServicePath()+"/StoragePools/"+id)
+}
+
+func (c *Client) StorageGroups(ctx context.Context) (*sf.StorageGroupCollectionStorageGroupCollection, error) {
+	return get[sf.StorageGroupCollectionStorageGroupCollection](ctx, c, c.storageServicePath()+"/StorageGroups")
+}
+
+func (c *Client) StorageGroup(ctx context.Context, id string) (*sf.StorageGroupV150StorageGroup, error) {
+	return get[sf.StorageGroupV150StorageGroup](ctx, c, c.storageServicePath()+"/StorageGroups/"+id)
+}
+
+// CreateStorageGroup creates a storage group exposing a storage pool to a server endpoint, returning
+// the created storage group
+func (c *Client) CreateStorageGroup(ctx context.Context, group *sf.StorageGroupV150StorageGroup) (*sf.StorageGroupV150StorageGroup, error) {
+	return create(ctx, c, c.storageServicePath()+"/StorageGroups", group)
+}
+
+func (c *Client) DeleteStorageGroup(ctx context.Context, id string) error {
+	return remove(ctx, c, c.storageServicePath()+"/StorageGroups/"+id)
+}
+
+// Endpoints returns the server endpoints of the storage service
+func (c *Client) Endpoints(ctx context.Context) (*sf.EndpointCollectionEndpointCollection, error) {
+	return get[sf.EndpointCollectionEndpointCollection](ctx, c, c.storageServicePath()+"/Endpoints")
+}
+
+func (c *Client) Endpoint(ctx context.Context, id string) (*sf.EndpointV150Endpoint, error) {
+	return get[sf.EndpointV150Endpoint](ctx, c, c.storageServicePath()+"/Endpoints/"+id)
+}
+
+func (c *Client) FileSystems(ctx context.Context) (*sf.FileSystemCollectionFileSystemCollection, error) {
+	return get[sf.FileSystemCollectionFileSystemCollection](ctx, c, c.storageServicePath()+"/FileSystems")
+}
+
+func (c *Client) FileSystem(ctx context.Context, id string) (*sf.FileSystemV122FileSystem, error) {
+	return get[sf.FileSystemV122FileSystem](ctx, c, c.storageServicePath()+"/FileSystems/"+id)
+}
+
+// CreateFileSystem creates a file system on a storage pool, returning the created file system
+func (c *Client) CreateFileSystem(ctx context.Context, fs *sf.FileSystemV122FileSystem) (*sf.FileSystemV122FileSystem, error) {
+	return create(ctx, c, c.storageServicePath()+"/FileSystems", fs)
+}
+
+func (c *Client) DeleteFileSystem(ctx context.Context, id string) error {
+	return remove(ctx, c, c.storageServicePath()+"/FileSystems/"+id)
+}
+
+func (c *Client) fileSharesPath(fileSystemId string) string {
+	return c.storageServicePath() + "/FileSystems/" + fileSystemId + "/ExportedFileShares"
+}
+
+func (c *Client) FileShares(ctx context.Context, fileSystemId string) (*sf.FileShareCollectionFileShareCollection, error) {
+	return get[sf.FileShareCollectionFileShareCollection](ctx, c, c.fileSharesPath(fileSystemId))
+}
+
+func (c *Client) FileShare(ctx context.Context, fileSystemId, id string) (*sf.FileShareV120FileShare, error) {
+	return get[sf.FileShareV120FileShare](ctx, c, c.fileSharesPath(fileSystemId)+"/"+id)
+}
+
+// CreateFileShare exports a file system to a server endpoint, returning the created file share
+func (c *Client) CreateFileShare(ctx context.Context, fileSystemId string, share *sf.FileShareV120FileShare) (*sf.FileShareV120FileShare, error) {
+	return create(ctx, c, c.fileSharesPath(fileSystemId), share)
+}
+
+func (c *Client) DeleteFileShare(ctx context.Context, fileSystemId, id string) error {
+	return remove(ctx, c, c.fileSharesPath(fileSystemId)+"/"+id)
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// Telemetry - Metric reports of the telemetry service
+
+const telemetryServicePath = "/redfish/v1/TelemetryService"
+
+func (c *Client) MetricDefinitions(ctx context.Context) (*sf.MetricDefinitionCollectionMetricDefinitionCollection, error) {
+	return get[sf.MetricDefinitionCollectionMetricDefinitionCollection](ctx, c, telemetryServicePath+"/MetricDefinitions")
+}
+
+func (c *Client) MetricReportDefinitions(ctx context.Context) (*sf.MetricReportDefinitionCollectionMetricReportDefinitionCollection, error) {
+	return get[sf.MetricReportDefinitionCollectionMetricReportDefinitionCollection](ctx, c, telemetryServicePath+"/MetricReportDefinitions")
+}
+
+func (c *Client) MetricReports(ctx context.Context) (*sf.MetricReportCollectionMetricReportCollection, error) {
+	return get[sf.MetricReportCollectionMetricReportCollection](ctx, c, telemetryServicePath+"/MetricReports")
+}
+
+func (c *Client) MetricReport(ctx context.Context, id string) (*sf.MetricReportV140MetricReport, error) {
+	return get[sf.MetricReportV140MetricReport](ctx, c, telemetryServicePath+"/MetricReports/"+id)
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+)
+
+// InProcessTransport is an http.RoundTripper that services requests by calling the handler of an element
+// controller directly
+type InProcessTransport struct {
+	Handler http.Handler
+}
+
+func (t *InProcessTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	req := r.Clone(r.Context())
+	req.RequestURI = r.URL.RequestURI()
+	req.RemoteAddr = "in-process"
+	if req.Body == nil {
+		req.Body = http.NoBody
+	}
+
+	recorder := httptest.NewRecorder()
+	t.Handler.ServeHTTP(recorder, req)
+
+	if r.Body != nil {
+		r.Body.Close()
+	}
+
+	rsp := recorder.Result()
+	rsp.Request = r
+
+	return rsp, nil
+}
@@ -58,6 +58,18 @@ func EncodeResponse(s interface{}, err error, w http.ResponseWriter) {
 	ec.EncodeResponse(s, err, w)
 }
 
+// RunTask - Run the operation as an asynchronous task if the client requested it, otherwise run the
+// operation and encode its response.
+func RunTask(w http.ResponseWriter, r *http.Request, name string, fn ec.TaskFunc) {
+	if ec.Tasks.Start(w, r, name, fn) {
+		return
+	}
+
+	s, err := fn(r.Context())
+
+	EncodeResponse(s, err, w)
+}
+
 const (
 	NamespaceMetadataSignature = 0x54424252 // "RBBT"
 	NamespaceMetadataRevision  = 1
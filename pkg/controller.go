@@ -36,11 +36,15 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	audit "github.com/NearNodeFlash/nnf-ec/pkg/manager-audit"
 	event "github.com/NearNodeFlash/nnf-ec/pkg/manager-event"
 	fabric "github.com/NearNodeFlash/nnf-ec/pkg/manager-fabric"
 	msgreg "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry"
 	nnf "github.com/NearNodeFlash/nnf-ec/pkg/manager-nnf"
 	nvme "github.com/NearNodeFlash/nnf-ec/pkg/manager-nvme"
+	serviceroot "github.com/NearNodeFlash/nnf-ec/pkg/manager-service-root"
+	session "github.com/NearNodeFlash/nnf-ec/pkg/manager-session"
+	task "github.com/NearNodeFlash/nnf-ec/pkg/manager-task"
 	telemetry "github.com/NearNodeFlash/nnf-ec/pkg/manager-telemetry"
 	"github.com/NearNodeFlash/nnf-ec/pkg/persistent"
 )
@@ -60,6 +64,8 @@ type Options struct {
 	InitializeAndExit     bool   // Initialize all controllers then exit without starting the http server (mfg use)
 	deleteUnknownVolumes  bool   // Delete volumes not represented by a storage pool at the end of initialization
 	replaceMissingVolumes bool   // Replace missing volumes in storage pools
+
+	quarantineUnknownVolumes bool // Quarantine volumes not represented by a storage pool at the end of initialization
 }
 
 func (o *Options) DeleteUnknownVolumes() bool {
@@ -70,6 +76,10 @@ func (o *Options) ReplaceMissingVolumes() bool {
 	return o.replaceMissingVolumes
 }
 
+func (o *Options) QuarantineUnknownVolumes() bool {
+	return o.quarantineUnknownVolumes
+}
+
 func newDefaultOptions() *Options {
 	return &Options{mock: false, cli: false, persistence: true}
 }
@@ -89,12 +99,18 @@ func BindFlags(fs *flag.FlagSet) *Options {
 	fs.BoolVar(&opts.InitializeAndExit, "initializeAndExit", opts.InitializeAndExit, "Initialize all hardware controllers, then exit without starting the http server. Useful in hardware bringup")
 	fs.BoolVar(&opts.deleteUnknownVolumes, "deleteUnknownVolumes", opts.deleteUnknownVolumes, "Delete volumes not represented by storage pools")
 	fs.BoolVar(&opts.replaceMissingVolumes, "replaceMissingVolumes", opts.replaceMissingVolumes, "Replace missing volumes in storage pools")
+	fs.BoolVar(&opts.quarantineUnknownVolumes, "quarantineUnknownVolumes", opts.quarantineUnknownVolumes, "Detach and retain volumes not represented by storage pools until deleted or adopted. Overrides deleteUnknownVolumes")
 
 	nvme.BindFlags(fs)
+	session.BindFlags(fs)
+	audit.BindFlags(fs)
 
 	return opts
 }
 
+// The OpenAPI document of the element controller is generated from the routes of its routers
+//go:generate go run ../cmd/nnf-openapi -o ../api/openapi.yaml
+
 // NewController - Create a new NNF Element Controller with the desired mocking behavior
 func NewController(opts *Options) *ec.Controller {
 	if opts == nil {
@@ -129,20 +145,26 @@ func NewController(opts *Options) *ec.Controller {
 		persistent.StorageProvider = persistent.NewJsonFilePersistentStorageProvider(opts.json)
 	}
 
-	return ec.NewController(Name, Port, Version, NewDefaultApiRouters(switchCtrl, nvmeCtrl, nnfCtrl, opts.DeleteUnknownVolumes(), opts.ReplaceMissingVolumes()))
+	return ec.NewController(Name, Port, Version, NewDefaultApiRouters(switchCtrl, nvmeCtrl, nnfCtrl, opts.DeleteUnknownVolumes(), opts.ReplaceMissingVolumes(), opts.QuarantineUnknownVolumes()))
 }
 
 // NewDefaultApiRouters - Create the default set of API routers for the NNF Element Controller
-func NewDefaultApiRouters(switchCtrl fabric.SwitchtecControllerInterface, nvmeCtrl nvme.NvmeController, nnfCtrl nnf.NnfControllerInterface, nnfUnknownVolumes bool, nnfReplaceMissingVolumes bool) ec.Routers {
+func NewDefaultApiRouters(switchCtrl fabric.SwitchtecControllerInterface, nvmeCtrl nvme.NvmeController, nnfCtrl nnf.NnfControllerInterface, nnfUnknownVolumes bool, nnfReplaceMissingVolumes bool, nnfQuarantineUnknownVolumes bool) ec.Routers {
 
 	routers := []ec.Router{
 		fabric.NewDefaultApiRouter(fabric.NewDefaultApiService(), switchCtrl),
 		nvme.NewDefaultApiRouter(nvme.NewDefaultApiService(), nvmeCtrl),
-		nnf.NewDefaultApiRouter(nnf.NewDefaultApiService(nnf.NewDefaultStorageService(nnfUnknownVolumes, nnfReplaceMissingVolumes)), nnfCtrl),
+		nnf.NewDefaultApiRouter(nnf.NewDefaultApiService(nnf.NewDefaultStorageService(nnfUnknownVolumes, nnfReplaceMissingVolumes, nnf.WithQuarantineUnknownVolumes(nnfQuarantineUnknownVolumes))), nnfCtrl),
 		telemetry.NewDefaultApiRouter(telemetry.NewDefaultApiService()),
 		event.NewDefaultApiRouter(event.NewDefaultApiService()),
 		msgreg.NewDefaultApiRouter(msgreg.NewDefaultApiService()),
+		session.NewDefaultApiRouter(session.NewDefaultApiService()),
+		audit.NewDefaultApiRouter(audit.NewDefaultApiService()),
+		task.NewDefaultApiRouter(task.NewDefaultApiService()),
 	}
 
+	// The service root is generated from the routes of all other routers
+	routers = append(routers, serviceroot.NewDefaultApiRouter(serviceroot.NewDefaultApiService(routers)))
+
 	return routers
 }
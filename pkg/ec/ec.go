@@ -29,21 +29,37 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
-	"net/http/httptest"
+	"sync"
 	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
 
 	logr "github.com/go-logr/logr"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+	"github.com/NearNodeFlash/nnf-ec/pkg/tracing"
 )
 
 var (
-	GET_METHOD    = http.MethodGet
-	POST_METHOD   = http.MethodPost
-	PATCH_METHOD  = http.MethodPatch
-	PUT_METHOD    = http.MethodPut
-	DELETE_METHOD = http.MethodDelete
+	GET_METHOD     = http.MethodGet
+	POST_METHOD    = http.MethodPost
+	PATCH_METHOD   = http.MethodPatch
+	PUT_METHOD     = http.MethodPut
+	DELETE_METHOD  = http.MethodDelete
+	OPTIONS_METHOD = http.MethodOptions
+)
+
+// Privilege - A Redfish privilege required to access a route, as defined in the Redfish
+// privilege registry.
+type Privilege string
+
+const (
+	LoginPrivilege               Privilege = "Login"
+	ConfigureComponentsPrivilege Privilege = "ConfigureComponents"
+	ConfigureManagerPrivilege    Privilege = "ConfigureManager"
+	ConfigureUsersPrivilege      Privilege = "ConfigureUsers"
+	ConfigureSelfPrivilege       Privilege = "ConfigureSelf"
 )
 
 // Route -
@@ -52,6 +68,35 @@ type Route struct {
 	Method      string
 	Path        string
 	HandlerFunc http.HandlerFunc
+
+	// Privilege required to access the route. If unset, GET requires Login and all other
+	// methods require ConfigureComponents.
+	Privilege Privilege
+
+	// Model is the type of the request body, such as sf.StoragePoolV150StoragePool{}. If set, the
+	// request body is validated against the model before the handler is called.
+	Model interface{}
+
+	// Response is the type of the response body, such as sf.StoragePoolV150StoragePool{}. It
+	// describes the route in the OpenAPI document of the controller.
+	Response interface{}
+
+	// Properties that must be present in the request body, as paths relative to the body such
+	// as "Links/StoragePool".
+	RequiredProperties []string
+}
+
+// RequiredPrivilege returns the privilege required to access the route
+func (r *Route) RequiredPrivilege() Privilege {
+	if len(r.Privilege) != 0 {
+		return r.Privilege
+	}
+
+	if r.Method == GET_METHOD {
+		return LoginPrivilege
+	}
+
+	return ConfigureComponentsPrivilege
 }
 
 // Routes -
@@ -70,6 +115,33 @@ type Router interface {
 // Routers -
 type Routers []Router
 
+// AuthorizingRouter is optionally implemented by a Router that authorizes requests to the routes of
+// every router in the element controller. Authorize returns an error if the request does not hold the
+// required privilege; the error is encoded as the response.
+type AuthorizingRouter interface {
+	Authorize(r *http.Request, privilege Privilege) error
+}
+
+// MiddlewareRouter is optionally implemented by a Router whose middleware must run ahead of the
+// routes of every router in the element controller, such as authentication.
+type MiddlewareRouter interface {
+	Middleware() mux.MiddlewareFunc
+}
+
+// AuditingRouter is optionally implemented by a Router that records requests to the element controller.
+// Its middleware runs ahead of the drain middleware and the middleware of every MiddlewareRouter, so
+// requests refused while shutting down or rejected by authentication are recorded.
+type AuditingRouter interface {
+	AuditMiddleware() mux.MiddlewareFunc
+}
+
+// MessageRegistryRouter is optionally implemented by the Router maintaining the message registries. It
+// returns the message identified by the registry prefix, such as "Base", and message key with the
+// arguments substituted, and is used to describe errors found by the element controller itself.
+type MessageRegistryRouter interface {
+	Message(registryPrefix, key string, args ...string) (sf.MessageV111Message, bool)
+}
+
 type Logger = logr.Logger
 
 // Controller -
@@ -83,6 +155,11 @@ type Controller struct {
 	options   Options
 	router    *mux.Router
 	processor ControllerProcessor
+	pathLocks pathLocks
+	drain     drain
+	setup     sync.Once
+
+	httpMetrics httpMetrics
 }
 
 func NewController(name string, port int, version string, routers Routers) *Controller {
@@ -95,6 +172,7 @@ func NewController(name string, port int, version string, routers Routers) *Cont
 	}
 }
 
+// WithLogger sets the logger of the controller
 func (ctrl *Controller) WithLogger(log Logger) *Controller {
 	ctrl.Log = log
 	return ctrl
@@ -106,10 +184,33 @@ type Options struct {
 	Port    int
 	Log     bool
 	Verbose bool
+
+	// TLS configuration. When a certificate and key are provided the element controller
+	// serves HTTPS; providing a client CA bundle additionally requires clients to present
+	// a certificate signed by one of the CAs (mutual TLS).
+	TLSCertFile     string
+	TLSKeyFile      string
+	TLSClientCAFile string
+
+	// Time allowed for requests in flight and background tasks to complete when the element
+	// controller is shut down.
+	ShutdownTimeout time.Duration
+
+	// OpenTelemetry collector endpoint, such as http://localhost:4318, to which request spans are
+	// exported using OTLP/HTTP. Spans are not exported if unset.
+	OtlpEndpoint string
+
+	// Unix domain socket on which requests are served in addition to TCP, or instead of TCP if
+	// SocketOnly is set. The socket file is created with the octal SocketMode permissions and, if
+	// provided, owned by SocketGroup.
+	SocketPath  string
+	SocketMode  string
+	SocketGroup string
+	SocketOnly  bool
 }
 
 func NewDefaultOptions() *Options {
-	return &Options{Http: false, Port: 8080, Log: false, Verbose: false}
+	return &Options{Http: false, Port: 8080, Log: false, Verbose: false, ShutdownTimeout: defaultShutdownTimeout, SocketMode: defaultSocketMode}
 }
 
 func NewDefaultTestOptions() *Options {
@@ -122,6 +223,15 @@ func BindFlags(fs *flag.FlagSet) *Options {
 	fs.IntVar(&opts.Port, "port", opts.Port, "Override element controller port")
 	fs.BoolVar(&opts.Log, "log", opts.Log, "Enable server logging")
 	fs.BoolVar(&opts.Verbose, "verbose", opts.Verbose, "Enable verbose logging")
+	fs.StringVar(&opts.TLSCertFile, "tlsCert", opts.TLSCertFile, "Server TLS certificate file; enables HTTPS")
+	fs.StringVar(&opts.TLSKeyFile, "tlsKey", opts.TLSKeyFile, "Server TLS private key file")
+	fs.StringVar(&opts.TLSClientCAFile, "tlsClientCA", opts.TLSClientCAFile, "Client CA bundle file; requires clients to present a verified certificate")
+	fs.DurationVar(&opts.ShutdownTimeout, "shutdownTimeout", opts.ShutdownTimeout, "Time allowed for in-flight requests and tasks to complete on shutdown")
+	fs.StringVar(&opts.OtlpEndpoint, "otlpEndpoint", opts.OtlpEndpoint, "OpenTelemetry collector OTLP/HTTP endpoint to export request spans; disabled if empty")
+	fs.StringVar(&opts.SocketPath, "socket", opts.SocketPath, "Unix domain socket on which to serve requests for local clients, conventionally "+DefaultSocketPath)
+	fs.StringVar(&opts.SocketMode, "socketMode", opts.SocketMode, "Octal permissions of the Unix domain socket file")
+	fs.StringVar(&opts.SocketGroup, "socketGroup", opts.SocketGroup, "Group owning the Unix domain socket file")
+	fs.BoolVar(&opts.SocketOnly, "socketOnly", opts.SocketOnly, "Serve requests only on the Unix domain socket, not on TCP")
 
 	return opts
 }
@@ -176,36 +286,33 @@ func (c *Controller) initialize(opts *Options) error {
 	return nil
 }
 
-type ControllerProcessor interface {
-	Run(c *Controller, options Options) error
-	Send(c *Controller, w http.ResponseWriter, r *http.Request)
-	Close()
-}
-
-func NewControllerProcessor(http bool) ControllerProcessor {
-	if http {
-		return &HttpControllerProcessor{}
-	}
-
-	return &DummyControllerProcessor{}
-}
+// use installs the middleware applied to every request to the element controller's routes
+func (c *Controller) use(options Options) {
+	log := c.Log
 
-type HttpControllerProcessor struct {
-	client http.Client
-	server *http.Server
-}
+	// Tracing runs first so the request ID is available to all other middleware. The GETs the controller
+	// issues internally, such as to expand a hyperlink, are traced, counted, logged and audited as part of
+	// the client's request.
+	c.router.Use(clientRequestsOnly(c.traceMiddleware))
+	c.router.Use(clientRequestsOnly(c.metricsMiddleware))
 
-func (p *HttpControllerProcessor) Run(c *Controller, options Options) error {
-	log := c.Log
 	if options.Log {
-		c.router.Use(func(next http.Handler) http.Handler {
+		c.router.Use(clientRequestsOnly(func(next http.Handler) http.Handler {
 			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
-				var log = log.WithValues(
+				var log = tracing.Logger(r.Context(), log).WithValues(
 					"method", r.Method,
 					"url", r.RequestURI,
 				)
 
+				if identity := tlsClientIdentity(r.TLS); len(identity) != 0 {
+					log = log.WithValues("client", identity)
+				}
+
+				if creds := PeerCredentialsFromRequest(r); creds != nil {
+					log = log.WithValues("peerPid", creds.Pid, "peerUid", creds.Uid, "peerGid", creds.Gid)
+				}
+
 				if options.Verbose && r.Method == POST_METHOD {
 					body, _ := ioutil.ReadAll(r.Body)
 					r.Body = ioutil.NopCloser(bytes.NewBuffer(body))
@@ -214,16 +321,19 @@ func (p *HttpControllerProcessor) Run(c *Controller, options Options) error {
 
 				start := time.Now()
 
-				recorder := httptest.NewRecorder()
-				next.ServeHTTP(recorder, r)
+				// The response is written through to the client; only the status, and the body if
+				// verbose, are recorded for the log
+				recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+				if options.Verbose {
+					recorder.body = new(bytes.Buffer)
+				}
 
-				status := recorder.Result().StatusCode
+				next.ServeHTTP(recorder, r)
 
-				w.WriteHeader(status)
-				w.Write(recorder.Body.Bytes())
+				status := recorder.statusCode
 
 				if options.Verbose {
-					log = log.WithValues("response", recorder.Body.String())
+					log = log.WithValues("response", recorder.body.String())
 				}
 
 				log.WithValues(
@@ -232,17 +342,109 @@ func (p *HttpControllerProcessor) Run(c *Controller, options Options) error {
 					"elapsedTime", time.Since(start).String(),
 				).Info("Http Response")
 			})
-		})
+		}))
+	}
+
+	for _, api := range c.Routers {
+		if r, ok := api.(AuditingRouter); ok {
+			c.router.Use(clientRequestsOnly(r.AuditMiddleware()))
+		}
+	}
+
+	c.router.Use(c.drainMiddleware)
+
+	for _, api := range c.Routers {
+		if r, ok := api.(MiddlewareRouter); ok {
+			c.router.Use(r.Middleware())
+		}
+	}
+
+	c.router.Use(c.queryMiddleware)
+	c.router.Use(c.etagMiddleware)
+}
+
+type ControllerProcessor interface {
+	Run(c *Controller, options Options) error
+	Send(c *Controller, w http.ResponseWriter, r *http.Request)
+	Shutdown(ctx context.Context) error
+}
+
+func NewControllerProcessor(http bool) ControllerProcessor {
+	if http {
+		return &HttpControllerProcessor{}
 	}
 
+	return &DummyControllerProcessor{}
+}
+
+type HttpControllerProcessor struct {
+	client   http.Client
+	server   *http.Server
+	reloader *certificateReloader
+}
+
+func (p *HttpControllerProcessor) Run(c *Controller, options Options) error {
+	log := c.Log
+
 	// Permissive handling of Cross Origin Resource Sharing
 	// for debug. This allows us access the server from other
 	// web hosting platforms.
 	crs := cors.AllowAll()
 
 	p.server = &http.Server{
-		Addr:    fmt.Sprintf(":%d", c.Port),
-		Handler: crs.Handler(c.router),
+		Addr:        fmt.Sprintf(":%d", c.Port),
+		Handler:     crs.Handler(c.router),
+		ConnContext: connContext,
+	}
+
+	tlsEnabled := options.TLSEnabled() && !options.SocketOnly
+	if tlsEnabled {
+		reloader, err := newCertificateReloader(options, log)
+		if err != nil {
+			log.Error(err, "TLS Configuration Failed")
+			return err
+		}
+
+		p.reloader = reloader
+		p.reloader.watch()
+		p.server.TLSConfig = p.reloader.config()
+	}
+
+	if len(options.SocketPath) != 0 {
+		listener, err := listenUnix(options)
+		if err != nil {
+			log.Error(err, "Unix Socket Listen Failed", "path", options.SocketPath)
+			return err
+		}
+
+		log.Info("Starting Unix Socket Server", "path", options.SocketPath, "mode", options.socketMode(), "group", options.SocketGroup)
+
+		if options.SocketOnly {
+			if err := p.server.Serve(listener); err != http.ErrServerClosed {
+				log.Error(err, "Serve Unix Socket Failed")
+				return err
+			}
+
+			return nil
+		}
+
+		go func() {
+			if err := p.server.Serve(listener); err != http.ErrServerClosed {
+				log.Error(err, "Serve Unix Socket Failed")
+			}
+		}()
+	} else if options.SocketOnly {
+		return fmt.Errorf("socket only requires a socket path")
+	}
+
+	if tlsEnabled {
+		log.Info("Starting HTTPS Server", "address", p.server.Addr, "mutualTLS", len(options.TLSClientCAFile) != 0)
+		if err := p.server.ListenAndServeTLS("", ""); err != http.ErrServerClosed {
+			log.Error(err, "ListenAndServeTLS Failed")
+			return err
+		}
+
+		return nil
 	}
 
 	log.Info("Starting HTTP Server", "address", p.server.Addr)
@@ -264,12 +466,20 @@ func (p *HttpControllerProcessor) Send(c *Controller, w http.ResponseWriter, r *
 	rsp.Body.Close()
 }
 
-func (p *HttpControllerProcessor) Close() {
+// Shutdown stops the server, waiting until the context expires for active connections to close
+func (p *HttpControllerProcessor) Shutdown(ctx context.Context) error {
+	if p.reloader != nil {
+		p.reloader.stop()
+	}
+
 	if p.server != nil {
-		if err := p.server.Shutdown(context.TODO()); err != nil {
-			panic(err)
+		if err := p.server.Shutdown(ctx); err != nil {
+			p.server.Close()
+			return err
 		}
 	}
+
+	return nil
 }
 
 // Dummy Controller Processor is one that does nothing, Use this processor type
@@ -284,8 +494,8 @@ func (*DummyControllerProcessor) Send(c *Controller, w http.ResponseWriter, r *h
 	return
 }
 
-func (*DummyControllerProcessor) Close() {
-	return
+func (*DummyControllerProcessor) Shutdown(ctx context.Context) error {
+	return nil
 }
 
 // HandlerFunc defines an http handler for a controller's routes. By default
@@ -328,7 +538,13 @@ func (c *Controller) Run() error {
 		return fmt.Errorf("controller processor uninitialized")
 	}
 
-	c.Attach(c.router, nil)
+	c.Handler()
+
+	if len(c.options.OtlpEndpoint) != 0 {
+		if err := tracing.StartExporter(c.options.OtlpEndpoint, c.Name, c.Log); err != nil {
+			return err
+		}
+	}
 
 	if err := c.processor.Run(c, c.options); err != nil {
 		return err
@@ -337,6 +553,22 @@ func (c *Controller) Run() error {
 	return nil
 }
 
+// Handler returns the handler servicing the element controller's routes, applying the same middleware
+// as requests received by the http server. It allows requests to be serviced in-process, such as by the
+// in-process transport of the client package. The controller must be initialized.
+func (c *Controller) Handler() http.Handler {
+	c.setup.Do(func() {
+		c.Attach(c.router, nil)
+		c.attachHealth(c.router)
+		c.attachMetrics(c.router)
+		c.attachOpenAPI(c.router)
+		c.attachAllowedMethods(c.router)
+		c.use(c.options)
+	})
+
+	return c.router
+}
+
 // Send a request to the element controller
 func (c *Controller) Send(w http.ResponseWriter, r *http.Request) {
 	c.processor.Send(c, w, r)
@@ -347,14 +579,29 @@ func (c *Controller) Send(w http.ResponseWriter, r *http.Request) {
 // handler - allowing for interception of predefiend routes.
 func (c *Controller) Attach(router *mux.Router, handlerFunc HandlerFunc) {
 
+	authorizers := make([]AuthorizingRouter, 0)
+	for _, api := range c.Routers {
+		if a, ok := api.(AuthorizingRouter); ok {
+			authorizers = append(authorizers, a)
+		}
+	}
+
+	var registry MessageRegistryRouter
+	for _, api := range c.Routers {
+		if m, ok := api.(MessageRegistryRouter); ok {
+			registry = m
+		}
+	}
+
 	for _, api := range c.Routers {
 		for _, r := range api.Routes() {
 			route := router.
 				Name(r.Name).
 				Path(r.Path).
 				Methods(r.Method).
-				Handler(r.HandlerFunc)
+				Handler(authorize(authorizers, r.RequiredPrivilege(), validate(registry, r, r.HandlerFunc)))
 
+			// Forwarded requests are authorized by the receiving element controller
 			if handlerFunc != nil {
 				route.Handler(handlerFunc(c))
 			}
@@ -362,21 +609,47 @@ func (c *Controller) Attach(router *mux.Router, handlerFunc HandlerFunc) {
 	}
 }
 
-func (c *Controller) Close() {
-	c.processor.Close()
+// authorize wraps the handler so it is only called if all authorizers permit the request
+func authorize(authorizers []AuthorizingRouter, privilege Privilege, handler http.HandlerFunc) http.HandlerFunc {
+	if len(authorizers) == 0 {
+		return handler
+	}
 
-	for _, api := range c.Routers {
-		api.Close()
+	return func(w http.ResponseWriter, r *http.Request) {
+		for _, a := range authorizers {
+			if err := a.Authorize(r, privilege); err != nil {
+				EncodeResponse(nil, err, w)
+				return
+			}
+		}
+
+		handler(w, r)
 	}
 }
 
+// Close shuts down the element controller, allowing the configured shutdown timeout for requests
+// and tasks to complete
+func (c *Controller) Close() {
+	ctx, cancel := context.WithTimeout(context.Background(), c.options.shutdownTimeout())
+	defer cancel()
+
+	c.Shutdown(ctx)
+}
+
 // EncodeResponse -
 func EncodeResponse(s interface{}, err error, w http.ResponseWriter) error {
 	if err != nil {
 		// If the supplied error is of an Element Controller Controller Error type,
 		// encode the response to a new error response packet.
+		// Retryable errors tell the client how long to wait before retrying the request
+		if retryable, delay := IsRetryable(err); retryable {
+			w.Header().Set("Retry-After", retryAfter(delay))
+		}
+
 		var e *ControllerError
 		if errors.As(err, &e) {
+			// Headers must be set before the status is written
+			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(e.statusCode)
 			s = NewErrorResponse(e, s)
 		} else {
@@ -24,10 +24,13 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"encoding/json"
+
+	"github.com/NearNodeFlash/nnf-ec/pkg/tracing"
 )
 
 type TestApiRouter struct{}
@@ -62,6 +65,11 @@ func (*TestApiRouter) Routes() Routes {
 		Method:      GET_METHOD,
 		Path:        "/testFailNoError",
 		HandlerFunc: testHandlerFuncFailNoError,
+	}, {
+		Name:        "TestCreated",
+		Method:      POST_METHOD,
+		Path:        "/testCreated",
+		HandlerFunc: testHandlerFuncCreated,
 	}}
 }
 
@@ -116,6 +124,13 @@ func testHandlerFuncFailNoError(w http.ResponseWriter, r *http.Request) {
 		w)
 }
 
+func testHandlerFuncCreated(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Location", "/test/1")
+	w.Header().Set("ETag", `"1"`)
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(testMessage))
+}
+
 type testModel struct {
 	Message string
 }
@@ -138,6 +153,40 @@ func TestHttp(t *testing.T) {
 	RequestFail(t, c)
 }
 
+// TestLogPreservesHeaders checks the request logging middleware passes the handler's response headers,
+// status and body through to the client
+func TestLogPreservesHeaders(t *testing.T) {
+	for _, verbose := range []bool{false, true} {
+		c := NewController("Test", 0, "test", Routers{NewTestApiRouter()})
+		if err := c.Init(&Options{Log: true, Verbose: verbose}); err != nil {
+			t.Fatal(err)
+		}
+
+		w := httptest.NewRecorder()
+		c.Handler().ServeHTTP(w, httptest.NewRequest(POST_METHOD, "/testCreated", nil))
+
+		if w.Code != http.StatusCreated {
+			t.Errorf("Verbose %t: Status: Expected: %d Actual: %d", verbose, http.StatusCreated, w.Code)
+		}
+
+		if location := w.Header().Get("Location"); location != "/test/1" {
+			t.Errorf("Verbose %t: Location header not preserved: '%s'", verbose, location)
+		}
+
+		if etag := w.Header().Get("ETag"); etag != `"1"` {
+			t.Errorf("Verbose %t: ETag header not preserved: '%s'", verbose, etag)
+		}
+
+		if len(w.Header().Get(tracing.RequestIdHeader)) == 0 {
+			t.Errorf("Verbose %t: Request ID header not preserved", verbose)
+		}
+
+		if body := w.Body.String(); body != testMessage {
+			t.Errorf("Verbose %t: Body: Expected: '%s' Actual: '%s'", verbose, testMessage, body)
+		}
+	}
+}
+
 func Request(t *testing.T, c *Controller, method string) {
 	url := fmt.Sprintf("http://localhost:%d/test", c.Port)
 
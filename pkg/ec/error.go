@@ -23,8 +23,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
 )
 
 type ControllerError struct {
@@ -33,7 +37,18 @@ type ControllerError struct {
 	cause        string
 	resourceType string
 	err          error
-	Event        interface{}
+	extendedInfo []sf.MessageV111Message
+	events       []interface{}
+
+	// Deprecated: Event holds the most recent event added with WithEvent; use Events.
+	Event interface{}
+}
+
+// ExtendedInfoEvent is implemented by events that describe themselves as a message of the
+// @Message.ExtendedInfo of an error response. Events added to an error that do not implement it are
+// returned with the properties they share with a message.
+type ExtendedInfoEvent interface {
+	ExtendedInfo() sf.MessageV111Message
 }
 
 func NewControllerError(sc int) *ControllerError {
@@ -43,7 +58,7 @@ func NewControllerError(sc int) *ControllerError {
 func (e *ControllerError) Error() string {
 	errorString := fmt.Sprintf("Error %d: %s", e.statusCode, http.StatusText(e.statusCode))
 	if e.IsRetryable() {
-		errorString += fmt.Sprintf(", Retry-Delay: %ds", e.retryDelay)
+		errorString += fmt.Sprintf(", Retry-Delay: %s", e.retryDelay)
 	}
 	if len(e.resourceType) != 0 {
 		errorString += fmt.Sprintf(", Resource: %s", e.resourceType)
@@ -75,6 +90,30 @@ func (e *ControllerError) ResourceType() string {
 	return e.resourceType
 }
 
+// ExtendedInfo returns the messages describing the error, followed by the messages of its events
+func (e *ControllerError) ExtendedInfo() []sf.MessageV111Message {
+	messages := make([]sf.MessageV111Message, 0, len(e.extendedInfo)+len(e.events))
+	messages = append(messages, e.extendedInfo...)
+
+	for _, event := range e.events {
+		if ev, ok := event.(ExtendedInfoEvent); ok {
+			messages = append(messages, ev.ExtendedInfo())
+			continue
+		}
+
+		message := sf.MessageV111Message{}
+		if b, err := json.Marshal(event); err == nil && json.Unmarshal(b, &message) == nil {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages
+}
+
+func (e *ControllerError) Events() []interface{} {
+	return e.events
+}
+
 // Setters
 
 func (e *ControllerError) WithError(err error) *ControllerError {
@@ -92,18 +131,29 @@ func (e *ControllerError) WithResourceType(t string) *ControllerError {
 	return e
 }
 
+// WithEvent adds an event describing the error; an error may carry several events, each returned in
+// the @Message.ExtendedInfo of the error response
 func (e *ControllerError) WithEvent(event interface{}) *ControllerError {
+	e.events = append(e.events, event)
 	e.Event = &event
 	return e
 }
 
+// WithExtendedInfo adds messages describing the error, returned in the @Message.ExtendedInfo of the
+// error response
+func (e *ControllerError) WithExtendedInfo(messages ...sf.MessageV111Message) *ControllerError {
+	e.extendedInfo = append(e.extendedInfo, messages...)
+	return e
+}
+
 func (e *ControllerError) WithRetryDelay(delay time.Duration) *ControllerError {
 	e.retryDelay = delay
 	return e
 }
 
+// IsRetryable returns true if the request may succeed if retried after the retry delay
 func (e *ControllerError) IsRetryable() bool {
-	return e.statusCode != http.StatusTooManyRequests
+	return e.retryDelay != 0
 }
 
 func (e *ControllerError) RetryDelay() time.Duration {
@@ -126,6 +176,10 @@ func NewErrBadRequest() *ControllerError {
 	return NewControllerError(http.StatusBadRequest)
 }
 
+func NewErrMethodNotAllowed() *ControllerError {
+	return NewControllerError(http.StatusMethodNotAllowed)
+}
+
 func NewErrNotAcceptable() *ControllerError {
 	return NewControllerError(http.StatusNotAcceptable)
 }
@@ -134,10 +188,26 @@ func NewErrInternalServerError() *ControllerError {
 	return NewControllerError(http.StatusInternalServerError)
 }
 
+func NewErrUnauthorized() *ControllerError {
+	return NewControllerError(http.StatusUnauthorized)
+}
+
+func NewErrForbidden() *ControllerError {
+	return NewControllerError(http.StatusForbidden)
+}
+
+func NewErrPreconditionFailed() *ControllerError {
+	return NewControllerError(http.StatusPreconditionFailed)
+}
+
 func NewErrNotImplemented() *ControllerError {
 	return NewControllerError(http.StatusNotImplemented)
 }
 
+func NewErrServiceUnavailable() *ControllerError {
+	return NewControllerError(http.StatusServiceUnavailable)
+}
+
 // IsRetryable returns true and the retry delay if ANY errors emanating from the supplied error
 // is an ec.ControllerError that is Retryable, and false otherwise.
 func IsRetryable(err error) (bool, time.Duration) {
@@ -154,12 +224,19 @@ func IsRetryable(err error) (bool, time.Duration) {
 	return false, time.Duration(0)
 }
 
+// retryAfter returns the value of the Retry-After header for the delay, in whole seconds rounded up
+func retryAfter(delay time.Duration) string {
+	return strconv.Itoa(int(math.Max(1, math.Ceil(delay.Seconds()))))
+}
+
 type ErrorResponse struct {
 	Status  int    `json:"status"`
 	Error   string `json:"error"`
 	Cause   string `json:"cause,omitempty"`
 	Details string `json:"details,omitempty"`
 	Model   string `json:"model,omitempty"`
+
+	ExtendedInfo []sf.MessageV111Message `json:"@Message.ExtendedInfo,omitempty"`
 }
 
 // New Error Response - Returns encoded byte stream for responding to
@@ -177,6 +254,8 @@ func NewErrorResponse(e *ControllerError, v interface{}) (s interface{}) {
 		Error:   http.StatusText(e.statusCode),
 		Cause:   e.cause,
 		Details: details,
+
+		ExtendedInfo: e.ExtendedInfo(),
 	}
 
 	if v != nil {
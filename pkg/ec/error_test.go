@@ -0,0 +1,96 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+type extendedInfoTestEvent struct {
+	MessageId string
+}
+
+func (e extendedInfoTestEvent) ExtendedInfo() sf.MessageV111Message {
+	return sf.MessageV111Message{MessageId: e.MessageId, Resolution: "Retry the request."}
+}
+
+func TestErrorResponse(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		err        error
+		statusCode int
+		retryAfter string
+		messages   []string
+	}{
+		{
+			name:       "not ready",
+			err:        NewErrorNotReady().WithCause("starting"),
+			statusCode: http.StatusTooManyRequests,
+			retryAfter: "1",
+		},
+		{
+			name:       "wrapped retryable error",
+			err:        NewErrInternalServerError().WithError(NewErrServiceUnavailable().WithRetryDelay(1500 * time.Millisecond)),
+			statusCode: http.StatusInternalServerError,
+			retryAfter: "2",
+		},
+		{
+			name: "several messages",
+			err: NewErrBadRequest().
+				WithExtendedInfo(sf.MessageV111Message{MessageId: "Test.1.0.First"}).
+				WithEvent(extendedInfoTestEvent{MessageId: "Test.1.0.Second"}).
+				WithEvent(struct{ MessageId string }{MessageId: "Test.1.0.Third"}),
+			statusCode: http.StatusBadRequest,
+			messages:   []string{"Test.1.0.First", "Test.1.0.Second", "Test.1.0.Third"},
+		},
+	} {
+		w := httptest.NewRecorder()
+		EncodeResponse(nil, test.err, w)
+
+		if w.Code != test.statusCode {
+			t.Errorf("%s: Status: Expected: %d Actual: %d", test.name, test.statusCode, w.Code)
+		}
+
+		if retryAfter := w.Header().Get("Retry-After"); retryAfter != test.retryAfter {
+			t.Errorf("%s: Retry-After: Expected: '%s' Actual: '%s'", test.name, test.retryAfter, retryAfter)
+		}
+
+		rsp := ErrorResponse{}
+		if err := json.Unmarshal(w.Body.Bytes(), &rsp); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		if len(rsp.ExtendedInfo) != len(test.messages) {
+			t.Errorf("%s: ExtendedInfo: Expected: %v Actual: %+v", test.name, test.messages, rsp.ExtendedInfo)
+			continue
+		}
+
+		for idx, message := range rsp.ExtendedInfo {
+			if message.MessageId != test.messages[idx] {
+				t.Errorf("%s: ExtendedInfo %d: Expected: %s Actual: %s", test.name, idx, test.messages[idx], message.MessageId)
+			}
+		}
+	}
+}
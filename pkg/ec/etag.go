@@ -0,0 +1,204 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ec
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// ETags - Every successful JSON GET response carries a strong ETag derived from the resource's persisted state.
+// Handlers of resources with persisted state report it with SetResourceState; for other resources the ETag is
+// computed from the handler's representation with its volatile properties, such as Status and timestamps,
+// removed. The ETag is computed before query options are applied, so $select and $expand do not change it.
+//
+// PATCH, PUT and DELETE requests to the same resource are serialized. Those carrying an If-Match header are
+// only performed if the resource's current ETag matches using the strong comparison of RFC 7232, so weak entity
+// tags never match; otherwise 412 Precondition Failed is returned. If the resource's current state cannot be
+// read the error is returned in place of performing the request.
+
+// pathLocks provides a lock for each resource path. A path's lock is counted by the requests holding or
+// waiting for it and is removed once the last of them unlocks.
+type pathLocks struct {
+	sync.Mutex
+	locks map[string]*pathLock
+}
+
+type pathLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lock locks the path, returning the function that unlocks it
+func (p *pathLocks) lock(path string) func() {
+	p.Lock()
+
+	if p.locks == nil {
+		p.locks = make(map[string]*pathLock)
+	}
+
+	l, ok := p.locks[path]
+	if !ok {
+		l = new(pathLock)
+		p.locks[path] = l
+	}
+
+	l.refs++
+	p.Unlock()
+
+	l.Lock()
+
+	return func() {
+		l.Unlock()
+
+		p.Lock()
+		defer p.Unlock()
+
+		l.refs--
+		if l.refs == 0 {
+			delete(p.locks, path)
+		}
+	}
+}
+
+type resourceStateKey struct{}
+
+type resourceState struct {
+	state []byte
+}
+
+// SetResourceState records the persisted state of the resource being read by the request. The resource's ETag
+// is derived from the state in place of its representation. It has no effect outside of a GET request.
+func SetResourceState(ctx context.Context, state []byte) {
+	if rs, ok := ctx.Value(resourceStateKey{}).(*resourceState); ok {
+		rs.state = state
+	}
+}
+
+func computeETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:8]))
+}
+
+// volatileProperty returns true if the property changes without any change to the resource's persisted state
+func volatileProperty(name string) bool {
+	return name == "Status" || strings.HasSuffix(name, "DateTime") || strings.HasSuffix(name, "Timestamp")
+}
+
+func removeVolatileProperties(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for name, value := range v {
+			if volatileProperty(name) {
+				delete(v, name)
+				continue
+			}
+			removeVolatileProperties(value)
+		}
+	case []interface{}:
+		for _, value := range v {
+			removeVolatileProperties(value)
+		}
+	}
+}
+
+// representationState returns the state of a resource without persisted state from its representation
+func representationState(body []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+
+	removeVolatileProperties(v)
+
+	state, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+
+	return state
+}
+
+// etagMatches returns true if the If-Match header matches the ETag using strong comparison
+func etagMatches(ifMatch string, etag string) bool {
+	for _, tag := range strings.Split(ifMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || (!strings.HasPrefix(tag, "W/") && tag == etag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Controller) etagMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case GET_METHOD:
+			rs := new(resourceState)
+			recorder := httptest.NewRecorder()
+			next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), resourceStateKey{}, rs)))
+
+			if recorder.Code == http.StatusOK && strings.HasPrefix(recorder.Header().Get("Content-Type"), "application/json") {
+				state := rs.state
+				if state == nil {
+					state = representationState(recorder.Body.Bytes())
+				}
+
+				recorder.Header().Set("ETag", computeETag(state))
+			}
+
+			copyResponse(w, recorder)
+
+		case PATCH_METHOD, PUT_METHOD, DELETE_METHOD:
+			unlock := c.pathLocks.lock(r.URL.Path)
+			defer unlock()
+
+			if ifMatch := r.Header.Get("If-Match"); len(ifMatch) != 0 {
+				current := c.get(r, r.URL.Path)
+				switch current.Code {
+				case http.StatusOK:
+					if etag := current.Header().Get("ETag"); !etagMatches(ifMatch, etag) {
+						EncodeResponse(nil, NewErrPreconditionFailed().WithCause(fmt.Sprintf("Resource %s ETag %s does not match %s", r.URL.Path, etag, ifMatch)), w)
+						return
+					}
+				case http.StatusNotFound:
+					EncodeResponse(nil, NewErrPreconditionFailed().WithCause(fmt.Sprintf("Resource %s not found", r.URL.Path)), w)
+					return
+				default:
+					copyResponse(w, current)
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
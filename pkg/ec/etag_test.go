@@ -0,0 +1,172 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ec
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type etagTestModel struct {
+	Message  string
+	DateTime string
+}
+
+type etagTestRouter struct {
+	value string
+	reads int
+}
+
+func (*etagTestRouter) Name() string      { return "ETagTestRouter" }
+func (*etagTestRouter) Init(Logger) error { return nil }
+func (*etagTestRouter) Start() error      { return nil }
+func (*etagTestRouter) Close() error      { return nil }
+
+func (router *etagTestRouter) Routes() Routes {
+	return Routes{{
+		Name:   "ThingGet",
+		Method: GET_METHOD,
+		Path:   "/thing",
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+			EncodeResponse(&etagTestModel{Message: router.value, DateTime: time.Now().Format(time.RFC3339Nano)}, nil, w)
+		},
+	}, {
+		Name:   "ThingPut",
+		Method: PUT_METHOD,
+		Path:   "/thing",
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+			router.value = r.URL.Query().Get("value")
+			EncodeResponse(&testModel{Message: router.value}, nil, w)
+		},
+	}, {
+		Name:   "StatefulGet",
+		Method: GET_METHOD,
+		Path:   "/stateful",
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+			router.reads++
+			SetResourceState(r.Context(), []byte(router.value))
+			EncodeResponse(&testModel{Message: fmt.Sprintf("Read %d", router.reads)}, nil, w)
+		},
+	}, {
+		Name:   "UnavailableGet",
+		Method: GET_METHOD,
+		Path:   "/unavailable",
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+			EncodeResponse(nil, NewErrServiceUnavailable(), w)
+		},
+	}, {
+		Name:   "UnavailablePut",
+		Method: PUT_METHOD,
+		Path:   "/unavailable",
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+			router.value = "unavailable"
+			EncodeResponse(&testModel{Message: router.value}, nil, w)
+		},
+	}}
+}
+
+func TestETag(t *testing.T) {
+	router := &etagTestRouter{value: "one"}
+	c := NewController("Test", 0, "test", Routers{router})
+	if err := c.Init(NewDefaultTestOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	c.router.Use(c.etagMiddleware)
+	c.Attach(c.router, nil)
+
+	serve := func(method, url, ifMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, url, nil)
+		if len(ifMatch) != 0 {
+			r.Header.Set("If-Match", ifMatch)
+		}
+
+		w := httptest.NewRecorder()
+		c.router.ServeHTTP(w, r)
+		return w
+	}
+
+	etag := serve(GET_METHOD, "/thing", "").Header().Get("ETag")
+	if len(etag) == 0 {
+		t.Fatalf("GET response has no ETag")
+	}
+
+	// Volatile properties and query options do not change the ETag
+	if w := serve(GET_METHOD, "/thing?$select=Message", ""); w.Header().Get("ETag") != etag {
+		t.Errorf("ETag changed between reads: Expected: %s Actual: %s", etag, w.Header().Get("ETag"))
+	}
+
+	if w := serve(PUT_METHOD, "/thing?value=two", etag); w.Code != http.StatusOK {
+		t.Errorf("PUT with current ETag: Expected: %d Actual: %d", http.StatusOK, w.Code)
+	}
+
+	// The resource changed; the previous ETag is stale
+	if w := serve(PUT_METHOD, "/thing?value=three", etag); w.Code != http.StatusPreconditionFailed {
+		t.Errorf("PUT with stale ETag: Expected: %d Actual: %d", http.StatusPreconditionFailed, w.Code)
+	}
+
+	if w := serve(PUT_METHOD, "/thing?value=three", "*"); w.Code != http.StatusOK {
+		t.Errorf("PUT with wildcard ETag: Expected: %d Actual: %d", http.StatusOK, w.Code)
+	}
+
+	current := serve(GET_METHOD, "/thing", "").Header().Get("ETag")
+	if current == etag {
+		t.Errorf("ETag unchanged after the resource changed")
+	}
+
+	// If-Match uses strong comparison; a weak entity tag never matches
+	if w := serve(PUT_METHOD, "/thing?value=four", "W/"+current); w.Code != http.StatusPreconditionFailed {
+		t.Errorf("PUT with weak ETag: Expected: %d Actual: %d", http.StatusPreconditionFailed, w.Code)
+	}
+
+	// The ETag of a resource reporting its persisted state is derived from that state alone
+	stateful := serve(GET_METHOD, "/stateful", "").Header().Get("ETag")
+	if w := serve(GET_METHOD, "/stateful", ""); w.Header().Get("ETag") != stateful {
+		t.Errorf("Stateful ETag changed between reads: Expected: %s Actual: %s", stateful, w.Header().Get("ETag"))
+	}
+
+	// A precondition that cannot be evaluated fails the request
+	if w := serve(PUT_METHOD, "/unavailable", "*"); w.Code != http.StatusServiceUnavailable || router.value == "unavailable" {
+		t.Errorf("PUT with unreadable precondition: Expected: %d Actual: %d", http.StatusServiceUnavailable, w.Code)
+	}
+
+	// Requests without If-Match are serialized with the other requests to the resource
+	unlock := c.pathLocks.lock("/thing")
+	done := make(chan int)
+	go func() { done <- serve(PUT_METHOD, "/thing?value=five", "").Code }()
+
+	select {
+	case <-done:
+		t.Fatalf("PUT without If-Match did not wait for the resource's lock")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+	if code := <-done; code != http.StatusOK {
+		t.Errorf("PUT without If-Match: Expected: %d Actual: %d", http.StatusOK, code)
+	}
+
+	if len(c.pathLocks.locks) != 0 {
+		t.Errorf("Path locks retained after the requests completed: %d", len(c.pathLocks.locks))
+	}
+}
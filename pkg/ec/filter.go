@@ -0,0 +1,340 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Filter - A subset of the $filter query parameter grammar. Comparisons of a property to a literal using
+// eq, ne, gt, ge, lt and le, combined with and, or, not and parentheses. Properties may name nested
+// properties using '/', i.e. Status/Health eq 'OK'. Literals are quoted strings, numbers, true, false
+// and null.
+
+type filterExpression interface {
+	evaluate(model map[string]interface{}) (bool, error)
+}
+
+type filterAnd struct{ left, right filterExpression }
+type filterOr struct{ left, right filterExpression }
+type filterNot struct{ expr filterExpression }
+
+type filterComparison struct {
+	property string
+	op       string
+	value    interface{}
+}
+
+func (f *filterAnd) evaluate(model map[string]interface{}) (bool, error) {
+	left, err := f.left.evaluate(model)
+	if err != nil || !left {
+		return false, err
+	}
+
+	return f.right.evaluate(model)
+}
+
+func (f *filterOr) evaluate(model map[string]interface{}) (bool, error) {
+	left, err := f.left.evaluate(model)
+	if err != nil || left {
+		return left, err
+	}
+
+	return f.right.evaluate(model)
+}
+
+func (f *filterNot) evaluate(model map[string]interface{}) (bool, error) {
+	result, err := f.expr.evaluate(model)
+	return !result, err
+}
+
+func (f *filterComparison) evaluate(model map[string]interface{}) (bool, error) {
+	var value interface{} = model
+	for _, name := range strings.Split(f.property, "/") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			value = nil
+			break
+		}
+		value = m[name]
+	}
+
+	cmp, ok := compare(value, f.value)
+	if !ok {
+		switch f.op {
+		case "eq":
+			return false, nil
+		case "ne":
+			return true, nil
+		}
+
+		return false, fmt.Errorf("Property %s cannot be compared with operator %s", f.property, f.op)
+	}
+
+	switch f.op {
+	case "eq":
+		return cmp == 0, nil
+	case "ne":
+		return cmp != 0, nil
+	case "gt":
+		return cmp > 0, nil
+	case "ge":
+		return cmp >= 0, nil
+	case "lt":
+		return cmp < 0, nil
+	case "le":
+		return cmp <= 0, nil
+	}
+
+	return false, fmt.Errorf("Operator %s not supported", f.op)
+}
+
+// compare returns the ordering of a property value and a literal, and false if they are not comparable
+func compare(value, literal interface{}) (int, bool) {
+	switch l := literal.(type) {
+	case nil:
+		if value == nil {
+			return 0, true
+		}
+		return 1, false
+	case string:
+		if v, ok := value.(string); ok {
+			return strings.Compare(v, l), true
+		}
+	case bool:
+		if v, ok := value.(bool); ok {
+			if v == l {
+				return 0, true
+			}
+			return 1, false
+		}
+	case float64:
+		var v float64
+		switch n := value.(type) {
+		case json.Number:
+			f, err := n.Float64()
+			if err != nil {
+				return 0, false
+			}
+			v = f
+		case float64:
+			v = n
+		default:
+			return 0, false
+		}
+
+		switch {
+		case v < l:
+			return -1, true
+		case v > l:
+			return 1, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+func parseFilter(filter string) (filterExpression, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &filterParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("Unexpected '%s'", p.tokens[p.pos])
+	}
+
+	return expr, nil
+}
+
+func tokenizeFilter(filter string) ([]string, error) {
+	tokens := make([]string, 0)
+
+	for idx := 0; idx < len(filter); {
+		switch ch := filter[idx]; {
+		case ch == ' ':
+			idx++
+		case ch == '(' || ch == ')':
+			tokens = append(tokens, string(ch))
+			idx++
+		case ch == '\'':
+			// Quoted string; a quote is escaped by doubling it
+			end := idx + 1
+			for ; end < len(filter); end++ {
+				if filter[end] == '\'' {
+					if end+1 < len(filter) && filter[end+1] == '\'' {
+						end++
+						continue
+					}
+					break
+				}
+			}
+
+			if end == len(filter) {
+				return nil, fmt.Errorf("Unterminated string")
+			}
+
+			tokens = append(tokens, filter[idx:end+1])
+			idx = end + 1
+		default:
+			end := idx
+			for end < len(filter) && !strings.ContainsRune(" ()'", rune(filter[end])) {
+				end++
+			}
+
+			tokens = append(tokens, filter[idx:end])
+			idx = end
+		}
+	}
+
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *filterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+
+	return ""
+}
+
+func (p *filterParser) next() string {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+func (p *filterParser) parseOr() (filterExpression, error) {
+	left, err := p.parseAnd()
+	for err == nil && p.peek() == "or" {
+		p.next()
+
+		var right filterExpression
+		if right, err = p.parseAnd(); err == nil {
+			left = &filterOr{left: left, right: right}
+		}
+	}
+
+	return left, err
+}
+
+func (p *filterParser) parseAnd() (filterExpression, error) {
+	left, err := p.parseUnary()
+	for err == nil && p.peek() == "and" {
+		p.next()
+
+		var right filterExpression
+		if right, err = p.parseUnary(); err == nil {
+			left = &filterAnd{left: left, right: right}
+		}
+	}
+
+	return left, err
+}
+
+func (p *filterParser) parseUnary() (filterExpression, error) {
+	switch p.peek() {
+	case "not":
+		p.next()
+
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &filterNot{expr: expr}, nil
+
+	case "(":
+		p.next()
+
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.next() != ")" {
+			return nil, fmt.Errorf("Missing ')'")
+		}
+
+		return expr, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (filterExpression, error) {
+	property, op, literal := p.next(), p.next(), p.next()
+
+	if len(property) == 0 || strings.ContainsAny(property, "()'") {
+		return nil, fmt.Errorf("Expected property, found '%s'", property)
+	}
+
+	switch op {
+	case "eq", "ne", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("Operator '%s' not supported", op)
+	}
+
+	value, err := parseLiteral(literal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filterComparison{property: property, op: op, value: value}, nil
+}
+
+func parseLiteral(literal string) (interface{}, error) {
+	switch literal {
+	case "":
+		return nil, fmt.Errorf("Expected value")
+	case "null":
+		return nil, nil
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+
+	if strings.HasPrefix(literal, "'") {
+		return strings.ReplaceAll(literal[1:len(literal)-1], "''", "'"), nil
+	}
+
+	value, err := strconv.ParseFloat(literal, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Value '%s' not supported", literal)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// Health Probes - The element controller serves a liveness probe and a readiness probe for use by
+// Kubernetes. Neither probe is a Redfish resource and neither requires authentication. Routers take
+// part by implementing HealthReportingRouter; the probe succeeds with 200 OK only if every such router
+// reports healthy, otherwise 503 Service Unavailable is returned. Either way, the response body holds
+// the status of each router.
+
+const (
+	LivenessPath  = "/healthz"
+	ReadinessPath = "/readyz"
+)
+
+// HealthStatus - The health of a router. Reason describes why the router is not healthy; Details holds
+// router specific state for diagnosis.
+type HealthStatus struct {
+	Healthy bool                   `json:"healthy"`
+	Reason  string                 `json:"reason,omitempty"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// HealthReportingRouter is optionally implemented by a Router that reports its health. Ready returns
+// whether the router is prepared to serve requests; Live returns whether the router is making progress,
+// such that a failure indicates the element controller must be restarted.
+type HealthReportingRouter interface {
+	Ready() HealthStatus
+	Live() HealthStatus
+}
+
+// HealthResponse - The response body of the liveness and readiness probes
+type HealthResponse struct {
+	Status  string                  `json:"status"`
+	Reason  string                  `json:"reason,omitempty"`
+	Routers map[string]HealthStatus `json:"routers,omitempty"`
+}
+
+// attachHealth adds the liveness and readiness probes to the router
+func (c *Controller) attachHealth(router *mux.Router) {
+	router.Path(LivenessPath).Methods(GET_METHOD).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.encodeHealth(w, "", HealthReportingRouter.Live)
+	})
+
+	router.Path(ReadinessPath).Methods(GET_METHOD).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reason := ""
+		if c.drain.isDraining() {
+			reason = "element controller is shutting down"
+		}
+
+		c.encodeHealth(w, reason, HealthReportingRouter.Ready)
+	})
+}
+
+// encodeHealth writes the health of each router as reported by the probe function. A non-empty reason
+// fails the probe regardless of the health of the routers.
+func (c *Controller) encodeHealth(w http.ResponseWriter, reason string, probe func(HealthReportingRouter) HealthStatus) {
+	rsp := HealthResponse{Reason: reason, Routers: make(map[string]HealthStatus)}
+
+	healthy := len(reason) == 0
+	for _, api := range c.Routers {
+		if h, ok := api.(HealthReportingRouter); ok {
+			status := probe(h)
+			if !status.Healthy {
+				healthy = false
+			}
+
+			rsp.Routers[api.Name()] = status
+		}
+	}
+
+	statusCode := http.StatusOK
+	rsp.Status = "ok"
+	if !healthy {
+		statusCode = http.StatusServiceUnavailable
+		rsp.Status = "unavailable"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(&rsp)
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type healthTestRouter struct {
+	ready bool
+}
+
+func (*healthTestRouter) Name() string      { return "HealthTestRouter" }
+func (*healthTestRouter) Init(Logger) error { return nil }
+func (*healthTestRouter) Start() error      { return nil }
+func (*healthTestRouter) Close() error      { return nil }
+func (*healthTestRouter) Routes() Routes    { return Routes{} }
+
+func (router *healthTestRouter) Ready() HealthStatus {
+	if !router.ready {
+		return HealthStatus{Healthy: false, Reason: "starting"}
+	}
+
+	return HealthStatus{Healthy: true}
+}
+
+func (*healthTestRouter) Live() HealthStatus { return HealthStatus{Healthy: true} }
+
+func TestHealth(t *testing.T) {
+	router := &healthTestRouter{}
+
+	c := NewController("Test", 0, "test", Routers{router})
+	if err := c.Init(NewDefaultTestOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	c.attachHealth(c.router)
+
+	probe := func(path string, expected int) HealthResponse {
+		w := httptest.NewRecorder()
+		c.router.ServeHTTP(w, httptest.NewRequest(GET_METHOD, path, nil))
+
+		if w.Code != expected {
+			t.Errorf("%s: Expected: %d Actual: %d", path, expected, w.Code)
+		}
+
+		rsp := HealthResponse{}
+		if err := json.Unmarshal(w.Body.Bytes(), &rsp); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+
+		return rsp
+	}
+
+	probe(LivenessPath, http.StatusOK)
+
+	rsp := probe(ReadinessPath, http.StatusServiceUnavailable)
+	if status, ok := rsp.Routers[router.Name()]; !ok || status.Reason != "starting" {
+		t.Errorf("Readiness router status: %+v", rsp.Routers)
+	}
+
+	router.ready = true
+	probe(ReadinessPath, http.StatusOK)
+
+	// A controller that is shutting down is no longer ready, but remains live
+	c.drain.start()
+	probe(ReadinessPath, http.StatusServiceUnavailable)
+	probe(LivenessPath, http.StatusOK)
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// Allowed Methods - The methods allowed on each path are those of the Routes() of the routers for the
+// path, and the paths served by the element controller itself, along with OPTIONS. A request for a known
+// path with a method that is not allowed is answered with 405 Method Not Allowed, and an OPTIONS request
+// with 200 OK; both carry the Allow header. GET requests also carry the Allow header so clients learn
+// which operations the resource supports.
+
+// allowedMethods returns the methods allowed on each path template, and the templates in the order
+// their routes are attached.
+func (c *Controller) allowedMethods() (map[string][]string, []string) {
+	allowed := map[string][]string{}
+	paths := []string{}
+
+	add := func(path, method string) {
+		methods, ok := allowed[path]
+		if !ok {
+			paths = append(paths, path)
+		}
+
+		for _, m := range methods {
+			if m == method {
+				return
+			}
+		}
+
+		allowed[path] = append(methods, method)
+	}
+
+	for _, api := range c.Routers {
+		for _, r := range api.Routes() {
+			add(r.Path, r.Method)
+		}
+	}
+
+	for _, path := range []string{LivenessPath, ReadinessPath, MetricsPath, OpenAPIPath} {
+		add(path, GET_METHOD)
+	}
+
+	for _, path := range paths {
+		add(path, OPTIONS_METHOD)
+	}
+
+	return allowed, paths
+}
+
+// attachAllowedMethods answers requests for methods that are not allowed on a path of the router and
+// sets the Allow header of GET requests
+func (c *Controller) attachAllowedMethods(router *mux.Router) {
+	allowed, paths := c.allowedMethods()
+
+	// The router calls the method not allowed handler when a request matches the path of a route but
+	// none of the methods. The handler matches the path again, without regard to method, to find the
+	// methods allowed on the path.
+	notAllowed := mux.NewRouter()
+	for _, path := range paths {
+		allow := strings.Join(allowed[path], ", ")
+
+		notAllowed.Path(path).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Allow", allow)
+
+			if r.Method == OPTIONS_METHOD {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+
+			EncodeResponse(nil, NewErrMethodNotAllowed().WithCause(fmt.Sprintf("Method %s not allowed on %s; allowed methods are %s", r.Method, r.URL.Path, allow)), w)
+		})
+	}
+
+	router.MethodNotAllowedHandler = notAllowed
+
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == GET_METHOD {
+				if route := mux.CurrentRoute(r); route != nil {
+					if path, err := route.GetPathTemplate(); err == nil {
+						w.Header().Set("Allow", strings.Join(allowed[path], ", "))
+					}
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	})
+}
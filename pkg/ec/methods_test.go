@@ -0,0 +1,102 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type methodsTestRouter struct{}
+
+func (*methodsTestRouter) Name() string      { return "MethodsTestRouter" }
+func (*methodsTestRouter) Init(Logger) error { return nil }
+func (*methodsTestRouter) Start() error      { return nil }
+func (*methodsTestRouter) Close() error      { return nil }
+
+func (*methodsTestRouter) Routes() Routes {
+	return Routes{
+		{
+			Name:   "ConnectionGet",
+			Method: GET_METHOD,
+			Path:   "/connections/{ConnectionId:[0-9]+}",
+			HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+				EncodeResponse(map[string]string{"Id": "1"}, nil, w)
+			},
+		},
+		{
+			Name:   "ConnectionPatch",
+			Method: PATCH_METHOD,
+			Path:   "/connections/{ConnectionId:[0-9]+}",
+			HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+				EncodeResponse(nil, NewErrNotImplemented(), w)
+			},
+		},
+		{
+			Name:   "ConnectionsPost",
+			Method: POST_METHOD,
+			Path:   "/connections",
+			HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+				EncodeResponse(nil, nil, w)
+			},
+		},
+	}
+}
+
+func TestAllowedMethods(t *testing.T) {
+	c := NewController("Test", 0, "test", Routers{&methodsTestRouter{}})
+	if err := c.Init(NewDefaultTestOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		method string
+		path   string
+		code   int
+		allow  string
+	}{
+		{GET_METHOD, "/connections/1", http.StatusOK, "GET, PATCH, OPTIONS"},
+		{PATCH_METHOD, "/connections/1", http.StatusNotImplemented, ""},
+		{DELETE_METHOD, "/connections/1", http.StatusMethodNotAllowed, "GET, PATCH, OPTIONS"},
+		{OPTIONS_METHOD, "/connections/1", http.StatusOK, "GET, PATCH, OPTIONS"},
+		{GET_METHOD, "/connections", http.StatusMethodNotAllowed, "POST, OPTIONS"},
+		{OPTIONS_METHOD, "/connections", http.StatusOK, "POST, OPTIONS"},
+		{POST_METHOD, OpenAPIPath, http.StatusMethodNotAllowed, "GET, OPTIONS"},
+
+		// Paths that are not served, including those that do not match the pattern of a variable
+		{DELETE_METHOD, "/connections/one", http.StatusNotFound, ""},
+		{OPTIONS_METHOD, "/volumes", http.StatusNotFound, ""},
+	} {
+		w := httptest.NewRecorder()
+		c.Handler().ServeHTTP(w, httptest.NewRequest(test.method, test.path, nil))
+
+		if w.Code != test.code {
+			t.Errorf("%s %s: Expected: %d Actual: %d", test.method, test.path, test.code, w.Code)
+		}
+
+		if allow := w.Header().Get("Allow"); allow != test.allow {
+			t.Errorf("%s %s: Allow Expected: '%s' Actual: '%s'", test.method, test.path, test.allow, allow)
+		}
+
+		if test.code == http.StatusMethodNotAllowed && w.Header().Get("Content-Type") != "application/json" {
+			t.Errorf("%s %s: Content-Type: %s", test.method, test.path, w.Header().Get("Content-Type"))
+		}
+	}
+}
@@ -0,0 +1,263 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// Metrics - The element controller serves metrics for Prometheus at /metrics, in the Prometheus text
+// exposition format or, if the scraper accepts it, the OpenMetrics text format. The controller records
+// HTTP request counts and latencies per route; routers contribute the metrics of their managers by
+// implementing MetricsReportingRouter. Router metrics are drawn from state the managers already hold,
+// so a scrape never issues hardware commands.
+
+const MetricsPath = "/metrics"
+
+// MetricType - The type of a metric family
+type MetricType string
+
+const (
+	CounterMetricType   MetricType = "counter"
+	GaugeMetricType     MetricType = "gauge"
+	HistogramMetricType MetricType = "histogram"
+)
+
+// MetricLabels - The labels identifying a sample within a metric family
+type MetricLabels map[string]string
+
+// MetricSample - A single sample of a metric family. Suffix is appended to the family name, as
+// required for the _bucket, _sum and _count samples of a histogram.
+type MetricSample struct {
+	Suffix string
+	Labels MetricLabels
+	Value  float64
+}
+
+// MetricFamily - A set of samples sharing a name, type and help text. Counter names end in _total.
+type MetricFamily struct {
+	Name    string
+	Help    string
+	Type    MetricType
+	Samples []MetricSample
+}
+
+// MetricsReportingRouter is optionally implemented by a Router that reports metrics of its manager
+type MetricsReportingRouter interface {
+	Metrics() []MetricFamily
+}
+
+// Upper bounds, in seconds, of the HTTP request latency histogram buckets
+var latencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+type requestKey struct {
+	method string
+	route  string
+}
+
+type requestMetrics struct {
+	counts  map[int]uint64 // Requests by status code
+	buckets []uint64       // Requests by latency bucket; the last bucket is +Inf
+	sum     float64        // Sum of request latencies in seconds
+}
+
+// httpMetrics records the count and latency of requests to each route
+type httpMetrics struct {
+	sync.Mutex
+	requests map[requestKey]*requestMetrics
+}
+
+func (m *httpMetrics) record(method, route string, statusCode int, latency time.Duration) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.requests == nil {
+		m.requests = make(map[requestKey]*requestMetrics)
+	}
+
+	key := requestKey{method: method, route: route}
+	r, ok := m.requests[key]
+	if !ok {
+		r = &requestMetrics{counts: make(map[int]uint64), buckets: make([]uint64, len(latencyBuckets)+1)}
+		m.requests[key] = r
+	}
+
+	seconds := latency.Seconds()
+
+	r.counts[statusCode]++
+	r.sum += seconds
+	r.buckets[sort.SearchFloat64s(latencyBuckets, seconds)]++
+}
+
+func (m *httpMetrics) families() []MetricFamily {
+	m.Lock()
+	defer m.Unlock()
+
+	requests := MetricFamily{Name: "nnf_ec_http_requests_total", Help: "HTTP requests by route and status code", Type: CounterMetricType}
+	latency := MetricFamily{Name: "nnf_ec_http_request_duration_seconds", Help: "HTTP request latency by route", Type: HistogramMetricType}
+
+	for key, r := range m.requests {
+		for statusCode, count := range r.counts {
+			requests.Samples = append(requests.Samples, MetricSample{
+				Labels: MetricLabels{"method": key.method, "route": key.route, "code": strconv.Itoa(statusCode)},
+				Value:  float64(count),
+			})
+		}
+
+		total := uint64(0)
+		for idx, count := range r.buckets {
+			total += count
+
+			le := "+Inf"
+			if idx < len(latencyBuckets) {
+				le = strconv.FormatFloat(latencyBuckets[idx], 'g', -1, 64)
+			}
+
+			latency.Samples = append(latency.Samples, MetricSample{
+				Suffix: "_bucket",
+				Labels: MetricLabels{"method": key.method, "route": key.route, "le": le},
+				Value:  float64(total),
+			})
+		}
+
+		latency.Samples = append(latency.Samples,
+			MetricSample{Suffix: "_sum", Labels: MetricLabels{"method": key.method, "route": key.route}, Value: r.sum},
+			MetricSample{Suffix: "_count", Labels: MetricLabels{"method": key.method, "route": key.route}, Value: float64(total)},
+		)
+	}
+
+	return []MetricFamily{requests, latency}
+}
+
+func (c *Controller) metricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.URL.Path
+		if current := mux.CurrentRoute(r); current != nil {
+			if template, err := current.GetPathTemplate(); err == nil {
+				route = template
+			}
+		}
+
+		start := time.Now()
+
+		recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		c.httpMetrics.record(r.Method, route, recorder.statusCode, time.Since(start))
+	})
+}
+
+// attachMetrics adds the metrics endpoint to the router
+func (c *Controller) attachMetrics(router *mux.Router) {
+	router.Path(MetricsPath).Methods(GET_METHOD).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		families := c.httpMetrics.families()
+		for _, api := range c.Routers {
+			if m, ok := api.(MetricsReportingRouter); ok {
+				families = append(families, m.Metrics()...)
+			}
+		}
+
+		openMetrics := strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text")
+		if openMetrics {
+			w.Header().Set("Content-Type", "application/openmetrics-text; version=1.0.0; charset=utf-8")
+		} else {
+			w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		}
+
+		WriteMetrics(w, families, openMetrics)
+	})
+}
+
+// WriteMetrics writes the metric families in the Prometheus text exposition format, or the OpenMetrics
+// text format if requested
+func WriteMetrics(w io.Writer, families []MetricFamily, openMetrics bool) {
+	for _, f := range families {
+		name := f.Name
+		if openMetrics && f.Type == CounterMetricType {
+			name = strings.TrimSuffix(name, "_total")
+		}
+
+		fmt.Fprintf(w, "# HELP %s %s\n", name, escapeMetricHelp(f.Help))
+		fmt.Fprintf(w, "# TYPE %s %s\n", name, f.Type)
+
+		for _, s := range f.Samples {
+			fmt.Fprintf(w, "%s%s%s %s\n", f.Name, s.Suffix, formatMetricLabels(s.Labels), formatMetricValue(s.Value))
+		}
+	}
+
+	if openMetrics {
+		fmt.Fprint(w, "# EOF\n")
+	}
+}
+
+func formatMetricLabels(labels MetricLabels) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	pairs := make([]string, len(names))
+	for idx, name := range names {
+		pairs[idx] = fmt.Sprintf(`%s="%s"`, name, escapeMetricLabel(labels[name]))
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatMetricValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+
+	// Integral values, such as byte counts, are written without an exponent where exactly representable
+	if value == math.Trunc(value) && math.Abs(value) < 1<<53 {
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func escapeMetricHelp(help string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help)
+}
+
+func escapeMetricLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(value)
+}
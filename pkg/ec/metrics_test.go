@@ -0,0 +1,105 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type metricsTestRouter struct{}
+
+func (*metricsTestRouter) Name() string      { return "MetricsTestRouter" }
+func (*metricsTestRouter) Init(Logger) error { return nil }
+func (*metricsTestRouter) Start() error      { return nil }
+func (*metricsTestRouter) Close() error      { return nil }
+
+func (*metricsTestRouter) Routes() Routes {
+	return Routes{{
+		Name:   "ThingGet",
+		Method: GET_METHOD,
+		Path:   "/things/{ThingId}",
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+			EncodeResponse(&testModel{Message: "thing"}, nil, w)
+		},
+	}}
+}
+
+func (*metricsTestRouter) Metrics() []MetricFamily {
+	return []MetricFamily{{
+		Name:    "nnf_ec_things",
+		Help:    "Things with \"quoted\" labels",
+		Type:    GaugeMetricType,
+		Samples: []MetricSample{{Labels: MetricLabels{"name": `a"b`}, Value: 2}},
+	}}
+}
+
+func TestMetrics(t *testing.T) {
+	c := NewController("Test", 0, "test", Routers{&metricsTestRouter{}})
+	if err := c.Init(NewDefaultTestOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	c.router.Use(c.metricsMiddleware)
+	c.Attach(c.router, nil)
+	c.attachMetrics(c.router)
+
+	for _, id := range []string{"1", "2"} {
+		c.router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(GET_METHOD, "/things/"+id, nil))
+	}
+
+	scrape := func(accept string) string {
+		r := httptest.NewRequest(GET_METHOD, MetricsPath, nil)
+		r.Header.Set("Accept", accept)
+
+		w := httptest.NewRecorder()
+		c.router.ServeHTTP(w, r)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("Scrape: Expected: %d Actual: %d", http.StatusOK, w.Code)
+		}
+
+		return w.Body.String()
+	}
+
+	body := scrape("text/plain")
+
+	// Requests are recorded against the route template, not the request path
+	for _, line := range []string{
+		`nnf_ec_http_requests_total{code="200",method="GET",route="/things/{ThingId}"} 2`,
+		`nnf_ec_http_request_duration_seconds_bucket{le="+Inf",method="GET",route="/things/{ThingId}"} 2`,
+		`nnf_ec_http_request_duration_seconds_count{method="GET",route="/things/{ThingId}"} 2`,
+		`nnf_ec_things{name="a\"b"} 2`,
+	} {
+		if !strings.Contains(body, line+"\n") {
+			t.Errorf("Missing %s in:\n%s", line, body)
+		}
+	}
+
+	if strings.Contains(body, "# EOF") {
+		t.Errorf("Prometheus text format includes EOF")
+	}
+
+	body = scrape("application/openmetrics-text; version=1.0.0")
+	if !strings.Contains(body, "# TYPE nnf_ec_http_requests counter\n") || !strings.HasSuffix(body, "# EOF\n") {
+		t.Errorf("OpenMetrics format:\n%s", body)
+	}
+}
@@ -0,0 +1,332 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/yaml.v2"
+)
+
+// OpenAPI Document - The element controller describes the routes it serves as an OpenAPI 3 document,
+// generated from the Routes() of its routers. Each operation lists its path parameters, the privilege it
+// requires and the schemas of its request Model and Response, which are derived from the Go types by
+// their JSON encoding. The document is served at the URI reserved for it by the Redfish specification
+// and, like the service root, does not require authentication.
+
+const (
+	OpenAPIPath    = "/redfish/v1/openapi.yaml"
+	OpenAPIVersion = "3.0.3"
+)
+
+// OpenAPIDocument - The root of an OpenAPI document
+type OpenAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi" yaml:"openapi"`
+	Info       OpenAPIInfo                             `json:"info" yaml:"info"`
+	Paths      map[string]map[string]*OpenAPIOperation `json:"paths" yaml:"paths"`
+	Components OpenAPIComponents                       `json:"components" yaml:"components"`
+}
+
+type OpenAPIInfo struct {
+	Title   string `json:"title" yaml:"title"`
+	Version string `json:"version" yaml:"version"`
+}
+
+// OpenAPIOperation - A route of the element controller. Privileges holds the Redfish privilege required
+// to perform the operation.
+type OpenAPIOperation struct {
+	OperationId string                      `json:"operationId" yaml:"operationId"`
+	Tags        []string                    `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Parameters  []OpenAPIParameter          `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	RequestBody *OpenAPIRequestBody         `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
+	Responses   map[string]*OpenAPIResponse `json:"responses" yaml:"responses"`
+	Privileges  []Privilege                 `json:"x-privileges,omitempty" yaml:"x-privileges,omitempty"`
+}
+
+type OpenAPIParameter struct {
+	Name     string         `json:"name" yaml:"name"`
+	In       string         `json:"in" yaml:"in"`
+	Required bool           `json:"required" yaml:"required"`
+	Schema   *OpenAPISchema `json:"schema" yaml:"schema"`
+}
+
+// OpenAPIRequestBody - The request body of an operation. RequiredProperties holds the paths of the
+// properties that must be present, relative to the body.
+type OpenAPIRequestBody struct {
+	Required           bool                        `json:"required" yaml:"required"`
+	Content            map[string]OpenAPIMediaType `json:"content" yaml:"content"`
+	RequiredProperties []string                    `json:"x-requiredProperties,omitempty" yaml:"x-requiredProperties,omitempty"`
+}
+
+type OpenAPIResponse struct {
+	Description string                      `json:"description" yaml:"description"`
+	Content     map[string]OpenAPIMediaType `json:"content,omitempty" yaml:"content,omitempty"`
+}
+
+type OpenAPIMediaType struct {
+	Schema *OpenAPISchema `json:"schema" yaml:"schema"`
+}
+
+type OpenAPIComponents struct {
+	Schemas map[string]*OpenAPISchema `json:"schemas" yaml:"schemas"`
+}
+
+// OpenAPISchema - The schema of a value. A schema with no properties set accepts any value.
+type OpenAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty" yaml:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty" yaml:"type,omitempty"`
+	Format               string                    `json:"format,omitempty" yaml:"format,omitempty"`
+	Properties           map[string]*OpenAPISchema `json:"properties,omitempty" yaml:"properties,omitempty"`
+	Items                *OpenAPISchema            `json:"items,omitempty" yaml:"items,omitempty"`
+	AdditionalProperties *OpenAPISchema            `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
+}
+
+// pathVariableRegexp matches the variables of a route path, such as {StoragePoolId}, including any
+// pattern the variable must match.
+var pathVariableRegexp = regexp.MustCompile(`\{([^}:]+)(:[^}]*)?\}`)
+
+// OpenAPI returns the OpenAPI document describing the routes of the controller
+func (c *Controller) OpenAPI() *OpenAPIDocument {
+	doc := &OpenAPIDocument{
+		OpenAPI:    OpenAPIVersion,
+		Info:       OpenAPIInfo{Title: c.Name, Version: c.Version},
+		Paths:      map[string]map[string]*OpenAPIOperation{},
+		Components: OpenAPIComponents{Schemas: map[string]*OpenAPISchema{}},
+	}
+
+	schemas := newOpenAPISchemas(doc.Components.Schemas)
+
+	errorResponse := &OpenAPIResponse{
+		Description: "Error condition",
+		Content:     jsonContent(schemas.schemaOf(ErrorResponse{})),
+	}
+
+	for _, api := range c.Routers {
+		for _, r := range api.Routes() {
+			op := &OpenAPIOperation{
+				OperationId: r.Name,
+				Tags:        []string{api.Name()},
+				Responses:   map[string]*OpenAPIResponse{"default": errorResponse},
+				Privileges:  []Privilege{r.RequiredPrivilege()},
+			}
+
+			for _, match := range pathVariableRegexp.FindAllStringSubmatch(r.Path, -1) {
+				op.Parameters = append(op.Parameters, OpenAPIParameter{
+					Name:     match[1],
+					In:       "path",
+					Required: true,
+					Schema:   &OpenAPISchema{Type: "string"},
+				})
+			}
+
+			if r.Model != nil {
+				op.RequestBody = &OpenAPIRequestBody{
+					Required:           true,
+					Content:            jsonContent(schemas.schemaOf(r.Model)),
+					RequiredProperties: r.RequiredProperties,
+				}
+			}
+
+			success := &OpenAPIResponse{Description: "Success"}
+			if r.Response != nil {
+				success.Content = jsonContent(schemas.schemaOf(r.Response))
+			}
+			op.Responses["200"] = success
+
+			p := pathVariableRegexp.ReplaceAllString(r.Path, "{$1}")
+			if _, ok := doc.Paths[p]; !ok {
+				doc.Paths[p] = map[string]*OpenAPIOperation{}
+			}
+
+			doc.Paths[p][strings.ToLower(r.Method)] = op
+		}
+	}
+
+	return doc
+}
+
+// YAML returns the document encoded as YAML
+func (doc *OpenAPIDocument) YAML() ([]byte, error) {
+	return yaml.Marshal(doc)
+}
+
+func jsonContent(schema *OpenAPISchema) map[string]OpenAPIMediaType {
+	return map[string]OpenAPIMediaType{"application/json": {Schema: schema}}
+}
+
+// attachOpenAPI adds the OpenAPI document to the router. The document is encoded as YAML unless the
+// client accepts only JSON.
+func (c *Controller) attachOpenAPI(router *mux.Router) {
+	router.Path(OpenAPIPath).Methods(GET_METHOD).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		doc := c.OpenAPI()
+
+		if accept := r.Header.Get("Accept"); strings.Contains(accept, "application/json") && !strings.Contains(accept, "yaml") {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(doc)
+			return
+		}
+
+		data, err := doc.YAML()
+		if err != nil {
+			EncodeResponse(nil, NewErrInternalServerError().WithError(err).WithCause("Failed to encode OpenAPI document"), w)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Write(data)
+	})
+}
+
+// openAPISchemas derives the schemas of Go types. Structures are added to the components of the
+// document and referenced by name, which allows for recursive types.
+type openAPISchemas struct {
+	components map[string]*OpenAPISchema
+	names      map[reflect.Type]string
+}
+
+func newOpenAPISchemas(components map[string]*OpenAPISchema) *openAPISchemas {
+	return &openAPISchemas{components: components, names: map[reflect.Type]string{}}
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+func (s *openAPISchemas) schemaOf(v interface{}) *OpenAPISchema {
+	return s.schema(reflect.TypeOf(v))
+}
+
+func (s *openAPISchemas) schema(t reflect.Type) *OpenAPISchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return &OpenAPISchema{Type: "string", Format: "date-time"}
+	case rawMessageType:
+		return &OpenAPISchema{}
+	}
+
+	// Types that encode themselves have no schema that can be derived from their fields
+	if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		return &OpenAPISchema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &OpenAPISchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &OpenAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &OpenAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &OpenAPISchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &OpenAPISchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &OpenAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &OpenAPISchema{Type: "string", Format: "byte"}
+		}
+		return &OpenAPISchema{Type: "array", Items: s.schema(t.Elem())}
+	case reflect.Map:
+		return &OpenAPISchema{Type: "object", AdditionalProperties: s.schema(t.Elem())}
+	case reflect.Struct:
+		if len(t.Name()) == 0 {
+			return s.structSchema(t)
+		}
+
+		return &OpenAPISchema{Ref: "#/components/schemas/" + s.component(t)}
+	}
+
+	return &OpenAPISchema{}
+}
+
+// component returns the name of the component holding the schema of the named structure, adding the
+// component if required. Names are qualified by package only if two packages declare the same name.
+func (s *openAPISchemas) component(t reflect.Type) string {
+	if name, ok := s.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	if _, ok := s.components[name]; ok {
+		name = path.Base(t.PkgPath()) + "." + name
+	}
+
+	s.names[t] = name
+	s.components[name] = &OpenAPISchema{} // Reserve the name before any recursion
+	s.components[name] = s.structSchema(t)
+
+	return name
+}
+
+func (s *openAPISchemas) structSchema(t reflect.Type) *OpenAPISchema {
+	schema := &OpenAPISchema{Type: "object", Properties: map[string]*OpenAPISchema{}}
+	s.addProperties(schema, t)
+	return schema
+}
+
+// addProperties adds the properties of the structure's fields to the schema, following the rules of
+// encoding/json for field names and embedded structures.
+func (s *openAPISchemas) addProperties(schema *OpenAPISchema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && len(name) == 0 {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+
+			if ft.Kind() == reflect.Struct {
+				s.addProperties(schema, ft)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		schema.Properties[name] = s.schema(field.Type)
+	}
+}
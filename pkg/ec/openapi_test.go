@@ -0,0 +1,160 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+type openAPITestRouter struct{}
+
+func (*openAPITestRouter) Name() string      { return "OpenAPITestRouter" }
+func (*openAPITestRouter) Init(Logger) error { return nil }
+func (*openAPITestRouter) Start() error      { return nil }
+func (*openAPITestRouter) Close() error      { return nil }
+
+func (*openAPITestRouter) Routes() Routes {
+	handler := func(w http.ResponseWriter, r *http.Request) {}
+
+	return Routes{
+		{
+			Name:        "GroupGet",
+			Method:      GET_METHOD,
+			Path:        "/groups/{GroupId:[0-9]+}",
+			HandlerFunc: handler,
+			Response:    sf.StorageGroupV150StorageGroup{},
+		},
+		{
+			Name:               "GroupsPost",
+			Method:             POST_METHOD,
+			Path:               "/groups",
+			HandlerFunc:        handler,
+			Model:              sf.StorageGroupV150StorageGroup{},
+			RequiredProperties: []string{"Links/StoragePool"},
+			Response:           sf.StorageGroupV150StorageGroup{},
+		},
+		{
+			Name:        "GroupDelete",
+			Method:      DELETE_METHOD,
+			Path:        "/groups/{GroupId:[0-9]+}",
+			HandlerFunc: handler,
+			Privilege:   ConfigureManagerPrivilege,
+		},
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	c := NewController("Test", 0, "test", Routers{&openAPITestRouter{}})
+	if err := c.Init(NewDefaultTestOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(GET_METHOD, OpenAPIPath, nil)
+		req.Header.Set("Accept", accept)
+
+		w := httptest.NewRecorder()
+		c.Handler().ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Get %s: Expected: %d Actual: %d", accept, http.StatusOK, w.Code)
+		}
+
+		return w
+	}
+
+	w := get("application/json")
+
+	doc := OpenAPIDocument{}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.OpenAPI != OpenAPIVersion || doc.Info.Title != "Test" || doc.Info.Version != "test" {
+		t.Errorf("Document: %+v", doc)
+	}
+
+	// Path patterns are not part of the OpenAPI path
+	item, ok := doc.Paths["/groups/{GroupId}"]
+	if !ok {
+		t.Fatalf("Paths: %v", doc.Paths)
+	}
+
+	op := item["get"]
+	if op == nil || op.OperationId != "GroupGet" || len(op.Parameters) != 1 || op.Parameters[0].Name != "GroupId" || op.Parameters[0].In != "path" {
+		t.Fatalf("Get operation: %+v", op)
+	}
+
+	if op.Responses["200"].Content["application/json"].Schema.Ref != "#/components/schemas/StorageGroupV150StorageGroup" {
+		t.Errorf("Get response: %+v", op.Responses["200"])
+	}
+
+	if del := item["delete"]; del == nil || del.Responses["200"].Content != nil || len(del.Privileges) != 1 || del.Privileges[0] != ConfigureManagerPrivilege {
+		t.Errorf("Delete operation: %+v", del)
+	}
+
+	post := doc.Paths["/groups"]["post"]
+	if post == nil || post.RequestBody == nil || len(post.RequestBody.RequiredProperties) != 1 || post.Privileges[0] != ConfigureComponentsPrivilege {
+		t.Fatalf("Post operation: %+v", post)
+	}
+
+	// Component schemas follow the JSON encoding of the model, including references to other components
+	group := doc.Components.Schemas["StorageGroupV150StorageGroup"]
+	if group == nil || group.Type != "object" {
+		t.Fatalf("Group schema: %+v", group)
+	}
+
+	for name, expected := range map[string]OpenAPISchema{
+		"@odata.id":         {Type: "string"},
+		"VolumesAreExposed": {Type: "boolean"},
+		"Links":             {Ref: "#/components/schemas/StorageGroupV150Links"},
+		"Oem":               {Type: "object", AdditionalProperties: &OpenAPISchema{}},
+	} {
+		actual := group.Properties[name]
+		if actual == nil || actual.Type != expected.Type || actual.Ref != expected.Ref || (expected.AdditionalProperties == nil) != (actual.AdditionalProperties == nil) {
+			t.Errorf("Property %s: Expected: %+v Actual: %+v", name, expected, actual)
+		}
+	}
+
+	links := doc.Components.Schemas["StorageGroupV150Links"]
+	if links == nil || links.Properties["ParentStorageGroups"] == nil || links.Properties["ParentStorageGroups"].Items.Ref != "#/components/schemas/StorageGroupV150StorageGroup" {
+		t.Errorf("Links schema: %+v", links)
+	}
+
+	// YAML is returned by default
+	w = get("")
+	doc = OpenAPIDocument{}
+	if w.Header().Get("Content-Type") != "application/yaml" {
+		t.Errorf("Content-Type: %s", w.Header().Get("Content-Type"))
+	}
+
+	if err := yaml.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := doc.Paths["/groups"]["post"]; !ok {
+		t.Errorf("YAML paths: %v", doc.Paths)
+	}
+}
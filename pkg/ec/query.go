@@ -0,0 +1,418 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Query Parameters - The Redfish query parameters $expand, $select, $top, $skip and $filter are supported
+// on all GET requests by post-processing the JSON response of the route's handler. Expanded and filtered
+// resources are retrieved by issuing a GET for each referenced resource through the controller's router.
+// These internal GETs are authorized as the client's request is, but are not traced, counted, logged or
+// audited as requests of their own.
+
+const (
+	// MaxExpandLevels is the maximum number of levels supported by $expand
+	MaxExpandLevels = 3
+)
+
+type expandType int
+
+const (
+	expandNone    expandType = iota
+	expandAll                // "*" - expand all hyperlinks, including those in Links
+	expandNoLinks            // "." - expand hyperlinks not in Links
+	expandLinks              // "~" - expand hyperlinks in Links
+)
+
+type query struct {
+	expand  expandType
+	levels  int
+	selects []string
+	top     int
+	skip    int
+	filter  filterExpression
+}
+
+// parseQuery returns the query of the request, or nil if the request has no supported query parameters
+func parseQuery(values url.Values) (*query, error) {
+	q := &query{top: -1}
+	found := false
+
+	if v, ok := values["$expand"]; ok {
+		found = true
+		if err := q.parseExpand(v[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	if v, ok := values["$select"]; ok {
+		found = true
+		for _, s := range strings.Split(v[0], ",") {
+			if s = strings.TrimSpace(s); len(s) != 0 {
+				q.selects = append(q.selects, s)
+			}
+		}
+
+		if len(q.selects) == 0 {
+			return nil, NewErrBadRequest().WithCause("Query parameter $select requires a value")
+		}
+	}
+
+	for name, value := range map[string]*int{"$top": &q.top, "$skip": &q.skip} {
+		if v, ok := values[name]; ok {
+			found = true
+			n, err := strconv.Atoi(v[0])
+			if err != nil || n < 0 {
+				return nil, NewErrBadRequest().WithCause(fmt.Sprintf("Query parameter %s value '%s' is not a non-negative integer", name, v[0]))
+			}
+			*value = n
+		}
+	}
+
+	if v, ok := values["$filter"]; ok {
+		found = true
+		filter, err := parseFilter(v[0])
+		if err != nil {
+			return nil, NewErrBadRequest().WithError(err).WithCause(fmt.Sprintf("Query parameter $filter value '%s' is invalid", v[0]))
+		}
+		q.filter = filter
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return q, nil
+}
+
+func (q *query) parseExpand(value string) error {
+	q.levels = 1
+
+	kind := value
+	if idx := strings.Index(value, "("); idx != -1 {
+		if !strings.HasSuffix(value, ")") {
+			return NewErrBadRequest().WithCause(fmt.Sprintf("Query parameter $expand value '%s' is invalid", value))
+		}
+
+		kind = value[:idx]
+		option := strings.SplitN(value[idx+1:len(value)-1], "=", 2)
+		if len(option) != 2 || option[0] != "$levels" {
+			return NewErrBadRequest().WithCause(fmt.Sprintf("Query parameter $expand option '%s' not supported", value[idx:]))
+		}
+
+		levels, err := strconv.Atoi(option[1])
+		if err != nil || levels < 1 || levels > MaxExpandLevels {
+			return NewErrBadRequest().WithCause(fmt.Sprintf("Query parameter $expand levels '%s' out of range 1-%d", option[1], MaxExpandLevels))
+		}
+
+		q.levels = levels
+	}
+
+	switch kind {
+	case "*":
+		q.expand = expandAll
+	case ".":
+		q.expand = expandNoLinks
+	case "~":
+		q.expand = expandLinks
+	default:
+		return NewErrBadRequest().WithCause(fmt.Sprintf("Query parameter $expand value '%s' is invalid", value))
+	}
+
+	return nil
+}
+
+// queryMiddleware applies the query parameters of GET requests to the response of the route's handler
+func (c *Controller) queryMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != GET_METHOD {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		q, err := parseQuery(r.URL.Query())
+		if err != nil {
+			EncodeResponse(nil, err, w)
+			return
+		}
+
+		if q == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		recorder := httptest.NewRecorder()
+		next.ServeHTTP(recorder, r)
+
+		var model map[string]interface{}
+		if recorder.Code != http.StatusOK || decodeJson(recorder.Body.Bytes(), &model) != nil {
+			copyResponse(w, recorder)
+			return
+		}
+
+		if err := c.applyQuery(r, q, model); err != nil {
+			EncodeResponse(nil, err, w)
+			return
+		}
+
+		for key, values := range recorder.Header() {
+			if key != "Content-Length" {
+				w.Header()[key] = values
+			}
+		}
+
+		EncodeResponse(model, nil, w)
+	})
+}
+
+func (c *Controller) applyQuery(r *http.Request, q *query, model map[string]interface{}) error {
+	members, isCollection := model["Members"].([]interface{})
+
+	if isCollection {
+		if q.filter != nil {
+			filtered := make([]interface{}, 0, len(members))
+			for _, member := range members {
+				resource := c.resolve(r, member)
+				if resource == nil {
+					continue
+				}
+
+				match, err := q.filter.evaluate(resource)
+				if err != nil {
+					return NewErrBadRequest().WithError(err).WithCause("Query parameter $filter cannot be applied")
+				}
+
+				if match {
+					filtered = append(filtered, member)
+				}
+			}
+
+			members = filtered
+		}
+
+		model["Members@odata.count"] = len(members)
+
+		if q.skip < len(members) {
+			members = members[q.skip:]
+		} else {
+			members = []interface{}{}
+		}
+
+		if q.top >= 0 && q.top < len(members) {
+			members = members[:q.top]
+
+			next := r.URL.Query()
+			next.Set("$skip", strconv.Itoa(q.skip+q.top))
+			model["Members@odata.nextLink"] = r.URL.Path + "?" + next.Encode()
+		}
+
+		model["Members"] = members
+	}
+
+	if q.expand != expandNone {
+		c.expand(r, model, q.expand, q.levels, false)
+	}
+
+	if len(q.selects) != 0 {
+		if isCollection && q.expand != expandNone {
+			for _, member := range model["Members"].([]interface{}) {
+				if m, ok := member.(map[string]interface{}); ok {
+					project(m, q.selects)
+				}
+			}
+		} else {
+			project(model, q.selects)
+		}
+	}
+
+	return nil
+}
+
+// expand replaces the hyperlinks of the model with the referenced resources
+func (c *Controller) expand(r *http.Request, model map[string]interface{}, kind expandType, levels int, inLinks bool) {
+	if levels == 0 {
+		return
+	}
+
+	for key, value := range model {
+		links := inLinks || key == "Links"
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if isReference(v) {
+				if (kind == expandNoLinks && links) || (kind == expandLinks && !links) {
+					continue
+				}
+
+				if resource := c.resolve(r, v); resource != nil {
+					c.expand(r, resource, kind, levels-1, false)
+					model[key] = resource
+				}
+			} else {
+				c.expand(r, v, kind, levels, links)
+			}
+		case []interface{}:
+			for idx, item := range v {
+				ref, ok := item.(map[string]interface{})
+				if !ok || !isReference(ref) {
+					continue
+				}
+
+				if (kind == expandNoLinks && links) || (kind == expandLinks && !links) {
+					continue
+				}
+
+				if resource := c.resolve(r, ref); resource != nil {
+					c.expand(r, resource, kind, levels-1, false)
+					v[idx] = resource
+				}
+			}
+		}
+	}
+}
+
+func isReference(m map[string]interface{}) bool {
+	_, ok := m["@odata.id"]
+	return ok && len(m) == 1
+}
+
+// resolve returns the resource referenced by the hyperlink, or the resource itself if it is already expanded
+func (c *Controller) resolve(r *http.Request, value interface{}) map[string]interface{} {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if !isReference(m) {
+		return m
+	}
+
+	path, ok := m["@odata.id"].(string)
+	if !ok || c.router == nil {
+		return nil
+	}
+
+	recorder := c.get(r, path)
+
+	var resource map[string]interface{}
+	if recorder.Code != http.StatusOK || decodeJson(recorder.Body.Bytes(), &resource) != nil {
+		return nil
+	}
+
+	return resource
+}
+
+// project removes all properties of the model not named by the selects. Selects may name nested
+// properties using '/', i.e. Status/Health. OData annotations of the resource are always retained.
+func project(model map[string]interface{}, selects []string) {
+	nested := make(map[string][]string)
+	keep := make(map[string]bool)
+
+	for _, s := range selects {
+		parts := strings.SplitN(s, "/", 2)
+		keep[parts[0]] = true
+		if len(parts) == 2 {
+			nested[parts[0]] = append(nested[parts[0]], parts[1])
+		}
+	}
+
+	for key, value := range model {
+		if strings.HasPrefix(key, "@odata.") {
+			continue
+		}
+
+		if !keep[key] {
+			delete(model, key)
+			continue
+		}
+
+		if n, ok := nested[key]; ok {
+			if m, ok := value.(map[string]interface{}); ok {
+				project(m, n)
+			}
+		}
+	}
+}
+
+type internalRequestKey struct{}
+
+// isInternalRequest returns true if the request was issued by the controller on behalf of a client's request
+func isInternalRequest(r *http.Request) bool {
+	internal, _ := r.Context().Value(internalRequestKey{}).(bool)
+	return internal
+}
+
+// clientRequestsOnly applies the middleware to the requests of clients, passing internal requests directly
+// to the next handler
+func clientRequestsOnly(middleware func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		handler := middleware(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isInternalRequest(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
+
+// get issues a GET of the path through the controller's router on behalf of the request
+func (c *Controller) get(r *http.Request, path string) *httptest.ResponseRecorder {
+	req := r.Clone(context.WithValue(r.Context(), internalRequestKey{}, true))
+	req.Method = GET_METHOD
+	req.Body = http.NoBody
+	req.ContentLength = 0
+	req.URL = &url.URL{Path: path}
+	req.RequestURI = path
+	req.Header.Del("If-Match")
+	req.Header.Del("Prefer")
+
+	recorder := httptest.NewRecorder()
+	c.router.ServeHTTP(recorder, req)
+
+	return recorder
+}
+
+func decodeJson(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+func copyResponse(w http.ResponseWriter, recorder *httptest.ResponseRecorder) {
+	for key, values := range recorder.Header() {
+		w.Header()[key] = values
+	}
+
+	w.WriteHeader(recorder.Code)
+	w.Write(recorder.Body.Bytes())
+}
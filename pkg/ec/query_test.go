@@ -0,0 +1,131 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ec
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+type queryTestRouter struct{}
+
+func (*queryTestRouter) Name() string      { return "QueryTestRouter" }
+func (*queryTestRouter) Init(Logger) error { return nil }
+func (*queryTestRouter) Start() error      { return nil }
+func (*queryTestRouter) Close() error      { return nil }
+
+const queryTestMembers = 5
+
+func (*queryTestRouter) Routes() Routes {
+	return Routes{{
+		Name:   "Widgets",
+		Method: GET_METHOD,
+		Path:   "/widgets",
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+			members := make([]map[string]string, queryTestMembers)
+			for idx := range members {
+				members[idx] = map[string]string{"@odata.id": fmt.Sprintf("/widgets/%d", idx)}
+			}
+			EncodeResponse(map[string]interface{}{"@odata.id": "/widgets", "Members": members, "Members@odata.count": len(members)}, nil, w)
+		},
+	}, {
+		Name:   "Widget",
+		Method: GET_METHOD,
+		Path:   "/widgets/{id}",
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+			id := mux.Vars(r)["id"]
+			EncodeResponse(map[string]interface{}{
+				"@odata.id": "/widgets/" + id,
+				"Id":        id,
+				"Capacity":  len(id) * 100,
+				"Status":    map[string]string{"State": "Enabled", "Health": map[bool]string{true: "OK", false: "Warning"}[id != "3"]},
+			}, nil, w)
+		},
+	}}
+}
+
+func TestQuery(t *testing.T) {
+	c := NewController("Test", 0, "test", Routers{&queryTestRouter{}})
+	if err := c.Init(NewDefaultTestOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	c.router.Use(clientRequestsOnly(c.metricsMiddleware))
+	c.router.Use(c.queryMiddleware)
+	c.Attach(c.router, nil)
+	c.attachMetrics(c.router)
+
+	get := func(query string) (int, map[string]interface{}) {
+		w := httptest.NewRecorder()
+		c.router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/widgets?"+query, nil))
+
+		model := map[string]interface{}{}
+		json.Unmarshal(w.Body.Bytes(), &model)
+		return w.Code, model
+	}
+
+	members := func(model map[string]interface{}) []map[string]interface{} {
+		list := make([]map[string]interface{}, 0)
+		for _, m := range model["Members"].([]interface{}) {
+			list = append(list, m.(map[string]interface{}))
+		}
+		return list
+	}
+
+	if _, model := get("$expand=.&$select=Id"); len(members(model)) != queryTestMembers {
+		t.Errorf("Expand: Expected %d members: %+v", queryTestMembers, model)
+	} else if m := members(model)[0]; m["Id"] != "0" || m["Capacity"] != nil {
+		t.Errorf("Expand Select: Unexpected member %+v", m)
+	}
+
+	_, model := get("$top=2&$skip=1")
+	if m := members(model); len(m) != 2 || m[0]["@odata.id"] != "/widgets/1" {
+		t.Errorf("Top Skip: Unexpected members %+v", m)
+	}
+
+	if next, _ := url.Parse(fmt.Sprint(model["Members@odata.nextLink"])); next.Query().Get("$skip") != "3" {
+		t.Errorf("Top Skip: Unexpected next link %v", model["Members@odata.nextLink"])
+	}
+
+	if _, model := get(url.Values{"$filter": {"Status/Health ne 'OK' or (Id eq '0' and not Capacity gt 100)"}}.Encode()); len(members(model)) != 2 {
+		t.Errorf("Filter: Expected 2 members: %+v", model)
+	}
+
+	for _, query := range []string{"$top=-1", "$expand=!", "$expand=*($levels=9)", url.Values{"$filter": {"Id eq"}}.Encode()} {
+		if code, _ := get(query); code != http.StatusBadRequest {
+			t.Errorf("Query '%s': Expected: %d Actual: %d", query, http.StatusBadRequest, code)
+		}
+	}
+
+	// The members retrieved to expand and filter the collection are not counted as requests of their own
+	w := httptest.NewRecorder()
+	c.router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, MetricsPath, nil))
+
+	if body := w.Body.String(); !strings.Contains(body, `nnf_ec_http_requests_total{code="200",method="GET",route="/widgets"} 3`) || strings.Contains(body, `route="/widgets/{id}"`) {
+		t.Errorf("Metrics: Expected only the collection requests:\n%s", body)
+	}
+}
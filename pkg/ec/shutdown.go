@@ -0,0 +1,175 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NearNodeFlash/nnf-ec/pkg/tracing"
+)
+
+// Graceful Shutdown - When the element controller is shut down, new mutating requests are refused
+// with 503 Service Unavailable and a Retry-After header while requests already in flight and
+// background tasks run to completion, bounded by the shutdown timeout. The server is then stopped
+// and the routers are closed in the reverse order they were started, so dependent managers close
+// before the managers and persistent stores they rely on.
+
+const defaultShutdownTimeout = 30 * time.Second
+
+// drain tracks the requests in flight and whether the element controller is shutting down
+type drain struct {
+	sync.Mutex
+	draining bool
+	inflight int
+	idle     chan struct{}
+}
+
+// begin records the start of a request, returning false if the request must be refused
+func (d *drain) begin(r *http.Request) bool {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.draining && r.Method != GET_METHOD && r.Method != http.MethodHead && r.Method != http.MethodOptions {
+		return false
+	}
+
+	d.inflight++
+
+	return true
+}
+
+// end records the completion of a request
+func (d *drain) end() {
+	d.Lock()
+	defer d.Unlock()
+
+	d.inflight--
+
+	if d.inflight == 0 && d.idle != nil {
+		close(d.idle)
+		d.idle = nil
+	}
+}
+
+// isDraining returns true if the element controller is shutting down
+func (d *drain) isDraining() bool {
+	d.Lock()
+	defer d.Unlock()
+
+	return d.draining
+}
+
+// start begins draining, returning a channel that is closed once no requests are in flight
+func (d *drain) start() <-chan struct{} {
+	d.Lock()
+	defer d.Unlock()
+
+	d.draining = true
+
+	idle := make(chan struct{})
+	if d.inflight == 0 {
+		close(idle)
+	} else {
+		d.idle = idle
+	}
+
+	return idle
+}
+
+func (opts *Options) shutdownTimeout() time.Duration {
+	if opts.ShutdownTimeout > 0 {
+		return opts.ShutdownTimeout
+	}
+
+	return defaultShutdownTimeout
+}
+
+func (c *Controller) drainMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !c.drain.begin(r) {
+			delay := c.options.shutdownTimeout()
+			EncodeResponse(nil, NewErrServiceUnavailable().WithRetryDelay(delay).WithCause("element controller is shutting down"), w)
+			return
+		}
+
+		defer c.drain.end()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Shutdown gracefully stops the element controller. Requests in flight and background tasks are given
+// until the context expires to complete; the server is then stopped and the routers closed. If the
+// requests or tasks do not complete in time the routers are left open, so work still running is not
+// cut off from the persistent store, and the error is returned.
+func (c *Controller) Shutdown(ctx context.Context) error {
+	log := c.Log
+
+	log.Info("Shutting down element controller")
+
+	errs := make([]error, 0)
+
+	select {
+	case <-c.drain.start():
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("requests did not drain: %w", ctx.Err()))
+	}
+
+	if err := Tasks.Wait(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("tasks did not drain: %w", err))
+	}
+
+	drained := len(errs) == 0
+
+	if c.processor != nil {
+		if err := c.processor.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("server shutdown failed: %w", err))
+		}
+	}
+
+	if drained {
+		for idx := len(c.Routers) - 1; idx >= 0; idx-- {
+			api := c.Routers[idx]
+			if err := api.Close(); err != nil {
+				log.Error(err, "Router close failed", "router", api.Name())
+				errs = append(errs, fmt.Errorf("router %s close failed: %w", api.Name(), err))
+			}
+		}
+	} else {
+		log.Info("Routers left open; requests or tasks are still running")
+	}
+
+	if err := tracing.ShutdownExporter(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("span export did not complete: %w", err))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		log.Error(err, "Element controller shutdown incomplete")
+		return err
+	}
+
+	log.Info("Element controller shut down")
+
+	return nil
+}
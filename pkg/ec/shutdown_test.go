@@ -0,0 +1,163 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type shutdownTestRouter struct {
+	name    string
+	closed  *[]string
+	release chan struct{}
+	started chan struct{}
+}
+
+func (router *shutdownTestRouter) Name() string { return router.name }
+func (*shutdownTestRouter) Init(Logger) error   { return nil }
+func (*shutdownTestRouter) Start() error        { return nil }
+
+func (router *shutdownTestRouter) Close() error {
+	*router.closed = append(*router.closed, router.name)
+	return nil
+}
+
+func (router *shutdownTestRouter) Routes() Routes {
+	return Routes{{
+		Name:   router.name + "Get",
+		Method: GET_METHOD,
+		Path:   "/" + router.name,
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+			EncodeResponse(&testModel{Message: router.name}, nil, w)
+		},
+	}, {
+		Name:   router.name + "Put",
+		Method: PUT_METHOD,
+		Path:   "/" + router.name,
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+			router.started <- struct{}{}
+			<-router.release
+			EncodeResponse(&testModel{Message: router.name}, nil, w)
+		},
+	}}
+}
+
+func TestShutdown(t *testing.T) {
+	closed := make([]string, 0)
+	first := &shutdownTestRouter{name: "first", closed: &closed, release: make(chan struct{}), started: make(chan struct{}, 1)}
+	second := &shutdownTestRouter{name: "second", closed: &closed, release: make(chan struct{}), started: make(chan struct{}, 1)}
+
+	c := NewController("Test", 0, "test", Routers{first, second})
+	if err := c.Init(NewDefaultTestOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	c.router.Use(c.drainMiddleware)
+	c.Attach(c.router, nil)
+
+	serve := func(method, url string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c.router.ServeHTTP(w, httptest.NewRequest(method, url, nil))
+		return w
+	}
+
+	// Start a mutating request that remains in flight until released
+	inflight := make(chan int)
+	go func() { inflight <- serve(PUT_METHOD, "/first").Code }()
+	<-first.started
+
+	shutdown := make(chan error)
+	go func() { shutdown <- c.Shutdown(context.Background()) }()
+
+	// Wait for the element controller to begin draining
+	for start := time.Now(); ; time.Sleep(10 * time.Millisecond) {
+		if c.drain.isDraining() {
+			break
+		}
+
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("element controller did not begin draining")
+		}
+	}
+
+	w := serve(PUT_METHOD, "/second")
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("PUT while draining: Expected: %d Actual: %d", http.StatusServiceUnavailable, w.Code)
+	}
+	if len(w.Header().Get("Retry-After")) == 0 {
+		t.Errorf("PUT while draining: missing Retry-After header")
+	}
+
+	if w := serve(GET_METHOD, "/second"); w.Code != http.StatusOK {
+		t.Errorf("GET while draining: Expected: %d Actual: %d", http.StatusOK, w.Code)
+	}
+
+	select {
+	case err := <-shutdown:
+		t.Fatalf("shutdown completed with a request in flight: %v", err)
+	default:
+	}
+
+	close(first.release)
+
+	if code := <-inflight; code != http.StatusOK {
+		t.Errorf("In-flight PUT: Expected: %d Actual: %d", http.StatusOK, code)
+	}
+
+	if err := <-shutdown; err != nil {
+		t.Errorf("shutdown failed: %v", err)
+	}
+
+	if expected := []string{"second", "first"}; !reflect.DeepEqual(closed, expected) {
+		t.Errorf("Router close order: Expected: %v Actual: %v", expected, closed)
+	}
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	closed := make([]string, 0)
+	router := &shutdownTestRouter{name: "router", closed: &closed, release: make(chan struct{}), started: make(chan struct{}, 1)}
+
+	c := NewController("Test", 0, "test", Routers{router})
+	if err := c.Init(NewDefaultTestOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	c.router.Use(c.drainMiddleware)
+	c.Attach(c.router, nil)
+
+	go c.router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(PUT_METHOD, "/router", nil))
+	<-router.started
+	defer close(router.release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	if err := c.Shutdown(ctx); err == nil {
+		t.Errorf("shutdown with a request in flight past the deadline: Expected error")
+	}
+
+	if len(closed) != 0 {
+		t.Errorf("router closed with a request still in flight")
+	}
+}
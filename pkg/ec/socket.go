@@ -0,0 +1,125 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ec
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/user"
+	"strconv"
+)
+
+// Unix Domain Socket - The element controller can serve requests on a Unix domain socket in addition to,
+// or instead of, TCP. The socket is intended for local clients, such as the NNF node agent; access is
+// controlled by the permissions and group of the socket file. The credentials of the connecting process
+// are read from the socket and are available to the authorization layer through PeerCredentialsFromRequest.
+
+const (
+	// DefaultSocketPath is the conventional path of the Unix domain socket; local clients use it when it exists
+	DefaultSocketPath = "/var/run/nnf-ec.sock"
+
+	defaultSocketMode = "0660"
+)
+
+// PeerCredentials - The credentials of the process connected to the element controller's Unix socket
+type PeerCredentials struct {
+	Pid int32
+	Uid uint32
+	Gid uint32
+}
+
+func (c *PeerCredentials) String() string {
+	return fmt.Sprintf("pid=%d uid=%d gid=%d", c.Pid, c.Uid, c.Gid)
+}
+
+type peerCredentialsContextKey struct{}
+
+// PeerCredentialsFromRequest returns the credentials of the process that made the request, or nil if
+// the request was not received on the Unix socket.
+func PeerCredentialsFromRequest(r *http.Request) *PeerCredentials {
+	creds, _ := r.Context().Value(peerCredentialsContextKey{}).(*PeerCredentials)
+	return creds
+}
+
+// connContext records the peer credentials of Unix socket connections in the connection's context
+func connContext(ctx context.Context, conn net.Conn) context.Context {
+	if uc, ok := conn.(*net.UnixConn); ok {
+		if creds, err := peerCredentials(uc); err == nil {
+			return context.WithValue(ctx, peerCredentialsContextKey{}, creds)
+		}
+	}
+
+	return ctx
+}
+
+// listenUnix creates the Unix socket and applies the configured permissions and group to the socket file
+func listenUnix(opts Options) (net.Listener, error) {
+	mode, err := strconv.ParseUint(opts.socketMode(), 8, 32)
+	if err != nil {
+		return nil, fmt.Errorf("Socket mode %s not valid: %w", opts.SocketMode, err)
+	}
+
+	gid := -1
+	if len(opts.SocketGroup) != 0 {
+		group, err := user.LookupGroup(opts.SocketGroup)
+		if err != nil {
+			return nil, err
+		}
+
+		if gid, err = strconv.Atoi(group.Gid); err != nil {
+			return nil, err
+		}
+	}
+
+	// Remove a socket left behind by a previous run of the element controller
+	if info, err := os.Lstat(opts.SocketPath); err == nil && info.Mode()&os.ModeSocket != 0 {
+		os.Remove(opts.SocketPath)
+	}
+
+	listener, err := net.Listen("unix", opts.SocketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(opts.SocketPath, os.FileMode(mode)); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	if gid != -1 {
+		if err := os.Chown(opts.SocketPath, -1, gid); err != nil {
+			listener.Close()
+			return nil, err
+		}
+	}
+
+	return listener, nil
+}
+
+func (opts *Options) socketMode() string {
+	if len(opts.SocketMode) == 0 {
+		return defaultSocketMode
+	}
+
+	return opts.SocketMode
+}
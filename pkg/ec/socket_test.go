@@ -0,0 +1,105 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ec
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+type socketTestRouter struct{}
+
+func (*socketTestRouter) Name() string      { return "SocketTestRouter" }
+func (*socketTestRouter) Init(Logger) error { return nil }
+func (*socketTestRouter) Start() error      { return nil }
+func (*socketTestRouter) Close() error      { return nil }
+
+func (*socketTestRouter) Routes() Routes {
+	return Routes{{
+		Name:   "PeerGet",
+		Method: GET_METHOD,
+		Path:   "/peer",
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+			EncodeResponse(PeerCredentialsFromRequest(r), nil, w)
+		},
+	}}
+}
+
+func TestSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nnf-ec.sock")
+
+	opts := NewDefaultOptions()
+	opts.Http = true
+	opts.SocketPath = path
+	opts.SocketMode = "0600"
+	opts.SocketOnly = true
+
+	c := NewController("Test", 0, "test", Routers{&socketTestRouter{}})
+
+	c.Init(opts)
+	defer c.Close()
+
+	go c.Run()
+
+	var info os.FileInfo
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		if info, _ = os.Stat(path); info != nil && info.Mode().Perm() == 0600 {
+			break
+		}
+	}
+
+	if info == nil || info.Mode()&os.ModeSocket == 0 || info.Mode().Perm() != 0600 {
+		t.Fatalf("Unexpected socket file: %v", info)
+	}
+
+	client := http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", path)
+			},
+		},
+	}
+
+	rsp, err := client.Get("http://localhost/peer")
+	if err != nil {
+		t.Fatalf("Request on socket failed: %v", err)
+	}
+	defer rsp.Body.Close()
+
+	var creds *PeerCredentials
+	if err := json.NewDecoder(rsp.Body).Decode(&creds); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if runtime.GOOS != "linux" {
+		return
+	}
+
+	if creds == nil || creds.Uid != uint32(os.Getuid()) || creds.Gid != uint32(os.Getgid()) || creds.Pid != int32(os.Getpid()) {
+		t.Errorf("Unexpected peer credentials: %+v", creds)
+	}
+}
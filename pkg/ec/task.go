@@ -0,0 +1,432 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ec
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+	"github.com/NearNodeFlash/nnf-ec/pkg/tracing"
+)
+
+// Task Service - Long running operations, such as storage pool creation, can be run as a task when the
+// client includes the "Prefer: respond-async" header. The request is accepted with 202 Accepted, the task
+// resource, and a Location of the task monitor; the task monitor returns 202 Accepted until the operation
+// completes, then returns the operation's final response. Requests without the header run synchronously.
+
+const (
+	TaskServiceOdataId = "/redfish/v1/TaskService"
+	TasksOdataId       = TaskServiceOdataId + "/Tasks"
+	TaskMonitorsPath   = TaskServiceOdataId + "/TaskMonitors"
+
+	TaskOdataType = "#Task.v1_5_0.Task"
+
+	// Number of completed tasks retained before the oldest completed task is removed
+	maxCompletedTasks = 128
+)
+
+type TaskState string
+
+const (
+	TaskNew         TaskState = "New"
+	TaskRunning     TaskState = "Running"
+	TaskCompleted   TaskState = "Completed"
+	TaskException   TaskState = "Exception"
+	TaskInterrupted TaskState = "Interrupted"
+)
+
+// Task - A long running operation
+type Task struct {
+	Id              string              `json:"Id"`
+	Name            string              `json:"Name"`
+	Method          string              `json:"Method"`
+	Uri             string              `json:"Uri"`
+	State           TaskState           `json:"State"`
+	PercentComplete int64               `json:"PercentComplete"`
+	Messages        []sf.MessageMessage `json:"Messages,omitempty"`
+	StartTime       time.Time           `json:"StartTime"`
+	EndTime         time.Time           `json:"EndTime,omitempty"`
+
+	// The final response of the operation, returned by the task monitor
+	StatusCode  int    `json:"StatusCode,omitempty"`
+	ContentType string `json:"ContentType,omitempty"`
+	Response    []byte `json:"Response,omitempty"`
+
+	mgr *TaskManager
+}
+
+// TaskFunc - The operation run by a task. The returned model and error are encoded as the
+// operation's response. The context is that of the request that started the operation and carries
+// the task running it; see TaskFromContext.
+type TaskFunc func(ctx context.Context) (interface{}, error)
+
+func (t *Task) OdataId() string    { return fmt.Sprintf("%s/%s", TasksOdataId, t.Id) }
+func (t *Task) MonitorUri() string { return fmt.Sprintf("%s/%s", TaskMonitorsPath, t.Id) }
+func (t *Task) IsComplete() bool   { return t.State != TaskNew && t.State != TaskRunning }
+
+type taskContextKey struct{}
+
+// TaskFromContext returns the task running the operation, or nil if the operation runs synchronously.
+// Operations report their progress through the task so it is visible to clients polling the task.
+func TaskFromContext(ctx context.Context) *Task {
+	t, _ := ctx.Value(taskContextKey{}).(*Task)
+	return t
+}
+
+// SetPercentComplete records the progress of the task. It is safe to call on a nil task.
+func (t *Task) SetPercentComplete(percent int64) {
+	if t == nil {
+		return
+	}
+
+	t.mgr.Lock()
+	t.PercentComplete = percent
+	t.mgr.save(t)
+	t.mgr.Unlock()
+}
+
+// AddMessage records a message against the task. It is safe to call on a nil task.
+func (t *Task) AddMessage(message string) {
+	if t == nil {
+		return
+	}
+
+	t.mgr.Lock()
+	t.Messages = append(t.Messages, sf.MessageMessage{Message: message, Severity: string(sf.OK_RH)})
+	t.mgr.save(t)
+	t.mgr.Unlock()
+}
+
+// Model fills in the Redfish representation of the task
+func (t *Task) Model(model *sf.TaskV150Task) {
+	model.OdataId = t.OdataId()
+	model.OdataType = TaskOdataType
+	model.Id = t.Id
+	model.Name = t.Name
+	model.TaskState = sf.TaskV150TaskState(t.State)
+	model.PercentComplete = t.PercentComplete
+	model.Messages = t.Messages
+	model.StartTime = t.StartTime
+	model.EndTime = t.EndTime
+	model.TaskMonitor = t.MonitorUri()
+	model.Payload = sf.TaskV150Payload{HttpOperation: t.Method, TargetUri: t.Uri}
+
+	switch t.State {
+	case TaskException, TaskInterrupted:
+		model.TaskStatus = sf.CRITICAL_RH
+	default:
+		model.TaskStatus = sf.OK_RH
+	}
+}
+
+// TaskStore provides persistence of task records so tasks survive a restart of the element controller
+type TaskStore interface {
+	Load() ([]*Task, error)
+	Save(t *Task) error
+	Delete(id string) error
+}
+
+// TaskManager tracks all tasks of the element controller
+type TaskManager struct {
+	sync.Mutex
+	tasks   map[string]*Task
+	nextId  int
+	store   TaskStore
+	log     Logger
+	running sync.WaitGroup
+}
+
+var Tasks = NewTaskManager()
+
+// NewTaskManager returns a task manager with no tasks
+func NewTaskManager() *TaskManager {
+	return &TaskManager{tasks: make(map[string]*Task)}
+}
+
+// RespondAsync returns true if the client prefers an asynchronous response to the request
+func RespondAsync(r *http.Request) bool {
+	for _, prefer := range r.Header.Values("Prefer") {
+		for _, value := range strings.Split(prefer, ",") {
+			if strings.TrimSpace(value) == "respond-async" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// SetStore sets the persistent store of the task manager and restores any tasks recorded in the
+// store. Tasks that were running when the element controller stopped are marked Interrupted.
+func (m *TaskManager) SetStore(store TaskStore, log Logger) error {
+	m.Lock()
+	defer m.Unlock()
+
+	m.store = store
+	m.log = log
+
+	tasks, err := store.Load()
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tasks {
+		t.mgr = m
+
+		if !t.IsComplete() {
+			t.State = TaskInterrupted
+			t.EndTime = time.Now()
+			t.StatusCode = http.StatusInternalServerError
+			t.Messages = append(t.Messages, sf.MessageMessage{Message: "Task interrupted by restart of the element controller", Severity: string(sf.CRITICAL_RH)})
+
+			if err := store.Save(t); err != nil {
+				log.Error(err, "Failed to save interrupted task", "id", t.Id)
+			}
+		}
+
+		m.tasks[t.Id] = t
+
+		if id, err := strconv.Atoi(t.Id); err == nil && id >= m.nextId {
+			m.nextId = id + 1
+		}
+	}
+
+	return nil
+}
+
+// Start runs the operation as a task if the client requested an asynchronous response, returning
+// true if the task was started. The task resource is written as the 202 Accepted response.
+func (m *TaskManager) Start(w http.ResponseWriter, r *http.Request, name string, fn TaskFunc) bool {
+	if !RespondAsync(r) {
+		return false
+	}
+
+	m.Lock()
+
+	t := &Task{
+		Id:        strconv.Itoa(m.nextId),
+		Name:      name,
+		Method:    r.Method,
+		Uri:       r.URL.Path,
+		State:     TaskRunning,
+		StartTime: time.Now(),
+		mgr:       m,
+	}
+
+	m.nextId++
+	m.tasks[t.Id] = t
+	m.save(t)
+
+	model := sf.TaskV150Task{}
+	t.Model(&model)
+
+	m.expire()
+	m.Unlock()
+
+	m.running.Add(1)
+	go m.run(context.WithoutCancel(r.Context()), t, fn)
+
+	w.Header().Set("Location", t.MonitorUri())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	EncodeResponse(model, nil, w)
+
+	return true
+}
+
+// run performs the task operation on behalf of the request that started it. The operation is passed
+// the request context so it is traced as part of the request, and carries the task so the operation
+// can report its progress.
+func (m *TaskManager) run(ctx context.Context, t *Task, fn TaskFunc) {
+	defer m.running.Done()
+
+	ctx, span := tracing.StartSpan(ctx, "Task "+t.Name, tracing.SpanKindInternal)
+	span.SetAttribute("task.id", t.Id)
+
+	model, err := fn(context.WithValue(ctx, taskContextKey{}, t))
+	span.End(err)
+
+	rw := NewResponseWriter()
+	EncodeResponse(model, err, rw)
+
+	m.Lock()
+	defer m.Unlock()
+
+	t.EndTime = time.Now()
+	t.StatusCode = rw.StatusCode
+	t.ContentType = rw.Hdr.Get("Content-Type")
+	t.Response = rw.Buffer.Bytes()
+
+	if err != nil {
+		t.State = TaskException
+		t.Messages = append(t.Messages, sf.MessageMessage{Message: err.Error(), Severity: string(sf.CRITICAL_RH)})
+	} else {
+		t.State = TaskCompleted
+		t.PercentComplete = 100
+	}
+
+	m.save(t)
+}
+
+// Wait blocks until all running tasks complete or the context expires
+func (m *TaskManager) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		m.running.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// save records the task in the store; called with the lock held
+func (m *TaskManager) save(t *Task) {
+	if m.store != nil {
+		if err := m.store.Save(t); err != nil {
+			m.log.Error(err, "Failed to save task", "id", t.Id)
+		}
+	}
+}
+
+// remove deletes the task; called with the lock held
+func (m *TaskManager) remove(t *Task) {
+	delete(m.tasks, t.Id)
+
+	if m.store != nil {
+		if err := m.store.Delete(t.Id); err != nil {
+			m.log.Error(err, "Failed to delete task", "id", t.Id)
+		}
+	}
+}
+
+// expire removes the oldest completed tasks beyond the retention limit; called with the lock held
+func (m *TaskManager) expire() {
+	completed := make([]*Task, 0)
+	for _, t := range m.tasks {
+		if t.IsComplete() {
+			completed = append(completed, t)
+		}
+	}
+
+	if len(completed) <= maxCompletedTasks {
+		return
+	}
+
+	sort.Slice(completed, func(i, j int) bool { return completed[i].EndTime.Before(completed[j].EndTime) })
+
+	for _, t := range completed[:len(completed)-maxCompletedTasks] {
+		m.remove(t)
+	}
+}
+
+// Get returns a copy of the task, or nil if the task does not exist
+func (m *TaskManager) Get(id string) *Task {
+	m.Lock()
+	defer m.Unlock()
+
+	t, ok := m.tasks[id]
+	if !ok {
+		return nil
+	}
+
+	c := *t
+	c.Messages = append([]sf.MessageMessage{}, t.Messages...)
+
+	return &c
+}
+
+// List returns the ids of all tasks in ascending order
+func (m *TaskManager) List() []string {
+	m.Lock()
+	defer m.Unlock()
+
+	ids := make([]string, 0, len(m.tasks))
+	for id := range m.tasks {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		a, _ := strconv.Atoi(ids[i])
+		b, _ := strconv.Atoi(ids[j])
+		return a < b
+	})
+
+	return ids
+}
+
+// Delete removes a completed task
+func (m *TaskManager) Delete(id string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	t, ok := m.tasks[id]
+	if !ok {
+		return NewErrNotFound().WithCause(fmt.Sprintf("Task %s not found", id))
+	}
+
+	if !t.IsComplete() {
+		return NewErrBadRequest().WithCause(fmt.Sprintf("Task %s is running", id))
+	}
+
+	m.remove(t)
+
+	return nil
+}
+
+// Monitor writes the task monitor response: 202 Accepted while the task is running, otherwise the
+// final response of the operation.
+func (m *TaskManager) Monitor(id string, w http.ResponseWriter) error {
+	t := m.Get(id)
+	if t == nil {
+		return NewErrNotFound().WithCause(fmt.Sprintf("Task %s not found", id))
+	}
+
+	if !t.IsComplete() {
+		model := sf.TaskV150Task{}
+		t.Model(&model)
+
+		w.Header().Set("Location", t.MonitorUri())
+		w.Header().Set("Retry-After", "1")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		return EncodeResponse(model, nil, w)
+	}
+
+	if len(t.ContentType) != 0 {
+		w.Header().Set("Content-Type", t.ContentType)
+	}
+
+	w.WriteHeader(t.StatusCode)
+	_, err := w.Write(t.Response)
+
+	return err
+}
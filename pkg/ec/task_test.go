@@ -0,0 +1,144 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ec
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+type testTaskStore struct {
+	tasks map[string]Task
+}
+
+func (s *testTaskStore) Load() ([]*Task, error) {
+	tasks := make([]*Task, 0)
+	for _, t := range s.tasks {
+		t := t
+		tasks = append(tasks, &t)
+	}
+	return tasks, nil
+}
+
+func (s *testTaskStore) Save(t *Task) error     { s.tasks[t.Id] = *t; return nil }
+func (s *testTaskStore) Delete(id string) error { delete(s.tasks, id); return nil }
+
+func TestTask(t *testing.T) {
+	store := &testTaskStore{tasks: make(map[string]Task)}
+
+	m := &TaskManager{tasks: make(map[string]*Task)}
+	if err := m.SetStore(store, logr.Discard()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Requests without a preference for an asynchronous response are not run as a task
+	if m.Start(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/test", nil), "Test", nil) {
+		t.Fatalf("Synchronous request started as a task")
+	}
+
+	release := make(chan bool)
+
+	r := httptest.NewRequest(http.MethodPost, "/test", nil)
+	r.Header.Set("Prefer", "respond-async")
+
+	w := httptest.NewRecorder()
+	progress := make(chan bool)
+	if !m.Start(w, r, "Test", func(ctx context.Context) (interface{}, error) {
+		// Operations find the task running them through the context
+		TaskFromContext(ctx).SetPercentComplete(50)
+		close(progress)
+		<-release
+		return &testModel{Message: testMessage}, nil
+	}) {
+		t.Fatalf("Asynchronous request not started as a task")
+	}
+
+	if w.Code != http.StatusAccepted {
+		t.Errorf("Task Start: Expected: %d Actual: %d", http.StatusAccepted, w.Code)
+	}
+
+	model := sf.TaskV150Task{}
+	if err := json.Unmarshal(w.Body.Bytes(), &model); err != nil {
+		t.Fatal(err)
+	}
+
+	// Clients poll the task monitor named by the Location header
+	if w.Header().Get("Location") != model.TaskMonitor {
+		t.Errorf("Task Location: Expected: %s Actual: %s", model.TaskMonitor, w.Header().Get("Location"))
+	}
+
+	<-progress
+	if task := m.Get(model.Id); task.PercentComplete != 50 || store.tasks[model.Id].PercentComplete != 50 {
+		t.Errorf("Task progress: Expected: 50 Actual: %d Saved: %d", task.PercentComplete, store.tasks[model.Id].PercentComplete)
+	}
+
+	w = httptest.NewRecorder()
+	if err := m.Monitor(model.Id, w); err != nil || w.Code != http.StatusAccepted {
+		t.Errorf("Running Task Monitor: Expected: %d Actual: %d Error: %v", http.StatusAccepted, w.Code, err)
+	}
+
+	close(release)
+
+	for start := time.Now(); !m.Get(model.Id).IsComplete(); time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("Task %s did not complete", model.Id)
+		}
+	}
+
+	w = httptest.NewRecorder()
+	if err := m.Monitor(model.Id, w); err != nil || w.Code != http.StatusOK {
+		t.Fatalf("Completed Task Monitor: Expected: %d Actual: %d Error: %v", http.StatusOK, w.Code, err)
+	}
+
+	rsp := testModel{}
+	if err := json.Unmarshal(w.Body.Bytes(), &rsp); err != nil || rsp.Message != testMessage {
+		t.Errorf("Completed Task Monitor response incorrect: %s", w.Body.String())
+	}
+
+	// A task running when the element controller stopped is restored as interrupted
+	running := store.tasks[model.Id]
+	running.Id, running.State = "7", TaskRunning
+	store.tasks[running.Id] = running
+
+	m = &TaskManager{tasks: make(map[string]*Task)}
+	if err := m.SetStore(store, logr.Discard()); err != nil {
+		t.Fatal(err)
+	}
+
+	if task := m.Get("7"); task == nil || task.State != TaskInterrupted {
+		t.Errorf("Restored running task not interrupted: %+v", task)
+	}
+
+	if task := m.Get(model.Id); task == nil || task.State != TaskCompleted {
+		t.Errorf("Restored completed task incorrect: %+v", task)
+	}
+
+	if m.nextId != 8 {
+		t.Errorf("Next task id after restore: Expected: 8 Actual: %d", m.nextId)
+	}
+}
@@ -0,0 +1,171 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ec
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// TLSEnabled returns true if the element controller is configured to serve HTTPS
+func (opts *Options) TLSEnabled() bool {
+	return len(opts.TLSCertFile) != 0 || len(opts.TLSKeyFile) != 0
+}
+
+// certificateReloader holds the server certificate and optional client CA bundle used by
+// the HTTP server. The files are re-read on SIGHUP so certificates can be rotated without
+// restarting the element controller.
+type certificateReloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+
+	log Logger
+
+	sync.RWMutex
+	certificate *tls.Certificate
+	clientCAs   *x509.CertPool
+
+	signals chan os.Signal
+}
+
+func newCertificateReloader(opts Options, log Logger) (*certificateReloader, error) {
+	if len(opts.TLSCertFile) == 0 || len(opts.TLSKeyFile) == 0 {
+		return nil, fmt.Errorf("TLS requires both a certificate and a key file")
+	}
+
+	r := &certificateReloader{
+		certFile:     opts.TLSCertFile,
+		keyFile:      opts.TLSKeyFile,
+		clientCAFile: opts.TLSClientCAFile,
+		log:          log,
+	}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *certificateReloader) load() error {
+	certificate, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("Failed to load key pair cert: %s key: %s: %w", r.certFile, r.keyFile, err)
+	}
+
+	var clientCAs *x509.CertPool
+	if len(r.clientCAFile) != 0 {
+		pem, err := os.ReadFile(r.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("Failed to read client CA file %s: %w", r.clientCAFile, err)
+		}
+
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("No certificates found in client CA file %s", r.clientCAFile)
+		}
+	}
+
+	r.Lock()
+	r.certificate = &certificate
+	r.clientCAs = clientCAs
+	r.Unlock()
+
+	return nil
+}
+
+// watch reloads the certificates each time the process receives SIGHUP. A failed reload
+// is logged and the previously loaded certificates remain in use.
+func (r *certificateReloader) watch() {
+	r.signals = make(chan os.Signal, 1)
+	signal.Notify(r.signals, syscall.SIGHUP)
+
+	go func() {
+		for range r.signals {
+			if err := r.load(); err != nil {
+				r.log.Error(err, "Failed to reload TLS certificates")
+				continue
+			}
+
+			r.log.Info("Reloaded TLS certificates", "cert", r.certFile, "clientCA", r.clientCAFile)
+		}
+	}()
+}
+
+func (r *certificateReloader) stop() {
+	if r.signals != nil {
+		signal.Stop(r.signals)
+		close(r.signals)
+		r.signals = nil
+	}
+}
+
+// config returns the server TLS configuration. The configuration is resolved for each new
+// connection so that reloaded certificates take effect immediately.
+func (r *certificateReloader) config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			r.RLock()
+			defer r.RUnlock()
+
+			return r.certificate, nil
+		},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.RLock()
+			defer r.RUnlock()
+
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.certificate},
+			}
+
+			if r.clientCAs != nil {
+				config.ClientCAs = r.clientCAs
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+
+			return config, nil
+		},
+	}
+}
+
+// TLSClientIdentity returns the subject of the verified client certificate presented with the
+// request, or an empty string if the request was not made over mutual TLS.
+func TLSClientIdentity(r *http.Request) string {
+	return tlsClientIdentity(r.TLS)
+}
+
+// tlsClientIdentity returns the subject of the verified client certificate, or an empty
+// string if the connection did not present one.
+func tlsClientIdentity(state *tls.ConnectionState) string {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return ""
+	}
+
+	return state.VerifiedChains[0][0].Subject.String()
+}
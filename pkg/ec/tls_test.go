@@ -0,0 +1,186 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ec
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+type testCertificate struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCertificate(t *testing.T, name string, serial int64, parent *testCertificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, _ := x509.ParseCertificate(der)
+
+	return &testCertificate{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func (c *testCertificate) write(t *testing.T, certFile, keyFile string) {
+	der, err := x509.MarshalECPrivateKey(c.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(certFile, c.pem, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (c *testCertificate) keyPair(t *testing.T) tls.Certificate {
+	der, _ := x509.MarshalECPrivateKey(c.key)
+	pair, err := tls.X509KeyPair(c.pem, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pair
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+
+	ca := newTestCertificate(t, "Test CA", 1, nil)
+	server := newTestCertificate(t, "Test Server", 2, ca)
+	client := newTestCertificate(t, "Test Client", 3, ca)
+
+	opts := Options{
+		Http:            true,
+		Log:             true,
+		TLSCertFile:     filepath.Join(dir, "server.crt"),
+		TLSKeyFile:      filepath.Join(dir, "server.key"),
+		TLSClientCAFile: filepath.Join(dir, "ca.crt"),
+	}
+
+	server.write(t, opts.TLSCertFile, opts.TLSKeyFile)
+	if err := os.WriteFile(opts.TLSClientCAFile, ca.pem, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewController("Test", 8082, "test", Routers{NewTestApiRouter()})
+
+	c.Init(&opts)
+	defer c.Close()
+
+	go c.Run()
+	time.Sleep(1 * time.Second)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	url := fmt.Sprintf("https://localhost:%d/test", c.Port)
+
+	get := func(certificates []tls.Certificate) (*http.Response, error) {
+		client := http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{RootCAs: roots, Certificates: certificates},
+			},
+		}
+
+		return client.Get(url)
+	}
+
+	if _, err := get(nil); err == nil {
+		t.Errorf("Request without a client certificate succeeded")
+	}
+
+	rsp, err := get([]tls.Certificate{client.keyPair(t)})
+	if err != nil {
+		t.Fatalf("Request with a client certificate failed: %v", err)
+	}
+	rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		t.Errorf("Test Endpoint Failed %d", rsp.StatusCode)
+	}
+
+	// Rotate the server certificate and confirm the new certificate is served after SIGHUP
+	rotated := newTestCertificate(t, "Test Server", 4, ca)
+	rotated.write(t, opts.TLSCertFile, opts.TLSKeyFile)
+
+	if err := syscall.Kill(os.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(500 * time.Millisecond)
+
+	rsp, err = get([]tls.Certificate{client.keyPair(t)})
+	if err != nil {
+		t.Fatalf("Request after certificate reload failed: %v", err)
+	}
+	rsp.Body.Close()
+
+	if serial := rsp.TLS.PeerCertificates[0].SerialNumber; serial.Cmp(rotated.cert.SerialNumber) != 0 {
+		t.Errorf("Server certificate not reloaded: Expected Serial: %s Actual: %s", rotated.cert.SerialNumber, serial)
+	}
+}
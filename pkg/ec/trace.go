@@ -0,0 +1,88 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/NearNodeFlash/nnf-ec/pkg/tracing"
+)
+
+// Request Tracing - Every request is assigned a request ID, taken from the X-Request-Id header if the
+// client supplied a usable one and generated otherwise, which is returned in the X-Request-Id response
+// header. The request ID is carried in the request context, which the handlers pass to the managers, so
+// log lines, command records and spans recorded while servicing the request carry it.
+
+// statusRecorder records the status code written by a handler, and a copy of the body if body is set
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       *bytes.Buffer
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.body != nil {
+		r.body.Write(b)
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func (c *Controller) traceMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(tracing.RequestIdHeader)
+		if !tracing.ValidRequestId(id) {
+			id = tracing.NewRequestId()
+		}
+
+		w.Header().Set(tracing.RequestIdHeader, id)
+
+		name := r.URL.Path
+		if route := mux.CurrentRoute(r); route != nil {
+			if template, err := route.GetPathTemplate(); err == nil {
+				name = template
+			}
+		}
+
+		ctx, span := tracing.StartSpan(tracing.WithRequestId(r.Context(), id), r.Method+" "+name, tracing.SpanKindServer)
+		span.SetAttribute("http.method", r.Method)
+		span.SetAttribute("http.target", r.URL.Path)
+
+		recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		span.SetAttribute("http.status_code", strconv.Itoa(recorder.statusCode))
+
+		var err error
+		if recorder.statusCode >= http.StatusInternalServerError {
+			err = fmt.Errorf("%d %s", recorder.statusCode, http.StatusText(recorder.statusCode))
+		}
+
+		span.End(err)
+	})
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NearNodeFlash/nnf-ec/pkg/tracing"
+)
+
+type traceTestRouter struct {
+	requestId string
+}
+
+func (*traceTestRouter) Name() string      { return "TraceTestRouter" }
+func (*traceTestRouter) Init(Logger) error { return nil }
+func (*traceTestRouter) Start() error      { return nil }
+func (*traceTestRouter) Close() error      { return nil }
+
+func (router *traceTestRouter) Routes() Routes {
+	return Routes{{
+		Name:   "ThingGet",
+		Method: GET_METHOD,
+		Path:   "/thing",
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+			router.requestId = tracing.RequestId(r.Context())
+			EncodeResponse(&testModel{Message: router.requestId}, nil, w)
+		},
+	}}
+}
+
+func TestRequestId(t *testing.T) {
+	router := &traceTestRouter{}
+
+	c := NewController("Test", 0, "test", Routers{router})
+	if err := c.Init(NewDefaultTestOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	c.router.Use(c.traceMiddleware)
+	c.Attach(c.router, nil)
+
+	serve := func(requestId string) string {
+		r := httptest.NewRequest(GET_METHOD, "/thing", nil)
+		if len(requestId) != 0 {
+			r.Header.Set(tracing.RequestIdHeader, requestId)
+		}
+
+		w := httptest.NewRecorder()
+		c.router.ServeHTTP(w, r)
+
+		id := w.Header().Get(tracing.RequestIdHeader)
+		if id != router.requestId {
+			t.Errorf("Request ID: Response: %s Handler: %s", id, router.requestId)
+		}
+
+		return id
+	}
+
+	if id := serve("client-id-1"); id != "client-id-1" {
+		t.Errorf("Client request ID: Expected: client-id-1 Actual: %s", id)
+	}
+
+	if id := serve(""); len(id) == 0 {
+		t.Errorf("Request ID not generated")
+	}
+
+	if id := serve("bad id"); id == "bad id" || len(id) == 0 {
+		t.Errorf("Invalid client request ID not replaced: %s", id)
+	}
+}
@@ -0,0 +1,331 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// Validation - The request body of a route declaring a Model is checked against the model before the
+// handler is called. The models are generated from the Redfish and Swordfish schemas, so a property the
+// model does not define, a value the model cannot hold, or a value outside of an enumerated type's allowed
+// values is reported as an error of the Base registry naming the offending property. Annotations such as
+// @odata.type are accepted wherever they appear.
+
+// propertyError describes a property of the request body that failed validation
+type propertyError struct {
+	key      string   // Key of the Base registry message
+	property string   // Path of the property within the request body
+	args     []string // Arguments of the message
+}
+
+// validate wraps the handler so it is only called if the request body is valid for the route
+func validate(registry MessageRegistryRouter, route Route, handler http.HandlerFunc) http.HandlerFunc {
+	if route.Model == nil && len(route.RequiredProperties) == 0 {
+		return handler
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			EncodeResponse(nil, NewErrBadRequest().WithError(err), w)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		if errs := validateBody(route, body); len(errs) != 0 {
+			messages := make([]sf.MessageV111Message, len(errs))
+			for idx, e := range errs {
+				messages[idx] = baseMessage(registry, e)
+			}
+
+			EncodeResponse(nil, NewErrBadRequest().WithCause("Request body is not valid").WithExtendedInfo(messages...), w)
+			return
+		}
+
+		handler(w, r)
+	}
+}
+
+func validateBody(route Route, body []byte) []propertyError {
+	var value interface{} = map[string]interface{}{}
+
+	if len(bytes.TrimSpace(body)) != 0 {
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+
+		if err := decoder.Decode(&value); err != nil {
+			return []propertyError{{key: "MalformedJSON"}}
+		}
+	}
+
+	errs := make([]propertyError, 0)
+	if route.Model != nil {
+		errs = validateValue(reflect.TypeOf(route.Model), value, "", errs)
+	}
+
+	if object, ok := value.(map[string]interface{}); ok {
+		for _, property := range route.RequiredProperties {
+			if !hasProperty(object, property) {
+				errs = append(errs, propertyError{key: "PropertyMissing", property: property, args: []string{property}})
+			}
+		}
+	}
+
+	return errs
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// validateValue checks the decoded JSON value can be held by a value of type t
+func validateValue(t reflect.Type, value interface{}, property string, errs []propertyError) []propertyError {
+	// A null value leaves the model unchanged
+	if value == nil {
+		return errs
+	}
+
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	// Types that decode themselves are trusted to report their own errors
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+		return errs
+	}
+
+	typeError := func() []propertyError {
+		return append(errs, propertyError{key: "PropertyValueTypeError", property: property, args: []string{formatPropertyValue(value), property}})
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		return errs
+
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return typeError()
+		}
+
+		fields := jsonFields(t)
+		for _, name := range sortedKeys(object) {
+			field, ok := fields[name]
+			if !ok {
+				if !strings.Contains(name, "@") {
+					errs = append(errs, propertyError{key: "PropertyUnknown", property: joinProperty(property, name), args: []string{joinProperty(property, name)}})
+				}
+
+				continue
+			}
+
+			errs = validateValue(field.Type, object[name], joinProperty(property, name), errs)
+		}
+
+		return errs
+
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return typeError()
+		}
+
+		for _, name := range sortedKeys(object) {
+			errs = validateValue(t.Elem(), object[name], joinProperty(property, name), errs)
+		}
+
+		return errs
+
+	case reflect.Slice, reflect.Array:
+		// Byte slices are encoded as base64 strings
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			if _, ok := value.(string); !ok {
+				return typeError()
+			}
+			return errs
+		}
+
+		array, ok := value.([]interface{})
+		if !ok {
+			return typeError()
+		}
+
+		for idx, element := range array {
+			errs = validateValue(t.Elem(), element, joinProperty(property, fmt.Sprint(idx)), errs)
+		}
+
+		return errs
+
+	case reflect.String:
+		str, ok := value.(string)
+		if !ok {
+			return typeError()
+		}
+
+		if allowed, ok := enumValues(t); ok && !slices.Contains(allowed, str) {
+			return append(errs, propertyError{key: "PropertyValueNotInList", property: property, args: []string{str, property}})
+		}
+
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			return typeError()
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, ok := value.(json.Number)
+		if !ok {
+			return typeError()
+		}
+
+		if i, err := number.Int64(); err != nil || reflect.Zero(t).OverflowInt(i) {
+			return typeError()
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, ok := value.(json.Number)
+		if !ok {
+			return typeError()
+		}
+
+		if u, err := strconv.ParseUint(number.String(), 10, 64); err != nil || reflect.Zero(t).OverflowUint(u) {
+			return typeError()
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if _, ok := value.(json.Number); !ok {
+			return typeError()
+		}
+	}
+
+	return errs
+}
+
+var modelsPkgPath = reflect.TypeOf(sf.ResourceState("")).PkgPath()
+
+// enumValues returns the allowed values of an enumerated string type of the generated models
+func enumValues(t reflect.Type) ([]string, bool) {
+	if t.PkgPath() != modelsPkgPath {
+		return nil, false
+	}
+
+	return sf.EnumValues(t.Name())
+}
+
+// jsonFields returns the fields of the struct keyed by the name used in JSON, including the fields of
+// embedded structs
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && len(name) == 0 && field.Type.Kind() == reflect.Struct {
+			continue // Promoted fields are visited individually
+		}
+
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		fields[name] = field
+	}
+
+	return fields
+}
+
+// hasProperty returns true if the property path, such as "Links/StoragePool", is present in the object
+func hasProperty(object map[string]interface{}, property string) bool {
+	var value interface{} = object
+	for _, name := range strings.Split(property, "/") {
+		o, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		if value, ok = o[name]; !ok || value == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func joinProperty(property, name string) string {
+	if len(property) == 0 {
+		return name
+	}
+
+	return property + "/" + name
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func formatPropertyValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	b, _ := json.Marshal(value)
+	return string(b)
+}
+
+// baseMessage returns the Base registry message for the property error. The message registry supplies
+// the message text; without it only the message identifier and arguments are returned.
+func baseMessage(registry MessageRegistryRouter, e propertyError) sf.MessageV111Message {
+	message := sf.MessageV111Message{MessageId: "Base." + e.key, MessageArgs: e.args}
+	if registry != nil {
+		if m, ok := registry.Message("Base", e.key, e.args...); ok {
+			message = m
+		}
+	}
+
+	if len(e.property) != 0 {
+		message.RelatedProperties = []string{"#/" + e.property}
+	}
+
+	return message
+}
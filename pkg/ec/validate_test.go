@@ -0,0 +1,165 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ec
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+type validateTestRouter struct {
+	group sf.StorageGroupV150StorageGroup
+}
+
+func (*validateTestRouter) Name() string      { return "ValidateTestRouter" }
+func (*validateTestRouter) Init(Logger) error { return nil }
+func (*validateTestRouter) Start() error      { return nil }
+func (*validateTestRouter) Close() error      { return nil }
+
+func (router *validateTestRouter) Routes() Routes {
+	return Routes{{
+		Name:               "StorageGroupsPost",
+		Method:             POST_METHOD,
+		Path:               "/groups",
+		Model:              sf.StorageGroupV150StorageGroup{},
+		RequiredProperties: []string{"Links/StoragePool"},
+		HandlerFunc: func(w http.ResponseWriter, r *http.Request) {
+			router.group = sf.StorageGroupV150StorageGroup{}
+			if err := json.NewDecoder(r.Body).Decode(&router.group); err != nil {
+				EncodeResponse(nil, NewErrInternalServerError().WithError(err), w)
+				return
+			}
+
+			EncodeResponse(&router.group, nil, w)
+		},
+	}}
+}
+
+func (*validateTestRouter) Message(registryPrefix, key string, args ...string) (sf.MessageV111Message, bool) {
+	return sf.MessageV111Message{MessageId: fmt.Sprintf("%s.1.0.%s", registryPrefix, key), MessageArgs: args}, true
+}
+
+func TestValidate(t *testing.T) {
+	router := &validateTestRouter{}
+
+	c := NewController("Test", 0, "test", Routers{router})
+	if err := c.Init(NewDefaultTestOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	c.Attach(c.router, nil)
+
+	post := func(body string) (int, ErrorResponse) {
+		w := httptest.NewRecorder()
+		c.router.ServeHTTP(w, httptest.NewRequest(POST_METHOD, "/groups", strings.NewReader(body)))
+
+		rsp := ErrorResponse{}
+		if w.Code != http.StatusOK {
+			if err := json.Unmarshal(w.Body.Bytes(), &rsp); err != nil {
+				t.Fatalf("%s: %v", body, err)
+			}
+		}
+
+		return w.Code, rsp
+	}
+
+	// A valid body, including annotations, reaches the handler intact
+	if code, _ := post(`{"@odata.type": "#StorageGroup.v1_5_0.StorageGroup", "Id": "1", "Links": {"StoragePool": {"@odata.id": "/pools/1"}}}`); code != http.StatusOK {
+		t.Fatalf("Valid body: Expected: %d Actual: %d", http.StatusOK, code)
+	}
+
+	if router.group.Id != "1" || router.group.Links.StoragePool.OdataId != "/pools/1" {
+		t.Errorf("Handler body: %+v", router.group)
+	}
+
+	for _, test := range []struct {
+		body     string
+		expected []sf.MessageV111Message
+	}{
+		{
+			body: `{"Id": "1", "Links": {"StoragePool": {"@odata.id": "/pools/1"}, "Pool": 1}, "Color": "blue"}`,
+			expected: []sf.MessageV111Message{
+				{MessageId: "Base.1.0.PropertyUnknown", MessageArgs: []string{"Color"}, RelatedProperties: []string{"#/Color"}},
+				{MessageId: "Base.1.0.PropertyUnknown", MessageArgs: []string{"Links/Pool"}, RelatedProperties: []string{"#/Links/Pool"}},
+			},
+		},
+		{
+			body: `{"Id": 1, "VolumesAreExposed": "yes", "Links": {"StoragePool": {"@odata.id": "/pools/1"}}}`,
+			expected: []sf.MessageV111Message{
+				{MessageId: "Base.1.0.PropertyValueTypeError", MessageArgs: []string{"1", "Id"}, RelatedProperties: []string{"#/Id"}},
+				{MessageId: "Base.1.0.PropertyValueTypeError", MessageArgs: []string{"yes", "VolumesAreExposed"}, RelatedProperties: []string{"#/VolumesAreExposed"}},
+			},
+		},
+		{
+			body: `{"Id": "1", "Status": {"State": "Sleeping"}, "Links": {"StoragePool": {"@odata.id": "/pools/1"}}}`,
+			expected: []sf.MessageV111Message{
+				{MessageId: "Base.1.0.PropertyValueNotInList", MessageArgs: []string{"Sleeping", "Status/State"}, RelatedProperties: []string{"#/Status/State"}},
+			},
+		},
+		{
+			body: `{"Id": "1", "Links": {}}`,
+			expected: []sf.MessageV111Message{
+				{MessageId: "Base.1.0.PropertyMissing", MessageArgs: []string{"Links/StoragePool"}, RelatedProperties: []string{"#/Links/StoragePool"}},
+			},
+		},
+		{
+			body: `{"Id": "1",`,
+			expected: []sf.MessageV111Message{
+				{MessageId: "Base.1.0.MalformedJSON"},
+			},
+		},
+	} {
+		code, rsp := post(test.body)
+		if code != http.StatusBadRequest {
+			t.Errorf("%s: Expected: %d Actual: %d", test.body, http.StatusBadRequest, code)
+			continue
+		}
+
+		expected, _ := json.Marshal(test.expected)
+		actual, _ := json.Marshal(rsp.ExtendedInfo)
+		if string(expected) != string(actual) {
+			t.Errorf("%s: Expected: %s Actual: %s", test.body, expected, actual)
+		}
+	}
+}
+
+func TestValidateUnsigned(t *testing.T) {
+	for _, test := range []struct {
+		value string
+		valid bool
+	}{
+		{"0", true},
+		{"18446744073709551615", true},
+		{"18446744073709551616", false},
+		{"-1", false},
+		{"1.5", false},
+	} {
+		errs := validateValue(reflect.TypeOf(uint64(0)), json.Number(test.value), "Value", nil)
+		if valid := len(errs) == 0; valid != test.valid {
+			t.Errorf("%s: Expected valid: %t Actual: %+v", test.value, test.valid, errs)
+		}
+	}
+}
@@ -20,10 +20,13 @@
 package logging
 
 import (
+	"context"
 	"os"
 	"strconv"
 
 	log "github.com/sirupsen/logrus"
+
+	"github.com/NearNodeFlash/nnf-ec/pkg/tracing"
 )
 
 type cli struct {
@@ -65,20 +68,33 @@ func (l *cli) init() {
 	l.Info("CLI Log Starting...")
 }
 
-func (l *cli) Trace(cmd string, execFunc func(cmd string) ([]byte, error)) ([]byte, error) {
-	return l.Trace2(LogToContainer, cmd, execFunc)
+// Trace runs and records the command. Commands run on behalf of a request, as given by the context, are
+// recorded with the request ID and traced as part of the request.
+func (l *cli) Trace(ctx context.Context, cmd string, execFunc func(cmd string) ([]byte, error)) ([]byte, error) {
+	return l.Trace2(ctx, LogToContainer, cmd, execFunc)
 }
 
-func (l *cli) Trace2(logmask LoggingType, cmd string, execFunc func(cmd string) ([]byte, error)) ([]byte, error) {
+func (l *cli) Trace2(ctx context.Context, logmask LoggingType, cmd string, execFunc func(cmd string) ([]byte, error)) ([]byte, error) {
 	if l.File == nil {
 		l.init()
 	}
 
-	l.WithField("command", cmd).Info()
+	fields := log.Fields{"command": cmd}
+
+	if id := tracing.RequestId(ctx); len(id) != 0 {
+		fields["requestId"] = id
+	}
+
+	_, span := tracing.StartSpan(ctx, "Command", tracing.SpanKindClient)
+	span.SetAttribute("command", cmd)
+
+	l.WithFields(fields).Info()
 	l.File.Sync()
 
 	rsp, err := execFunc(cmd)
 
+	span.End(err)
+
 	isPrintable := func(rsp []byte) bool {
 		for _, r := range string(rsp) {
 			if !strconv.IsPrint(r) {
@@ -100,7 +116,7 @@ func (l *cli) Trace2(logmask LoggingType, cmd string, execFunc func(cmd string)
 	}
 
 	l.
-		WithField("command", cmd).
+		WithFields(fields).
 		WithField("response", response).
 		WithError(err).
 		Info()
@@ -108,7 +124,7 @@ func (l *cli) Trace2(logmask LoggingType, cmd string, execFunc func(cmd string)
 	// Get it out to the NNF logs.
 	if logmask&LogToStdout == LogToStdout {
 		log.
-			WithField("command", cmd).
+			WithFields(fields).
 			WithField("response", response).
 			WithError(err).
 			Info()
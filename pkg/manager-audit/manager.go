@@ -0,0 +1,482 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	msgreg "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry/registries"
+	openapi "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/common"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// Audit Log - Every POST, PATCH, PUT and DELETE serviced by the element controller is recorded in an
+// append-only audit log. The log is a file of JSON records, one per line, which is rotated once it
+// reaches the maximum size; the most recent rotated files are retained as <path>.1, <path>.2, and so
+// on. The records of the current and retained files are served as the entries of the Audit log service
+// of the element controller's Manager resource.
+
+const (
+	ManagersOdataId    = "/redfish/v1/Managers"
+	ManagerId          = "EC"
+	ManagerOdataId     = ManagersOdataId + "/" + ManagerId
+	LogServicesOdataId = ManagerOdataId + "/LogServices"
+	LogServiceId       = "Audit"
+	LogServiceOdataId  = LogServicesOdataId + "/" + LogServiceId
+	EntriesOdataId     = LogServiceOdataId + "/Entries"
+
+	defaultMaxSize  = 10 * 1024 * 1024
+	defaultMaxFiles = 5
+)
+
+// Record describes a single mutating request and its outcome
+type Record struct {
+	Id        uint64    `json:"Id"`
+	Timestamp time.Time `json:"Timestamp"`
+
+	// Identity of the client. User is the account that authenticated the request; Client is the
+	// subject of the client's TLS certificate or, for Unix socket clients, the peer credentials.
+	User       string `json:"User,omitempty"`
+	Client     string `json:"Client,omitempty"`
+	RemoteAddr string `json:"RemoteAddr,omitempty"`
+
+	RequestId string `json:"RequestId,omitempty"`
+	Method    string `json:"Method"`
+	Uri       string `json:"Uri"`
+
+	// Request body with the values of sensitive properties, such as passwords, redacted
+	Body json.RawMessage `json:"Body,omitempty"`
+
+	StatusCode int `json:"StatusCode"`
+
+	// Resources created or modified by the request, such as the @odata.id of a new storage pool
+	ResourceIds []string `json:"ResourceIds,omitempty"`
+}
+
+type manager struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	sync.Mutex
+	file   *os.File
+	size   int64
+	lastId uint64
+
+	log ec.Logger
+}
+
+// AuditManager is disabled until a path is provided by the auditLog flag. The path must be writable by the
+// element controller's user; the directory is created if it does not exist.
+var AuditManager = manager{
+	maxSize:  defaultMaxSize,
+	maxFiles: defaultMaxFiles,
+}
+
+func BindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&AuditManager.path, "auditLog", "", "Audit log file recording mutating requests; auditing is disabled if empty")
+	fs.Int64Var(&AuditManager.maxSize, "auditLogMaxSize", AuditManager.maxSize, "Size in bytes at which the audit log is rotated")
+	fs.IntVar(&AuditManager.maxFiles, "auditLogMaxFiles", AuditManager.maxFiles, "Number of rotated audit log files retained")
+}
+
+func (m *manager) enabled() bool { return len(m.path) != 0 }
+
+// Initialize the audit manager. The log directory is created if it does not exist, and the records
+// retained from a previous run of the element controller are scanned so record identifiers continue
+// to increase.
+func (m *manager) Initialize(log ec.Logger) error {
+	m.log = log
+
+	if !m.enabled() {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(m.path), 0700); err != nil {
+		log.Error(err, "Failed to create audit log directory", "path", m.path)
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	err := m.forEach(func(r *Record) bool {
+		if r.Id > m.lastId {
+			m.lastId = r.Id
+		}
+		return true
+	})
+
+	if err != nil {
+		log.Error(err, "Failed to read audit log", "path", m.path)
+		return err
+	}
+
+	return nil
+}
+
+func (m *manager) Close() error {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.file != nil {
+		err := m.file.Close()
+		m.file = nil
+		return err
+	}
+
+	return nil
+}
+
+// Record appends the record to the audit log, assigning the record its identifier. The log is opened
+// on the first record and rotated if the record would grow it beyond the maximum size.
+func (m *manager) Record(r *Record) error {
+	if !m.enabled() {
+		return nil
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	r.Id = m.lastId + 1
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	data = append(data, '\n')
+
+	if m.file != nil && m.size != 0 && m.size+int64(len(data)) > m.maxSize {
+		if err := m.rotate(); err != nil {
+			return err
+		}
+	}
+
+	if m.file == nil {
+		if err := m.open(); err != nil {
+			return err
+		}
+	}
+
+	n, err := m.file.Write(data)
+	m.size += int64(n)
+	if err != nil {
+		return err
+	}
+
+	m.lastId = r.Id
+
+	return nil
+}
+
+func (m *manager) open() error {
+	file, err := os.OpenFile(m.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	m.file, m.size = file, info.Size()
+
+	return nil
+}
+
+// rotate closes the current file and shifts it and the retained files up by one, discarding the oldest
+func (m *manager) rotate() error {
+	if err := m.file.Close(); err != nil {
+		return err
+	}
+
+	m.file, m.size = nil, 0
+
+	if m.maxFiles <= 0 {
+		return os.Remove(m.path)
+	}
+
+	os.Remove(m.rotatedPath(m.maxFiles))
+	for idx := m.maxFiles - 1; idx >= 1; idx-- {
+		if err := os.Rename(m.rotatedPath(idx), m.rotatedPath(idx+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return os.Rename(m.path, m.rotatedPath(1))
+}
+
+func (m *manager) rotatedPath(idx int) string {
+	return fmt.Sprintf("%s.%d", m.path, idx)
+}
+
+// forEach calls fn for each retained record, oldest first, until fn returns false
+func (m *manager) forEach(fn func(*Record) bool) error {
+	paths := make([]string, 0, m.maxFiles+1)
+	for idx := m.maxFiles; idx >= 1; idx-- {
+		paths = append(paths, m.rotatedPath(idx))
+	}
+	paths = append(paths, m.path)
+
+	for _, path := range paths {
+		more, err := forEachInFile(path, fn)
+		if err != nil {
+			return err
+		}
+
+		if !more {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func forEachInFile(path string, fn func(*Record) bool) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return true, nil
+		}
+
+		return false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		record := Record{}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			// A record torn by a crash while writing is skipped
+			continue
+		}
+
+		if !fn(&record) {
+			return false, nil
+		}
+	}
+
+	return true, scanner.Err()
+}
+
+// Records returns the retained records, oldest first
+func (m *manager) Records() ([]Record, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	records := make([]Record, 0)
+	err := m.forEach(func(r *Record) bool {
+		records = append(records, *r)
+		return true
+	})
+
+	return records, err
+}
+
+// ManagersGet -
+func (m *manager) ManagersGet(model *sf.ManagerCollectionManagerCollection) error {
+	model.Members = []sf.OdataV4IdRef{{OdataId: ManagerOdataId}}
+	model.MembersodataCount = int64(len(model.Members))
+
+	return nil
+}
+
+// ManagerIdGet -
+func (m *manager) ManagerIdGet(id string, model *sf.ManagerV1100Manager) error {
+	if id != ManagerId {
+		return ec.NewErrNotFound().WithCause(fmt.Sprintf("Manager %s not found", id)).WithEvent(msgreg.ResourceNotFoundBase("Manager", id))
+	}
+
+	now := time.Now()
+
+	model.Id = ManagerId
+	model.ManagerType = sf.SERVICE_MV1100MT
+	model.DateTime = &now
+	model.LogServices = sf.OdataV4IdRef{OdataId: LogServicesOdataId}
+	model.Status = sf.ResourceStatus{State: sf.ENABLED_RST, Health: sf.OK_RH}
+
+	return nil
+}
+
+// LogServicesGet -
+func (m *manager) LogServicesGet(id string, model *sf.LogServiceCollectionLogServiceCollection) error {
+	if id != ManagerId {
+		return ec.NewErrNotFound().WithCause(fmt.Sprintf("Manager %s not found", id)).WithEvent(msgreg.ResourceNotFoundBase("Manager", id))
+	}
+
+	model.Members = []sf.OdataV4IdRef{{OdataId: LogServiceOdataId}}
+	model.MembersodataCount = int64(len(model.Members))
+
+	return nil
+}
+
+// LogServiceIdGet -
+func (m *manager) LogServiceIdGet(id, logServiceId string, model *sf.LogServiceV120LogService) error {
+	if err := m.findLogService(id, logServiceId); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	model.Id = LogServiceId
+	model.Description = "Mutating requests serviced by the element controller"
+	model.DateTime = &now
+	model.LogEntryType = sf.OEM_LSV120LET
+	model.OverWritePolicy = sf.WRAPS_WHEN_FULL_LSV120OWP
+	model.ServiceEnabled = m.enabled()
+	model.Entries = sf.OdataV4IdRef{OdataId: EntriesOdataId}
+
+	model.Status = sf.ResourceStatus{State: sf.ENABLED_RST, Health: sf.OK_RH}
+	if !m.enabled() {
+		model.Status.State = sf.DISABLED_RST
+	}
+
+	return nil
+}
+
+// LogServiceIdEntriesGet -
+func (m *manager) LogServiceIdEntriesGet(id, logServiceId string, model *sf.LogEntryCollectionLogEntryCollection) error {
+	if err := m.findLogService(id, logServiceId); err != nil {
+		return err
+	}
+
+	records, err := m.Records()
+	if err != nil {
+		return ec.NewErrInternalServerError().WithError(err).WithCause("Failed to read audit log")
+	}
+
+	model.Members = make([]sf.OdataV4IdRef, len(records))
+	for idx, r := range records {
+		model.Members[idx] = sf.OdataV4IdRef{OdataId: fmt.Sprintf("%s/%d", EntriesOdataId, r.Id)}
+	}
+
+	model.MembersodataCount = int64(len(model.Members))
+
+	return nil
+}
+
+// LogServiceIdEntryIdGet -
+func (m *manager) LogServiceIdEntryIdGet(id, logServiceId, entryId string, model *sf.LogEntryV170LogEntry) error {
+	if err := m.findLogService(id, logServiceId); err != nil {
+		return err
+	}
+
+	notFound := ec.NewErrNotFound().WithCause(fmt.Sprintf("Log entry %s not found", entryId)).WithEvent(msgreg.ResourceNotFoundBase("LogEntry", entryId))
+
+	recordId, err := strconv.ParseUint(entryId, 10, 64)
+	if err != nil {
+		return notFound
+	}
+
+	var record *Record
+
+	m.Lock()
+	err = m.forEach(func(r *Record) bool {
+		if r.Id == recordId {
+			record = r
+		}
+		return record == nil
+	})
+	m.Unlock()
+
+	if err != nil {
+		return ec.NewErrInternalServerError().WithError(err).WithCause("Failed to read audit log")
+	}
+
+	if record == nil {
+		return notFound
+	}
+
+	record.model(model)
+
+	return nil
+}
+
+func (m *manager) findLogService(id, logServiceId string) error {
+	if id != ManagerId {
+		return ec.NewErrNotFound().WithCause(fmt.Sprintf("Manager %s not found", id)).WithEvent(msgreg.ResourceNotFoundBase("Manager", id))
+	}
+
+	if logServiceId != LogServiceId {
+		return ec.NewErrNotFound().WithCause(fmt.Sprintf("Log service %s not found", logServiceId)).WithEvent(msgreg.ResourceNotFoundBase("LogService", logServiceId))
+	}
+
+	return nil
+}
+
+type recordOem struct {
+	User        string
+	Client      string
+	RemoteAddr  string
+	RequestId   string
+	Method      string
+	Uri         string
+	Body        string
+	StatusCode  int
+	ResourceIds []string
+}
+
+func (r *Record) model(model *sf.LogEntryV170LogEntry) {
+	model.Id = strconv.FormatUint(r.Id, 10)
+	model.Created = r.Timestamp
+	model.EntryType = sf.OEM_LEV170LET
+	model.OemRecordFormat = "NnfAudit"
+
+	user := r.User
+	if len(user) == 0 {
+		user = r.Client
+	}
+	if len(user) == 0 {
+		user = r.RemoteAddr
+	}
+
+	model.Message = fmt.Sprintf("%s %s by %s: %d %s", r.Method, r.Uri, user, r.StatusCode, http.StatusText(r.StatusCode))
+
+	model.Severity = sf.OK_LEV170ES
+	if r.StatusCode >= http.StatusBadRequest {
+		model.Severity = sf.WARNING_LEV170ES
+	}
+
+	model.Oem = openapi.MarshalOem(recordOem{
+		User:        r.User,
+		Client:      r.Client,
+		RemoteAddr:  r.RemoteAddr,
+		RequestId:   r.RequestId,
+		Method:      r.Method,
+		Uri:         r.Uri,
+		Body:        string(r.Body),
+		StatusCode:  r.StatusCode,
+		ResourceIds: r.ResourceIds,
+	})
+}
@@ -0,0 +1,218 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+
+	session "github.com/NearNodeFlash/nnf-ec/pkg/manager-session"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+func TestAuditLog(t *testing.T) {
+	m := &manager{
+		path:     filepath.Join(t.TempDir(), "audit.log"),
+		maxSize:  1024,
+		maxFiles: 2,
+	}
+
+	if err := m.Initialize(logr.Discard()); err != nil {
+		t.Fatalf("Failed to initialize audit manager: %v", err)
+	}
+	defer m.Close()
+
+	const pools = "/redfish/v1/StorageServices/NNF/StoragePools"
+
+	handler := m.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		// The handler must receive the request body intact
+		model := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&model); err != nil || model["Password"] != "secret" {
+			t.Errorf("Handler received body %v: %v", model, err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"@odata.id": "` + pools + `/1", "Id": "1"}`))
+	}))
+
+	serve := func(method string) {
+		body := `{"Name": "pool", "Oem": {"Password": "secret"}, "Password": "secret"}`
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(method, pools, bytes.NewBufferString(body)))
+	}
+
+	serve(http.MethodGet)
+	serve(http.MethodPost)
+
+	records, err := m.Records()
+	if err != nil {
+		t.Fatalf("Failed to read records: %v", err)
+	}
+
+	if len(records) != 1 {
+		t.Fatalf("Expected only the POST to be recorded: %+v", records)
+	}
+
+	r := records[0]
+	if r.Id != 1 || r.Method != http.MethodPost || r.Uri != pools || r.StatusCode != http.StatusCreated {
+		t.Errorf("Unexpected record: %+v", r)
+	}
+
+	if strings.Contains(string(r.Body), "secret") || !strings.Contains(string(r.Body), `"Name":"pool"`) {
+		t.Errorf("Record body not redacted: %s", r.Body)
+	}
+
+	if len(r.ResourceIds) != 1 || r.ResourceIds[0] != pools+"/1" {
+		t.Errorf("Unexpected resource ids: %v", r.ResourceIds)
+	}
+
+	// Fill the log until it rotates past the retained files; only the most recent records remain,
+	// with identifiers continuing to increase
+	for i := 0; i < 30; i++ {
+		serve(http.MethodPost)
+	}
+
+	if _, err := os.Stat(m.rotatedPath(3)); !os.IsNotExist(err) {
+		t.Errorf("Expected at most %d rotated files: %v", m.maxFiles, err)
+	}
+
+	records, _ = m.Records()
+	if len(records) == 0 || records[len(records)-1].Id != 31 || records[0].Id == 1 {
+		t.Fatalf("Unexpected records after rotation: %d records", len(records))
+	}
+
+	for idx := 1; idx < len(records); idx++ {
+		if records[idx].Id != records[idx-1].Id+1 {
+			t.Errorf("Records out of order: %d follows %d", records[idx].Id, records[idx-1].Id)
+		}
+	}
+
+	// Identifiers continue from the retained records after a restart
+	m.Close()
+	m.lastId = 0
+	if err := m.Initialize(logr.Discard()); err != nil || m.lastId != 31 {
+		t.Errorf("Expected last id 31 after restart, got %d: %v", m.lastId, err)
+	}
+
+	entry := sf.LogEntryV170LogEntry{}
+	if err := m.LogServiceIdEntryIdGet(ManagerId, LogServiceId, "31", &entry); err != nil {
+		t.Fatalf("Failed to get entry: %v", err)
+	}
+
+	if entry.Id != "31" || entry.Oem["Method"] != http.MethodPost || entry.Oem["StatusCode"] != int64(http.StatusCreated) {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+
+	if err := m.LogServiceIdEntryIdGet(ManagerId, LogServiceId, fmt.Sprint(records[0].Id-1), &entry); err == nil {
+		t.Errorf("Expected rotated out entry to be not found")
+	}
+}
+
+func TestAuditRejectedRequests(t *testing.T) {
+	hash, err := session.HashPassword("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accountFile := filepath.Join(t.TempDir(), "accounts.json")
+	data, _ := json.Marshal([]session.Account{{UserName: "admin", PasswordHash: hash, RoleId: session.AdministratorRoleId}})
+	if err := os.WriteFile(accountFile, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := flag.NewFlagSet(t.Name(), flag.ContinueOnError)
+	session.BindFlags(fs)
+	if err := fs.Parse([]string{"-auth", "-accountFile", accountFile}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := session.SessionManager.Initialize(logr.Discard()); err != nil {
+		t.Fatalf("Failed to initialize session manager: %v", err)
+	}
+	defer session.SessionManager.Close()
+
+	m := &manager{
+		path:     filepath.Join(t.TempDir(), "audit.log"),
+		maxSize:  defaultMaxSize,
+		maxFiles: defaultMaxFiles,
+	}
+
+	if err := m.Initialize(logr.Discard()); err != nil {
+		t.Fatalf("Failed to initialize audit manager: %v", err)
+	}
+	defer m.Close()
+
+	const pools = "/redfish/v1/StorageServices/NNF/StoragePools"
+
+	// Auditing runs ahead of authentication, as it is installed by the element controller
+	handler := m.Middleware(session.SessionManager.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	serve := func(method string, authenticate bool) {
+		r := httptest.NewRequest(method, pools, bytes.NewBufferString(`{}`))
+		if authenticate {
+			r.SetBasicAuth("admin", "secret")
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+	}
+
+	serve(http.MethodGet, true)
+	serve(http.MethodGet, false)
+	serve(http.MethodPost, false)
+	serve(http.MethodPost, true)
+
+	records, err := m.Records()
+	if err != nil {
+		t.Fatalf("Failed to read records: %v", err)
+	}
+
+	expected := []Record{
+		{Method: http.MethodGet, StatusCode: http.StatusUnauthorized},
+		{Method: http.MethodPost, StatusCode: http.StatusUnauthorized},
+		{Method: http.MethodPost, StatusCode: http.StatusOK, User: "admin"},
+	}
+
+	if len(records) != len(expected) {
+		t.Fatalf("Expected %d records: %+v", len(expected), records)
+	}
+
+	for idx, e := range expected {
+		r := records[idx]
+		if r.Method != e.Method || r.StatusCode != e.StatusCode || r.User != e.User {
+			t.Errorf("Record %d: expected %s %d by '%s', got %s %d by '%s'", idx, e.Method, e.StatusCode, e.User, r.Method, r.StatusCode, r.User)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	session "github.com/NearNodeFlash/nnf-ec/pkg/manager-session"
+	"github.com/NearNodeFlash/nnf-ec/pkg/tracing"
+)
+
+const (
+	// Request and response bodies larger than this are not recorded
+	maxBodySize = 64 * 1024
+
+	redacted = "REDACTED"
+)
+
+// Properties whose values are redacted from recorded request bodies; matched case-insensitively
+// against any part of the property name.
+var sensitiveProperties = []string{"password", "passphrase", "secret", "token", "credential"}
+
+// responseRecorder records the status code and, up to the maximum body size, the body of a response
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	captureBody bool
+	body        bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.captureBody && r.body.Len()+len(b) <= maxBodySize {
+		r.body.Write(b)
+	}
+
+	return r.ResponseWriter.Write(b)
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case ec.POST_METHOD, ec.PATCH_METHOD, ec.PUT_METHOD, ec.DELETE_METHOD:
+		return true
+	}
+
+	return false
+}
+
+// isRejected returns true if the request was refused by authentication or authorization
+func isRejected(statusCode int) bool {
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return true
+	}
+
+	return false
+}
+
+// Middleware records every mutating request, and its outcome, in the audit log, as well as any request
+// rejected by authentication or authorization. The middleware runs ahead of authentication and of the
+// refusal of requests while shutting down so those requests are recorded too; the account that
+// authenticated the request is provided by the session middleware once the request is served.
+func (m *manager) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !m.enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		record := &Record{
+			Timestamp:  time.Now().UTC(),
+			Client:     ec.TLSClientIdentity(r),
+			RemoteAddr: r.RemoteAddr,
+			RequestId:  tracing.RequestId(r.Context()),
+			Method:     r.Method,
+			Uri:        r.URL.RequestURI(),
+		}
+
+		if creds := ec.PeerCredentialsFromRequest(r); creds != nil {
+			record.Client = creds.String()
+		}
+
+		r, account := session.WithAccountHolder(r)
+
+		mutating := isMutating(r.Method)
+		if mutating && r.Body != nil {
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+			r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+
+			if err == nil && len(body) <= maxBodySize {
+				record.Body = redact(body)
+			}
+		}
+
+		recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK, captureBody: mutating}
+		next.ServeHTTP(recorder, r)
+
+		if !mutating && !isRejected(recorder.statusCode) {
+			return
+		}
+
+		if account := account(); account != nil {
+			record.User = account.UserName
+		}
+
+		record.StatusCode = recorder.statusCode
+		record.ResourceIds = resourceIds(r, recorder)
+
+		if err := m.Record(record); err != nil {
+			m.log.Error(err, "Failed to record audit log entry", "method", record.Method, "uri", record.Uri)
+		}
+	})
+}
+
+// redact returns the JSON body with the values of sensitive properties replaced, or nil if the body
+// is empty or is not JSON.
+func redact(body []byte) json.RawMessage {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil
+	}
+
+	data, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitive(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(value)
+			}
+		}
+	case []interface{}:
+		for idx := range v {
+			v[idx] = redactValue(v[idx])
+		}
+	}
+
+	return v
+}
+
+func isSensitive(property string) bool {
+	property = strings.ToLower(property)
+	for _, s := range sensitiveProperties {
+		if strings.Contains(property, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resourceIds returns the resources created or modified by a successful request: the resource, or
+// task, named by the Location header and the @odata.id of the returned resource. If the response
+// names neither, the request URI identifies the resource.
+func resourceIds(r *http.Request, recorder *responseRecorder) []string {
+	if recorder.statusCode < http.StatusOK || recorder.statusCode >= http.StatusMultipleChoices {
+		return nil
+	}
+
+	ids := make([]string, 0)
+	if location := recorder.Header().Get("Location"); len(location) != 0 {
+		ids = append(ids, location)
+	}
+
+	resource := struct {
+		OdataId string `json:"@odata.id"`
+	}{}
+
+	if err := json.Unmarshal(recorder.body.Bytes(), &resource); err == nil && len(resource.OdataId) != 0 {
+		if len(ids) == 0 || ids[0] != resource.OdataId {
+			ids = append(ids, resource.OdataId)
+		}
+	}
+
+	if len(ids) == 0 {
+		ids = append(ids, r.URL.Path)
+	}
+
+	return ids
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"github.com/gorilla/mux"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+type DefaultApiRouter struct {
+	servicer Api
+}
+
+func NewDefaultApiRouter(s Api) ec.Router {
+	return &DefaultApiRouter{servicer: s}
+}
+
+func (*DefaultApiRouter) Name() string {
+	return "Audit Manager"
+}
+
+func (*DefaultApiRouter) Init(log ec.Logger) error {
+	return AuditManager.Initialize(log)
+}
+
+func (*DefaultApiRouter) Start() error {
+	return nil
+}
+
+func (*DefaultApiRouter) Close() error {
+	return AuditManager.Close()
+}
+
+// AuditMiddleware records mutating and rejected requests to all routes of the element controller
+func (*DefaultApiRouter) AuditMiddleware() mux.MiddlewareFunc {
+	return AuditManager.Middleware
+}
+
+func (r *DefaultApiRouter) Routes() ec.Routes {
+	s := r.servicer
+	return ec.Routes{
+		{
+			Name:        "RedfishV1ManagersGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/Managers",
+			HandlerFunc: s.RedfishV1ManagersGet,
+			Response:    sf.ManagerCollectionManagerCollection{},
+		},
+		{
+			Name:        "RedfishV1ManagersManagerIdGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/Managers/{ManagerId}",
+			HandlerFunc: s.RedfishV1ManagersManagerIdGet,
+			Response:    sf.ManagerV1100Manager{},
+		},
+		{
+			Name:        "RedfishV1ManagersManagerIdLogServicesGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/Managers/{ManagerId}/LogServices",
+			HandlerFunc: s.RedfishV1ManagersManagerIdLogServicesGet,
+			Response:    sf.LogServiceCollectionLogServiceCollection{},
+		},
+		{
+			Name:        "RedfishV1ManagersManagerIdLogServicesLogServiceIdGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/Managers/{ManagerId}/LogServices/{LogServiceId}",
+			HandlerFunc: s.RedfishV1ManagersManagerIdLogServicesLogServiceIdGet,
+			Response:    sf.LogServiceV120LogService{},
+		},
+		{
+			Name:        "RedfishV1ManagersManagerIdLogServicesLogServiceIdEntriesGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/Managers/{ManagerId}/LogServices/{LogServiceId}/Entries",
+			HandlerFunc: s.RedfishV1ManagersManagerIdLogServicesLogServiceIdEntriesGet,
+			Response:    sf.LogEntryCollectionLogEntryCollection{},
+			Privilege:   ec.ConfigureManagerPrivilege,
+		},
+		{
+			Name:        "RedfishV1ManagersManagerIdLogServicesLogServiceIdEntriesLogEntryIdGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/Managers/{ManagerId}/LogServices/{LogServiceId}/Entries/{LogEntryId}",
+			HandlerFunc: s.RedfishV1ManagersManagerIdLogServicesLogServiceIdEntriesLogEntryIdGet,
+			Response:    sf.LogEntryV170LogEntry{},
+			Privilege:   ec.ConfigureManagerPrivilege,
+		},
+	}
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"fmt"
+	"net/http"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+
+	. "github.com/NearNodeFlash/nnf-ec/pkg/common"
+)
+
+type DefaultApiService struct {
+	*manager
+}
+
+func NewDefaultApiService() Api {
+	return &DefaultApiService{manager: &AuditManager}
+}
+
+func (s *DefaultApiService) RedfishV1ManagersGet(w http.ResponseWriter, r *http.Request) {
+
+	model := sf.ManagerCollectionManagerCollection{
+		OdataId:   ManagersOdataId,
+		OdataType: "#ManagerCollection.ManagerCollection",
+		Name:      "Manager Collection",
+	}
+
+	err := s.ManagersGet(&model)
+
+	EncodeResponse(model, err, w)
+}
+
+func (s *DefaultApiService) RedfishV1ManagersManagerIdGet(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	managerId := params["ManagerId"]
+
+	model := sf.ManagerV1100Manager{
+		OdataId:   fmt.Sprintf("%s/%s", ManagersOdataId, managerId),
+		OdataType: "#Manager.v1_10_0.Manager",
+		Name:      "Near Node Flash Element Controller",
+	}
+
+	err := s.ManagerIdGet(managerId, &model)
+
+	EncodeResponse(model, err, w)
+}
+
+func (s *DefaultApiService) RedfishV1ManagersManagerIdLogServicesGet(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	managerId := params["ManagerId"]
+
+	model := sf.LogServiceCollectionLogServiceCollection{
+		OdataId:   fmt.Sprintf("%s/%s/LogServices", ManagersOdataId, managerId),
+		OdataType: "#LogServiceCollection.LogServiceCollection",
+		Name:      "Log Service Collection",
+	}
+
+	err := s.LogServicesGet(managerId, &model)
+
+	EncodeResponse(model, err, w)
+}
+
+func (s *DefaultApiService) RedfishV1ManagersManagerIdLogServicesLogServiceIdGet(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	managerId := params["ManagerId"]
+	logServiceId := params["LogServiceId"]
+
+	model := sf.LogServiceV120LogService{
+		OdataId:   fmt.Sprintf("%s/%s/LogServices/%s", ManagersOdataId, managerId, logServiceId),
+		OdataType: "#LogService.v1_2_0.LogService",
+		Name:      "Audit Log Service",
+	}
+
+	err := s.LogServiceIdGet(managerId, logServiceId, &model)
+
+	EncodeResponse(model, err, w)
+}
+
+func (s *DefaultApiService) RedfishV1ManagersManagerIdLogServicesLogServiceIdEntriesGet(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	managerId := params["ManagerId"]
+	logServiceId := params["LogServiceId"]
+
+	model := sf.LogEntryCollectionLogEntryCollection{
+		OdataId:   fmt.Sprintf("%s/%s/LogServices/%s/Entries", ManagersOdataId, managerId, logServiceId),
+		OdataType: "#LogEntryCollection.LogEntryCollection",
+		Name:      "Audit Log Entry Collection",
+	}
+
+	err := s.LogServiceIdEntriesGet(managerId, logServiceId, &model)
+
+	EncodeResponse(model, err, w)
+}
+
+func (s *DefaultApiService) RedfishV1ManagersManagerIdLogServicesLogServiceIdEntriesLogEntryIdGet(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	managerId := params["ManagerId"]
+	logServiceId := params["LogServiceId"]
+	logEntryId := params["LogEntryId"]
+
+	model := sf.LogEntryV170LogEntry{
+		OdataId:   fmt.Sprintf("%s/%s/LogServices/%s/Entries/%s", ManagersOdataId, managerId, logServiceId, logEntryId),
+		OdataType: "#LogEntry.v1_7_0.LogEntry",
+		Name:      "Audit Log Entry",
+	}
+
+	err := s.LogServiceIdEntryIdGet(managerId, logServiceId, logEntryId, &model)
+
+	EncodeResponse(model, err, w)
+}
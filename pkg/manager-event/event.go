@@ -22,7 +22,9 @@ package event
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
+	msgreg "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry"
 	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
 )
 
@@ -62,7 +64,7 @@ type Event struct {
 }
 
 func (e Event) OdataId() string {
-	return fmt.Sprintf("redfish/v1/EventService/Events/%s", e.Id)
+	return fmt.Sprintf("/redfish/v1/EventService/Events/%s", e.Id)
 }
 
 func (e Event) Is(event Event) bool {
@@ -74,7 +76,8 @@ func (e Event) CopyInto(model *sf.EventV161Event) {
 	model.EventTimestamp = e.Timestamp
 	model.EventGroupId = e.GroupId
 	model.MemberId = e.MemberId
-	model.Message = e.Message
+	model.MessageId = e.MessageId.String()
+	model.Message = e.ExtendedInfo().Message
 	model.MessageArgs = e.MessageArgs
 	model.MessageSeverity = e.MessageSeverity
 	if len(e.OriginOfCondition) != 0 {
@@ -82,6 +85,26 @@ func (e Event) CopyInto(model *sf.EventV161Event) {
 	}
 }
 
+// ExtendedInfo returns the event as a message of the @Message.ExtendedInfo of an error response. The
+// message text, resolution and related properties are taken from the message registry.
+func (e Event) ExtendedInfo() sf.MessageV111Message {
+	id := e.MessageId.String()
+
+	prefix, _, _ := strings.Cut(id, ".")
+	key := id[strings.LastIndex(id, ".")+1:]
+
+	if m, ok := msgreg.MessageRegistryManager.Message(prefix, key, e.MessageArgs...); ok {
+		return m
+	}
+
+	return sf.MessageV111Message{
+		MessageId:       id,
+		Message:         e.Message,
+		MessageArgs:     e.MessageArgs,
+		MessageSeverity: e.MessageSeverity,
+	}
+}
+
 func (e Event) Args(args ...*string) error {
 	if len(args) > len(e.MessageArgs) {
 		return fmt.Errorf("Requested arguments exceeds supplied arguments")
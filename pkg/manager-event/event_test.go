@@ -0,0 +1,96 @@
+/*
+ * Copyright 2020, 2021, 2022 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package event_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	event "github.com/NearNodeFlash/nnf-ec/pkg/manager-event"
+	registry "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry"
+	msgreg "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry/registries"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+func TestEventCopyInto(t *testing.T) {
+	if err := registry.MessageRegistryManager.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize message registry: %v", err)
+	}
+
+	e := msgreg.FabricReadyNnf("Rabbit")
+	e.Id = "7"
+
+	if e.OdataId() != "/redfish/v1/EventService/Events/7" {
+		t.Errorf("Event OdataId incorrect: %s", e.OdataId())
+	}
+
+	model := sf.EventV161Event{}
+	e.CopyInto(&model)
+
+	if model.MessageId != "Nnf.1.0.0.FabricReady" {
+		t.Errorf("Event MessageId incorrect: Expected: Nnf.1.0.0.FabricReady Actual: %s", model.MessageId)
+	}
+
+	// The message is the registry text with the arguments substituted, not the unformatted template
+	if model.Message != "The fabric 'Rabbit' is ready" {
+		t.Errorf("Event Message incorrect: Expected: The fabric 'Rabbit' is ready Actual: %s", model.Message)
+	}
+}
+
+func TestEventPublishTimestamp(t *testing.T) {
+	if err := event.EventManager.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize event manager: %v", err)
+	}
+
+	event.EventManager.Publish(msgreg.FabricReadyNnf("Rabbit"))
+
+	stamped := msgreg.FabricReadyNnf("Rabbit")
+	stamped.Timestamp = "2022-01-01T00:00:00Z"
+	event.EventManager.Publish(stamped)
+
+	model := sf.EventCollectionEventCollection{}
+	if err := event.EventManager.EventsGet(&model); err != nil {
+		t.Fatalf("Failed to retrieve events: %v", err)
+	}
+
+	if model.MembersodataCount != 2 || !strings.HasPrefix(model.Members[0].OdataId, "/redfish/v1/EventService/Events/") {
+		t.Fatalf("Events incorrect: %+v", model.Members)
+	}
+
+	// An event published without a timestamp is stamped with the time it was published
+	published := sf.EventV161Event{}
+	if err := event.EventManager.EventsEventIdGet("0", &published); err != nil {
+		t.Fatalf("Failed to retrieve event: %v", err)
+	}
+
+	if _, err := time.Parse(time.RFC3339, published.EventTimestamp); err != nil {
+		t.Errorf("Event timestamp not set on publish: '%s'", published.EventTimestamp)
+	}
+
+	// An event that carries its own timestamp keeps it
+	if err := event.EventManager.EventsEventIdGet("1", &published); err != nil {
+		t.Fatalf("Failed to retrieve event: %v", err)
+	}
+
+	if published.EventTimestamp != stamped.Timestamp {
+		t.Errorf("Event timestamp incorrect: Expected: %s Actual: %s", stamped.Timestamp, published.EventTimestamp)
+	}
+}
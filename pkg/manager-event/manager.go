@@ -22,6 +22,8 @@ package event
 import (
 	"fmt"
 	"strconv"
+	"sync"
+	"time"
 
 	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
 	msgreg "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry"
@@ -119,7 +121,11 @@ const (
 	DefaultDeliveryRetryIntervalSeconds = 60
 )
 
+// The manager's mutex protects the subscriptions and the event log. Subscription handlers are
+// always called without the mutex held so a handler is free to publish further events.
 type manager struct {
+	sync.Mutex
+
 	subscriptions []subscription
 	events        Events
 
@@ -130,6 +136,9 @@ type manager struct {
 	// The total number of events recorded by the Event Manager since initialization.
 	numEvents int
 
+	// The number of events recorded since initialization, by severity.
+	numEventsBySeverity map[sf.ResourceHealth]int
+
 	deliveryRetryAttempts int
 
 	deliveryRetryInvervalSeconds int
@@ -150,6 +159,7 @@ func (m *manager) Initialize() error {
 	m.events = make([]Event, MaxNumEvents, MaxNumEvents)
 	m.maxEvents = MaxNumEvents
 	m.numEvents = 0
+	m.numEventsBySeverity = make(map[sf.ResourceHealth]int)
 
 	m.deliveryRetryAttempts = DefaultDeliveryRetryAttempts
 	m.deliveryRetryInvervalSeconds = DefaultDeliveryRetryIntervalSeconds
@@ -159,6 +169,8 @@ func (m *manager) Initialize() error {
 
 // Subscribe will add the subscription to the Event Manager. When an event is published to the Event Manager
 // (through the Publish() method), the Event Manager will broadcast the event to all registered subscriptions.
+// A subscription that is already registered is not added again, so a manager that is re-initialized does
+// not receive each event more than once.
 func (m *manager) Subscribe(s Subscription) {
 	m.addSubscription(s, sf.OEM_EDV190ST)
 }
@@ -167,12 +179,23 @@ func (m *manager) Subscribe(s Subscription) {
 // to the Event Managers list of historic events. The Event must contain a valid MessageId - that is
 // to say the event's MessageId must be backed by an entry in the Message Registry.
 func (m *manager) Publish(e Event) {
+	m.Lock()
+
 	e.Id = strconv.Itoa(m.numEvents)
+	if len(e.Timestamp) == 0 {
+		e.Timestamp = time.Now().Format(time.RFC3339)
+	}
 
 	m.events[m.numEvents%m.maxEvents] = e
 	m.numEvents++
+	m.numEventsBySeverity[e.MessageSeverity]++
 
-	for _, s := range m.subscriptions {
+	subscriptions := make([]subscription, len(m.subscriptions))
+	copy(subscriptions, m.subscriptions)
+
+	m.Unlock()
+
+	for _, s := range subscriptions {
 		s.s.EventHandler(e)
 	}
 }
@@ -185,7 +208,18 @@ func (m *manager) PublishResourceEvent(e Event, r Resource) {
 	m.Publish(e)
 }
 
-func (m *manager) addSubscription(s Subscription, t sf.EventDestinationV190SubscriptionType) {
+func (m *manager) addSubscription(s Subscription, t sf.EventDestinationV190SubscriptionType) string {
+	m.Lock()
+	defer m.Unlock()
+
+	if t == sf.OEM_EDV190ST {
+		for _, sub := range m.subscriptions {
+			if sub.t == t && sub.s == s {
+				return sub.id
+			}
+		}
+	}
+
 	var sid = -1
 	for _, s := range m.subscriptions {
 		id, _ := strconv.Atoi(s.id)
@@ -201,21 +235,35 @@ func (m *manager) addSubscription(s Subscription, t sf.EventDestinationV190Subsc
 		s:  s,
 		t:  t,
 	})
+
+	return fmt.Sprintf("%d", sid)
 }
 
-func (m *manager) deleteSubscription(s *subscription) {
+// deleteSubscription removes the subscription with the given id, returning false if no such
+// subscription exists.
+func (m *manager) deleteSubscription(id string) bool {
+	m.Lock()
+	defer m.Unlock()
+
 	for subIdx, sub := range m.subscriptions {
-		if sub.id == s.id {
+		if sub.id == id {
 			m.subscriptions = append(m.subscriptions[:subIdx], m.subscriptions[subIdx+1:]...)
-			break
+			return true
 		}
 	}
+
+	return false
 }
 
+// findSubscription returns a copy of the subscription with the given id.
 func (m *manager) findSubscription(id string) *subscription {
+	m.Lock()
+	defer m.Unlock()
+
 	for idx := range m.subscriptions {
 		if m.subscriptions[idx].id == id {
-			return &m.subscriptions[idx]
+			s := m.subscriptions[idx]
+			return &s
 		}
 	}
 
@@ -242,6 +290,8 @@ func (m *manager) Get(model *sf.EventServiceV170EventService) error {
 
 // EventSubscriptionsGet
 func (m *manager) EventSubscriptionsGet(model *sf.EventDestinationCollectionEventDestinationCollection) error {
+	m.Lock()
+	defer m.Unlock()
 
 	model.MembersodataCount = int64(len(m.subscriptions))
 	model.Members = make([]sf.OdataV4IdRef, model.MembersodataCount)
@@ -259,13 +309,13 @@ func (m *manager) EventSubscriptionsPost(model *sf.EventDestinationV190EventDest
 		return ec.NewErrNotAcceptable().WithCause(fmt.Sprintf("retry policy %s is not supported by the event service", string(model.DeliveryRetryPolicy)))
 	}
 
-	m.addSubscription(RedfishSubscription{
+	id := m.addSubscription(RedfishSubscription{
 		Context:             model.Context,
 		Destination:         model.Destination,
 		DeliveryRetryPolicy: model.DeliveryRetryPolicy,
 	}, sf.REDFISH_EVENT_EDV190ST)
 
-	return m.EventSubscriptionsSubscriptionIdGet(m.subscriptions[len(m.subscriptions)-1].id, model)
+	return m.EventSubscriptionsSubscriptionIdGet(id, model)
 }
 
 // EventSubscriptionsSubscriptionIdGet
@@ -285,18 +335,17 @@ func (m *manager) EventSubscriptionsSubscriptionIdGet(id string, model *sf.Event
 
 // EventSubscriptionsSubscriptionIdDelete
 func (m *manager) EventSubscriptionsSubscriptionIdDelete(id string) error {
-	s := m.findSubscription(id)
-	if s == nil {
+	if !m.deleteSubscription(id) {
 		return ec.NewErrNotFound().WithCause(fmt.Sprintf("subscription %s not found", id))
 	}
 
-	m.deleteSubscription(s)
-
 	return nil
 }
 
 // EventsGet
 func (m *manager) EventsGet(model *sf.EventCollectionEventCollection) error {
+	m.Lock()
+	defer m.Unlock()
 
 	count := m.numEvents
 	start := 0
@@ -321,6 +370,9 @@ func (m *manager) EventsEventIdGet(id string, model *sf.EventV161Event) error {
 		return ec.NewErrBadRequest().WithError(err).WithCause(fmt.Sprintf("event id %s is non-integer type", id))
 	}
 
+	m.Lock()
+	defer m.Unlock()
+
 	if m.numEvents < idx {
 		return ec.NewErrNotFound().WithCause(fmt.Sprintf("event id %s not found", id))
 	}
@@ -329,3 +381,16 @@ func (m *manager) EventsEventIdGet(id string, model *sf.EventV161Event) error {
 
 	return nil
 }
+
+// metrics returns the number of events published since initialization, by severity
+func (m *manager) metrics() []ec.MetricFamily {
+	m.Lock()
+	defer m.Unlock()
+
+	events := ec.MetricFamily{Name: "nnf_ec_events_total", Help: "Events published by severity", Type: ec.CounterMetricType}
+	for severity, count := range m.numEventsBySeverity {
+		events.Samples = append(events.Samples, ec.MetricSample{Labels: ec.MetricLabels{"severity": string(severity)}, Value: float64(count)})
+	}
+
+	return []ec.MetricFamily{events}
+}
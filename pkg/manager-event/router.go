@@ -21,6 +21,7 @@ package event
 
 import (
 	"github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
 )
 
 // Router contains all the Redfish / Swordfish API calls for the Event Service
@@ -48,6 +49,10 @@ func (*DefaultApiRouter) Close() error {
 	return nil
 }
 
+func (r *DefaultApiRouter) Metrics() []ec.MetricFamily {
+	return EventManager.metrics()
+}
+
 func (r *DefaultApiRouter) Routes() ec.Routes {
 	s := r.servicer
 	return ec.Routes{
@@ -56,6 +61,7 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/EventService",
 			HandlerFunc: s.RedfishV1EventServiceGet,
+			Response:    sf.EventServiceV170EventService{},
 		},
 
 		/* ---------------------- Event Subscriptions ---------------------- */
@@ -65,24 +71,31 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/EventService/Subscriptions",
 			HandlerFunc: s.RedfishV1EventServiceEventSubscriptionsGet,
+			Response:    sf.EventDestinationCollectionEventDestinationCollection{},
 		},
 		{
-			Name:        "RedfishV1EventServiceEventSubscriptionsPost",
-			Method:      ec.POST_METHOD,
-			Path:        "/redfish/v1/EventService/Subscriptions",
-			HandlerFunc: s.RedfishV1EventServiceEventSubscriptionsPost,
+			Name:               "RedfishV1EventServiceEventSubscriptionsPost",
+			Method:             ec.POST_METHOD,
+			Path:               "/redfish/v1/EventService/Subscriptions",
+			HandlerFunc:        s.RedfishV1EventServiceEventSubscriptionsPost,
+			Response:           sf.EventDestinationV190EventDestination{},
+			Model:              sf.EventDestinationV190EventDestination{},
+			RequiredProperties: []string{"Destination"},
+			Privilege:          ec.ConfigureManagerPrivilege,
 		},
 		{
 			Name:        "RedfishV1EventServiceEventSubscriptionIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/EventService/Subscriptions/{SubscriptionId}",
 			HandlerFunc: s.RedfishV1EventServiceEventSubscriptionIdGet,
+			Response:    sf.EventDestinationV190EventDestination{},
 		},
 		{
 			Name:        "RedfishV1EventServiceEventSubscriptionIdDelete",
 			Method:      ec.DELETE_METHOD,
 			Path:        "/redfish/v1/EventService/Subscriptions/{SubscriptionId}",
 			HandlerFunc: s.RedfishV1EventServiceEventSubscriptionIdDelete,
+			Privilege:   ec.ConfigureManagerPrivilege,
 		},
 
 		/* ---------------------------- Events ----------------------------- */
@@ -92,12 +105,14 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/EventService/Events",
 			HandlerFunc: s.RedfishV1EventServiceEventsGet,
+			Response:    sf.EventCollectionEventCollection{},
 		},
 		{
 			Name:        "RedfishV1EventServiceEventIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/EventService/Events/{EventId}",
 			HandlerFunc: s.RedfishV1EventServiceEventEventIdGet,
+			Response:    sf.EventV161Event{},
 		},
 	}
 }
@@ -0,0 +1,145 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package fabric
+
+import (
+	"fmt"
+	"time"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// Health - The fabric manager is ready once started with at least one switch available. It is live
+// provided neither the fabric lock nor the command lock of any switch has been held for longer than
+// any operation should take, and the fabric monitor, if running, has polled the switches recently.
+// The readiness probe reads the fabric summary so it is never held up behind fabric operations.
+
+const (
+	livenessLockTimeout   = 2 * time.Minute
+	monitorStallIntervals = 3
+)
+
+// lockHeldFor returns how long the fabric lock has been held, or zero if it is free
+func (f *Fabric) lockHeldFor() time.Duration {
+	lockedAt := f.lockedAt.Load()
+	if lockedAt == 0 {
+		return 0
+	}
+
+	return time.Since(time.Unix(0, lockedAt))
+}
+
+// fabricSummary is a snapshot of the fabric state and the readiness of its switches
+type fabricSummary struct {
+	state      sf.ResourceState
+	switches   int
+	switchesUp int
+}
+
+// summarize publishes a new summary of the fabric. It is called with the fabric lock held, just
+// before the lock is released, so the summary reflects every change made under the lock.
+func (f *Fabric) summarize() {
+	switchesUp := 0
+	for idx := range f.switches {
+		if f.switches[idx].isReady() {
+			switchesUp++
+		}
+	}
+
+	f.summary.Store(&fabricSummary{
+		state:      f.status.State,
+		switches:   len(f.switches),
+		switchesUp: switchesUp,
+	})
+}
+
+// currentSummary returns the most recent summary of the fabric, or an offline summary if the
+// fabric has yet to be started.
+func (f *Fabric) currentSummary() fabricSummary {
+	if summary := f.summary.Load(); summary != nil {
+		return *summary
+	}
+
+	return fabricSummary{state: sf.UNAVAILABLE_OFFLINE_RST}
+}
+
+func (f *Fabric) ready() ec.HealthStatus {
+	summary := f.currentSummary()
+
+	status := ec.HealthStatus{
+		Healthy: true,
+		Details: map[string]interface{}{
+			"state":      summary.state,
+			"switches":   summary.switches,
+			"switchesUp": summary.switchesUp,
+		},
+	}
+
+	if summary.state != sf.ENABLED_RST {
+		status.Healthy = false
+		status.Reason = fmt.Sprintf("fabric state is %s", summary.state)
+	} else if summary.switchesUp == 0 {
+		status.Healthy = false
+		status.Reason = "no switches available"
+	}
+
+	return status
+}
+
+func (f *Fabric) live() ec.HealthStatus {
+	status := ec.HealthStatus{Healthy: true, Details: map[string]interface{}{}}
+
+	if held := f.lockHeldFor(); held > livenessLockTimeout {
+		status.Healthy = false
+		status.Reason = fmt.Sprintf("fabric lock held for %s", held.Round(time.Second))
+		return status
+	}
+
+	// The switch devices are only inspected if the fabric lock is free; a lock that is held is
+	// reported above once it exceeds the timeout.
+	if f.mutex.TryLock() {
+		for idx := range f.switches {
+			s := &f.switches[idx]
+			if !s.isReady() || s.dev.Device() == nil {
+				continue
+			}
+
+			if held := s.dev.Device().CommandLockHeldFor(); held > livenessLockTimeout {
+				status.Healthy = false
+				status.Reason = fmt.Sprintf("switch %s command lock held for %s", s.id, held.Round(time.Second))
+			}
+		}
+
+		// Nothing was queued for publishing, so release the mutex directly
+		f.mutex.Unlock()
+	}
+
+	if m := f.monitor; m != nil {
+		sincePoll := time.Since(time.Unix(0, m.lastPoll.Load()))
+		status.Details["monitorLastPoll"] = sincePoll.Round(time.Second).String()
+
+		if sincePoll > monitorStallIntervals*m.interval && status.Healthy {
+			status.Healthy = false
+			status.Reason = fmt.Sprintf("fabric monitor has not polled for %s", sincePoll.Round(time.Second))
+		}
+	}
+
+	return status
+}
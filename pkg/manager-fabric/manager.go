@@ -20,9 +20,13 @@
 package fabric
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/NearNodeFlash/nnf-ec/pkg/api"
 	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
@@ -49,6 +53,16 @@ const (
 type Fabric struct {
 	ctrl SwitchtecControllerInterface
 
+	// The mutex protects the switches, ports and endpoints of the fabric. Events raised while the
+	// mutex is held are queued and published when it is released.
+	mutex    sync.Mutex
+	events   []event.Event
+	lockedAt atomic.Int64 // Time the lock was taken, in Unix nanoseconds, or zero if free
+
+	summary atomic.Pointer[fabricSummary] // Summary published for the readiness probe
+
+	monitor *monitor
+
 	id     string
 	config *ConfigFile
 
@@ -104,6 +118,10 @@ type Port struct {
 
 	endpoints []*Endpoint
 
+	// Tx/Rx byte counters, cached each time the switch is polled
+	counters      switchtec.BandwidthCounter
+	countersValid bool
+
 	log ec.Logger
 }
 
@@ -189,6 +207,34 @@ func init() {
 
 func isFabric(id string) bool { return id == manager.id }
 
+// lock takes the fabric lock. Unexported fabric methods expect the caller to hold the lock;
+// exported functions take it themselves.
+func (f *Fabric) lock() {
+	f.mutex.Lock()
+	f.lockedAt.Store(time.Now().UnixNano())
+}
+
+// unlock releases the fabric lock and publishes any events raised while it was held. Events
+// are never published with the lock held, so subscribers may call back into the fabric.
+func (f *Fabric) unlock() {
+	events := f.events
+	f.events = nil
+
+	f.summarize()
+
+	f.lockedAt.Store(0)
+	f.mutex.Unlock()
+
+	for _, e := range events {
+		event.EventManager.Publish(e)
+	}
+}
+
+// publish queues the event for publishing when the fabric lock is released.
+func (f *Fabric) publish(e event.Event) {
+	f.events = append(f.events, e)
+}
+
 // TODO: Move these to the newer find functions
 //func isEndpoint(id string) bool      { _, err := fabric.findEndpoint(id); return err == nil }
 //func isEndpointGroup(id string) bool { _, err := fabric.findEndpointGroup(id); return err == nil }
@@ -367,7 +413,7 @@ func (s *Switch) isReady() bool { return len(s.path) != 0 }
 func (s *Switch) isDown() bool  { return !s.isReady() }
 func (s *Switch) setDown()      { s.path = "" }
 
-func (s *Switch) identify() error {
+func (s *Switch) identify(ctx context.Context) error {
 	f := s.fabric
 	log := s.log
 
@@ -386,7 +432,7 @@ func (s *Switch) identify() error {
 			return err
 		}
 
-		paxId, err := dev.Identify()
+		paxId, err := dev.Identify(ctx)
 		if err != nil {
 			log.Error(err, "Identify error")
 			return err
@@ -398,10 +444,10 @@ func (s *Switch) identify() error {
 			s.path = path
 			s.paxId = paxId
 
-			s.model = s.getModel()
-			s.manufacturer = s.getManufacturer()
-			s.serialNumber = s.getSerialNumber()
-			s.firmwareVersion = s.getFirmwareVersion()
+			s.model = s.getModel(ctx)
+			s.manufacturer = s.getManufacturer(ctx)
+			s.serialNumber = s.getSerialNumber(ctx)
+			s.firmwareVersion = s.getFirmwareVersion(ctx)
 
 			log.Info("Identified switch", "path", path,
 				"model", s.model, "manufacturer", s.manufacturer,
@@ -441,9 +487,27 @@ func (s *Switch) identify() error {
 	return fmt.Errorf("Identify Switch %s: Could Not ID Switch", s.id) // TODO: Switch not found
 }
 
-func (s *Switch) refreshPortStatus() error {
+// refreshPortMetrics reads the Tx/Rx counters of the switch ports and caches them on each port
+func (s *Switch) refreshPortMetrics(ctx context.Context) (PortMetrics, error) {
 
-	switchPortStatus, err := s.dev.GetPortStatus()
+	metrics, err := s.dev.GetPortMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, metric := range metrics {
+		if p := s.findPortByPhysicalPortId(metric.PhysPortId); p != nil {
+			p.counters = metric.BandwidthCounter
+			p.countersValid = true
+		}
+	}
+
+	return metrics, nil
+}
+
+func (s *Switch) refreshPortStatus(ctx context.Context) error {
+
+	switchPortStatus, err := s.dev.GetPortStatus(ctx)
 	if err != nil {
 		return err
 	}
@@ -506,7 +570,7 @@ StatusLoop:
 
 					p.portStatus = status
 					if eventFunc, ok := eventMap[p.portType]; ok {
-						event.EventManager.Publish(eventFunc(s.id, p.id))
+						s.fabric.publish(eventFunc(s.id, p.id))
 					}
 				}
 
@@ -543,27 +607,27 @@ func (s *Switch) getDeviceStringByFunc(f func(dev SwitchtecDeviceInterface) (str
 	return ""
 }
 
-func (s *Switch) getModel() string {
+func (s *Switch) getModel(ctx context.Context) string {
 	return s.getDeviceStringByFunc(func(dev SwitchtecDeviceInterface) (string, error) {
-		return dev.GetModel()
+		return dev.GetModel(ctx)
 	})
 }
 
-func (s *Switch) getManufacturer() string {
+func (s *Switch) getManufacturer(ctx context.Context) string {
 	return s.getDeviceStringByFunc(func(dev SwitchtecDeviceInterface) (string, error) {
-		return dev.GetManufacturer()
+		return dev.GetManufacturer(ctx)
 	})
 }
 
-func (s *Switch) getSerialNumber() string {
+func (s *Switch) getSerialNumber(ctx context.Context) string {
 	return s.getDeviceStringByFunc(func(dev SwitchtecDeviceInterface) (string, error) {
-		return dev.GetSerialNumber()
+		return dev.GetSerialNumber(ctx)
 	})
 }
 
-func (s *Switch) getFirmwareVersion() string {
+func (s *Switch) getFirmwareVersion(ctx context.Context) string {
 	return s.getDeviceStringByFunc(func(dev SwitchtecDeviceInterface) (string, error) {
-		return dev.GetFirmwareVersion()
+		return dev.GetFirmwareVersion(ctx)
 	})
 }
 
@@ -623,7 +687,7 @@ func (p *Port) getResourceState() sf.ResourceState {
 	return sf.ENABLED_RST
 }
 
-func (p *Port) Initialize() error {
+func (p *Port) Initialize(ctx context.Context) error {
 	log := p.log
 	log.V(2).Info("Initialize port")
 
@@ -675,7 +739,7 @@ func (p *Port) Initialize() error {
 		}
 
 		log.V(2).Info("Initialize downstream port")
-		if err := p.swtch.dev.EnumerateEndpoint(uint8(p.config.Port), processPort(p)); err != nil {
+		if err := p.swtch.dev.EnumerateEndpoint(ctx, uint8(p.config.Port), processPort(p)); err != nil {
 			log.Error(err, "Port initialization failed")
 			return err
 		}
@@ -686,7 +750,7 @@ func (p *Port) Initialize() error {
 	return nil
 }
 
-func (p *Port) bind() error {
+func (p *Port) bind(ctx context.Context) error {
 	f := p.swtch.fabric
 	log := p.log
 
@@ -798,7 +862,7 @@ func (p *Port) bind() error {
 						}
 
 						log.Info("Binding Port")
-						if err := s.dev.Bind(uint8(initiatorPort.config.Port), uint8(logicalPortId), endpoint.pdfid); err != nil {
+						if err := s.dev.Bind(ctx, uint8(initiatorPort.config.Port), uint8(logicalPortId), endpoint.pdfid); err != nil {
 							log.Error(err, "Bind Failed")
 						}
 
@@ -826,11 +890,11 @@ func (p *Port) notify(isDown bool) {
 
 			switch p.portType {
 			case sf.DOWNSTREAM_PORT_PV130PT:
-				event.EventManager.Publish(msgreg.DownstreamLinkDroppedFabric(p.swtch.id, p.id))
+				p.swtch.fabric.publish(msgreg.DownstreamLinkDroppedFabric(p.swtch.id, p.id))
 			case sf.UPSTREAM_PORT_PV130PT, sf.MANAGEMENT_PORT_PV130PT:
-				event.EventManager.Publish(msgreg.UpstreamLinkDroppedFabric(p.swtch.id, p.id))
+				p.swtch.fabric.publish(msgreg.UpstreamLinkDroppedFabric(p.swtch.id, p.id))
 			case sf.INTERSWITCH_PORT_PV130PT:
-				event.EventManager.Publish(msgreg.InterswitchLinkDroppedFabric(p.swtch.id, p.id))
+				p.swtch.fabric.publish(msgreg.InterswitchLinkDroppedFabric(p.swtch.id, p.id))
 			}
 		}
 
@@ -839,7 +903,7 @@ func (p *Port) notify(isDown bool) {
 		// A port up event causes a full refresh of all ports on the switch to ensure
 		// the most recent status is used. Since we don't have the ability to query
 		// the status of a single port, we will read all ports.
-		p.swtch.refreshPortStatus()
+		p.swtch.refreshPortStatus(context.Background())
 	}
 }
 
@@ -920,7 +984,7 @@ func Initialize(log ec.Logger, ctrl SwitchtecControllerInterface) error {
 		// if we can't find the switch Start() won't really do anything anyways.
 
 		log.Info("Identify switch")
-		if err := s.identify(); err != nil {
+		if err := s.identify(context.Background()); err != nil {
 			log.Error(err, "Failed to identify switch")
 		}
 
@@ -1090,9 +1154,12 @@ func Initialize(log ec.Logger, ctrl SwitchtecControllerInterface) error {
 
 // Start -
 func Start() error {
+	ctx := context.Background()
 	m := &manager
 	m.log.V(1).Info("Starting manager")
 
+	m.lock()
+
 	m.status.State = sf.STARTING_RST
 
 	// Enumerate over the switch ports and report events to the event
@@ -1108,7 +1175,7 @@ func Start() error {
 		for portIdx := range s.ports {
 			p := &s.ports[portIdx]
 
-			if err := p.Initialize(); err != nil {
+			if err := p.Initialize(ctx); err != nil {
 				m.log.Error(err, "Port initialization failed")
 
 				// Port initialization is not fatal - the manager can continue
@@ -1116,11 +1183,14 @@ func Start() error {
 			}
 		}
 
-		s.refreshPortStatus()
+		s.refreshPortStatus(ctx)
+		s.refreshPortMetrics(ctx)
 	}
 
 	m.status.State = sf.ENABLED_RST
 
+	m.unlock()
+
 	// Notify the event manager the fabric manager is ready
 	event.EventManager.Publish(msgreg.FabricReadyNnf(m.id))
 
@@ -1135,13 +1205,16 @@ func (m *Fabric) EventHandler(e event.Event) error {
 		var switchId, portId string
 		e.Args(&switchId, &portId)
 
+		m.lock()
+		defer m.unlock()
+
 		_, _, p := findPort(m.id, switchId, portId)
 		if p == nil {
 			return ec.NewErrInternalServerError().WithCause("Internal event illformed")
 		}
 
 		if p.portType == sf.DOWNSTREAM_PORT_PV130PT {
-			if err := p.bind(); err != nil {
+			if err := p.bind(context.Background()); err != nil {
 				m.log.Error(err, "Port bind failed")
 			}
 		}
@@ -1153,6 +1226,9 @@ func (m *Fabric) EventHandler(e event.Event) error {
 // GetEndpoint - Returns the first endpoint for the given switch port. For USP, there will only ever be one ID. For DSP there will
 // be an endpoint for each Physical and Virtual Functions on the DSP, but the first ID (corresponding to the PF) is what is returned.
 func GetEndpoint(switchId, portId string) (*Endpoint, error) {
+	manager.lock()
+	defer manager.unlock()
+
 	for _, s := range manager.switches {
 		for _, p := range s.ports {
 			if s.id == switchId && p.id == portId {
@@ -1175,6 +1251,9 @@ func Get(model *sf.FabricCollectionFabricCollection) error {
 
 // FabricIdGet -
 func FabricIdGet(fabricId string, model *sf.FabricV120Fabric) error {
+	manager.lock()
+	defer manager.unlock()
+
 	f := findFabric(fabricId)
 	if f == nil {
 		return ec.NewErrNotFound()
@@ -1195,6 +1274,9 @@ func FabricIdGet(fabricId string, model *sf.FabricV120Fabric) error {
 
 // FabricIdSwitchesGet -
 func FabricIdSwitchesGet(fabricId string, model *sf.SwitchCollectionSwitchCollection) error {
+	manager.lock()
+	defer manager.unlock()
+
 	f := findFabric(fabricId)
 	if f == nil {
 		return ec.NewErrNotFound()
@@ -1210,7 +1292,10 @@ func FabricIdSwitchesGet(fabricId string, model *sf.SwitchCollectionSwitchCollec
 }
 
 // FabricIdSwitchesSwitchIdGet -
-func FabricIdSwitchesSwitchIdGet(fabricId string, switchId string, model *sf.SwitchV140Switch) error {
+func FabricIdSwitchesSwitchIdGet(ctx context.Context, fabricId string, switchId string, model *sf.SwitchV140Switch) error {
+	manager.lock()
+	defer manager.unlock()
+
 	_, s := findSwitch(fabricId, switchId)
 	if s == nil {
 		return ec.NewErrNotFound()
@@ -1220,10 +1305,10 @@ func FabricIdSwitchesSwitchIdGet(fabricId string, switchId string, model *sf.Swi
 	model.SwitchType = sf.PC_IE_PP
 
 	model.Status = s.getStatus()
-	model.Model = s.getModel()
-	model.Manufacturer = s.getManufacturer()
-	model.SerialNumber = s.getSerialNumber()
-	model.FirmwareVersion = s.getFirmwareVersion()
+	model.Model = s.getModel(ctx)
+	model.Manufacturer = s.getManufacturer(ctx)
+	model.SerialNumber = s.getSerialNumber(ctx)
+	model.FirmwareVersion = s.getFirmwareVersion(ctx)
 
 	model.Ports.OdataId = fmt.Sprintf("/redfish/v1/Fabrics/%s/Switches/%s/Ports", fabricId, switchId)
 
@@ -1232,6 +1317,9 @@ func FabricIdSwitchesSwitchIdGet(fabricId string, switchId string, model *sf.Swi
 
 // FabricIdSwitchesSwitchIdPortsGet -
 func FabricIdSwitchesSwitchIdPortsGet(fabricId string, switchId string, model *sf.PortCollectionPortCollection) error {
+	manager.lock()
+	defer manager.unlock()
+
 	_, s := findSwitch(fabricId, switchId)
 	if s == nil {
 		return ec.NewErrNotFound()
@@ -1248,6 +1336,9 @@ func FabricIdSwitchesSwitchIdPortsGet(fabricId string, switchId string, model *s
 
 // FabricIdSwitchesSwitchIdPortsPortIdGet -
 func FabricIdSwitchesSwitchIdPortsPortIdGet(fabricId string, switchId string, portId string, model *sf.PortV130Port) error {
+	manager.lock()
+	defer manager.unlock()
+
 	_, _, p := findPort(fabricId, switchId, portId)
 
 	model.Name = p.config.Name
@@ -1283,6 +1374,9 @@ func FabricIdSwitchesSwitchIdPortsPortIdGet(fabricId string, switchId string, po
 
 // FabricIdEndpointsGet -
 func FabricIdEndpointsGet(fabricId string, model *sf.EndpointCollectionEndpointCollection) error {
+	manager.lock()
+	defer manager.unlock()
+
 	f := findFabric(fabricId)
 	if f == nil {
 		return ec.NewErrNotFound()
@@ -1299,6 +1393,9 @@ func FabricIdEndpointsGet(fabricId string, model *sf.EndpointCollectionEndpointC
 
 // FabricIdEndpointsEndpointIdGet -
 func FabricIdEndpointsEndpointIdGet(fabricId string, endpointId string, model *sf.EndpointV150Endpoint) error {
+	manager.lock()
+	defer manager.unlock()
+
 	_, ep := findEndpoint(fabricId, endpointId)
 	if ep == nil {
 		return ec.NewErrNotFound()
@@ -1373,6 +1470,9 @@ func FabricIdEndpointsEndpointIdGet(fabricId string, endpointId string, model *s
 
 // FabricIdEndpointGroupsGet -
 func FabricIdEndpointGroupsGet(fabricId string, model *sf.EndpointGroupCollectionEndpointGroupCollection) error {
+	manager.lock()
+	defer manager.unlock()
+
 	f := findFabric(fabricId)
 	if f == nil {
 		return ec.NewErrNotFound()
@@ -1388,6 +1488,9 @@ func FabricIdEndpointGroupsGet(fabricId string, model *sf.EndpointGroupCollectio
 }
 
 func FabricIdEndpointGroupsEndpointIdGet(fabricId string, groupId string, model *sf.EndpointGroupV130EndpointGroup) error {
+	manager.lock()
+	defer manager.unlock()
+
 	f, epg := findEndpointGroup(fabricId, groupId)
 	if epg == nil {
 		return ec.NewErrNotFound()
@@ -1410,6 +1513,9 @@ func FabricIdEndpointGroupsEndpointIdGet(fabricId string, groupId string, model
 
 // FabricIdConnectionsGet -
 func FabricIdConnectionsGet(fabricId string, model *sf.ConnectionCollectionConnectionCollection) error {
+	manager.lock()
+	defer manager.unlock()
+
 	f := findFabric(fabricId)
 	if f == nil {
 		return ec.NewErrNotFound()
@@ -1425,9 +1531,12 @@ func FabricIdConnectionsGet(fabricId string, model *sf.ConnectionCollectionConne
 }
 
 // FabricIdConnectionsConnectionIdGet
-func FabricIdConnectionsConnectionIdGet(fabricId string, connectionId string, model *sf.ConnectionV100Connection) error {
+func FabricIdConnectionsConnectionIdGet(ctx context.Context, fabricId string, connectionId string, model *sf.ConnectionV100Connection) error {
+	manager.lock()
+
 	f, c := findConnection(fabricId, connectionId)
 	if c == nil {
+		manager.unlock()
 		return ec.NewErrNotFound()
 	}
 
@@ -1447,9 +1556,12 @@ func FabricIdConnectionsConnectionIdGet(fabricId string, connectionId string, mo
 		model.Links.TargetEndpoints[idx].OdataId = ep.fmt("") // fmt.Sprintf("/redfish/v1/Fabrics/%s/Endpoints/%s", fabricId, endpoint.id)
 	}
 
+	// The fabric lock is released before querying the NVMe manager, which calls back into the fabric.
+	manager.unlock()
+
 	// TODO: This should be by controllerId uint16 (not a string)
 	controllerId := strconv.Itoa(int(initiator.controllerId))
-	volumes, err := api.NvmeInterface.GetVolumes(controllerId)
+	volumes, err := api.NvmeInterface.GetVolumes(ctx, controllerId)
 	if err == nil {
 		model.VolumeInfo = make([]sf.ConnectionV100VolumeInfo, len(volumes))
 		for idx, volume := range volumes {
@@ -1473,9 +1585,10 @@ func FabricIdConnectionsConnectionIdGet(fabricId string, connectionId string, mo
 	return nil
 }
 
-// FabricIdConnectionsConnectionIdPatch -
+// FabricIdConnectionsConnectionIdPatch - Connections are established by the fabric as endpoints are
+// attached to storage groups and cannot be modified.
 func FabricIdConnectionsConnectionIdPatch(fabricId string, connectionId string, model *sf.ConnectionV100Connection) error {
-	return ec.NewErrNotAcceptable()
+	return ec.NewErrNotImplemented().WithEvent(msgreg.ActionNotSupportedBase(ec.PATCH_METHOD))
 	/*
 		if !isFabric(fabricId) {
 			return ec.NewErrNotFound()
@@ -1500,6 +1613,9 @@ func FabricIdConnectionsConnectionIdPatch(fabricId string, connectionId string,
 }
 
 func GetSwitchDevice(fabricId, switchId string) *switchtec.Device {
+	manager.lock()
+	defer manager.unlock()
+
 	_, s := findSwitch(fabricId, switchId)
 	if s == nil {
 		return nil
@@ -1509,6 +1625,9 @@ func GetSwitchDevice(fabricId, switchId string) *switchtec.Device {
 }
 
 func GetSwitchPath(fabricId, switchId string) *string {
+	manager.lock()
+	defer manager.unlock()
+
 	_, s := findSwitch(fabricId, switchId)
 	if s == nil {
 		return nil
@@ -1519,6 +1638,9 @@ func GetSwitchPath(fabricId, switchId string) *string {
 
 // GetPortPDFID
 func GetPortPDFID(fabricId, switchId, portId string, controllerId uint16) (uint16, error) {
+	manager.lock()
+	defer manager.unlock()
+
 	_, _, p := findPort(fabricId, switchId, portId)
 	if p == nil {
 		return 0, fmt.Errorf("Port %s not found in fabric %s switch %s", portId, fabricId, portId)
@@ -1536,6 +1658,9 @@ func GetPortPDFID(fabricId, switchId, portId string, controllerId uint16) (uint1
 }
 
 func (f *Fabric) GetDownstreamPortRelativePortIndex(switchId, portId string) (int, error) {
+	f.lock()
+	defer f.unlock()
+
 	s := f.findSwitch(switchId)
 	if s == nil {
 		return -1, fmt.Errorf("Switch not found: Switch: %s", switchId)
@@ -1561,6 +1686,9 @@ func (f *Fabric) GetDownstreamPortRelativePortIndex(switchId, portId string) (in
 
 // FindDownstreamEndpoint -
 func (f *Fabric) FindDownstreamEndpoint(portId, functionId string) (string, error) {
+	f.lock()
+	defer f.unlock()
+
 	idx, err := strconv.Atoi(portId)
 	if err != nil {
 		return "", ec.NewErrNotFound()
@@ -1578,6 +1706,9 @@ func (f *Fabric) FindDownstreamEndpoint(portId, functionId string) (string, erro
 }
 
 func (f *Fabric) GetSwitchPort(switchId, portId string) (*Port, error) {
+	f.lock()
+	defer f.unlock()
+
 	s := f.findSwitch(switchId)
 	if s == nil {
 		return nil, fmt.Errorf("failed to find switch: switchId: %s", switchId)
@@ -1590,10 +1721,17 @@ func (f *Fabric) GetSwitchPort(switchId, portId string) (*Port, error) {
 	return p, nil
 }
 
-func (f *Fabric) ResetEndpoint(switchId, portId string, vfIndex int) error {
-	port, err := f.GetSwitchPort(switchId, portId)
-	if err != nil {
-		return err
+func (f *Fabric) ResetEndpoint(ctx context.Context, switchId, portId string, vfIndex int) error {
+	f.lock()
+	defer f.unlock()
+
+	s := f.findSwitch(switchId)
+	if s == nil {
+		return fmt.Errorf("failed to find switch: switchId: %s", switchId)
+	}
+	port := s.findPort(portId)
+	if port == nil {
+		return fmt.Errorf("failed to find port: switchId: %s, portId: %s", switchId, portId)
 	}
 
 	if port.portType != sf.DOWNSTREAM_PORT_PV130PT {
@@ -1606,5 +1744,5 @@ func (f *Fabric) ResetEndpoint(switchId, portId string, vfIndex int) error {
 	}
 
 	ep := port.endpoints[epIndex]
-	return port.swtch.dev.ResetEndpoint(ep.pdfid)
+	return port.swtch.dev.ResetEndpoint(ctx, ep.pdfid)
 }
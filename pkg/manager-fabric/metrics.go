@@ -20,9 +20,11 @@
 package fabric
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
 	telemetry "github.com/NearNodeFlash/nnf-ec/pkg/manager-telemetry"
 	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
 )
@@ -89,8 +91,12 @@ func initializeMetrics() error {
 		func(mrd *telemetry.MetricReportDefinition) ([]telemetry.MetricReportValue, error) {
 
 			vals := make([]telemetry.MetricReportValue, len(switchIds)*len(portIds)*len(properties))
+
+			manager.lock()
+			defer manager.unlock()
+
 			for switchIdx, s := range manager.switches {
-				metrics, err := s.dev.GetPortMetrics()
+				metrics, err := s.refreshPortMetrics(context.Background())
 				if err != nil {
 					return nil, err
 				}
@@ -121,3 +127,45 @@ func initializeMetrics() error {
 
 	return nil
 }
+
+// metrics returns the switch and port metrics for the /metrics endpoint. The Tx/Rx counters are
+// those cached when the switches were last polled; no switch commands are issued.
+func (f *Fabric) metrics() []ec.MetricFamily {
+	f.lock()
+	defer f.unlock()
+
+	switchUp := ec.MetricFamily{Name: "nnf_ec_switch_up", Help: "Whether the switch is available", Type: ec.GaugeMetricType}
+	linkUp := ec.MetricFamily{Name: "nnf_ec_switch_port_link_up", Help: "Whether the switch port link is up", Type: ec.GaugeMetricType}
+	rxBytes := ec.MetricFamily{Name: "nnf_ec_switch_port_rx_bytes_total", Help: "Bytes received by the switch port", Type: ec.CounterMetricType}
+	txBytes := ec.MetricFamily{Name: "nnf_ec_switch_port_tx_bytes_total", Help: "Bytes transmitted by the switch port", Type: ec.CounterMetricType}
+
+	boolValue := func(b bool) float64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+
+	for switchIdx := range f.switches {
+		s := &f.switches[switchIdx]
+
+		switchUp.Samples = append(switchUp.Samples, ec.MetricSample{Labels: ec.MetricLabels{"switch": s.id}, Value: boolValue(s.isReady())})
+
+		for portIdx := range s.ports {
+			p := &s.ports[portIdx]
+			labels := ec.MetricLabels{"switch": s.id, "port": p.id}
+
+			linkUp.Samples = append(linkUp.Samples, ec.MetricSample{
+				Labels: ec.MetricLabels{"switch": s.id, "port": p.id, "type": string(p.portType)},
+				Value:  boolValue(p.linkStatus == sf.LINK_UP_PV130LS),
+			})
+
+			if p.countersValid {
+				rxBytes.Samples = append(rxBytes.Samples, ec.MetricSample{Labels: labels, Value: float64(p.counters.Ingress.Total())})
+				txBytes.Samples = append(txBytes.Samples, ec.MetricSample{Labels: labels, Value: float64(p.counters.Egress.Total())})
+			}
+		}
+	}
+
+	return []ec.MetricFamily{switchUp, linkUp, rxBytes, txBytes}
+}
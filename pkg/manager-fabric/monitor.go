@@ -20,8 +20,10 @@
 package fabric
 
 import (
+	"context"
 	"math"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/NearNodeFlash/nnf-ec/internal/switchtec/pkg/switchtec"
@@ -31,12 +33,15 @@ import (
 // are updated with the latest information from the switch. This runs as a background
 // thread, and periodically queries the fabric.
 func NewMonitor(f *Fabric, i time.Duration) *monitor {
-	return &monitor{fabric: f, interval: i}
+	m := &monitor{fabric: f, interval: i}
+	m.lastPoll.Store(time.Now().UnixNano())
+	return m
 }
 
 type monitor struct {
 	fabric   *Fabric
 	interval time.Duration
+	lastPoll atomic.Int64 // Time the switches were last polled, in Unix nanoseconds
 }
 
 // StartFabricMonitor starts the fabric monitor in a background goroutine if the period is non-zero.
@@ -62,6 +67,7 @@ func StartFabricMonitor(fabric *Fabric) {
 	}
 
 	mon := NewMonitor(fabric, fabricMonitorPeriod)
+	fabric.monitor = mon
 	go mon.Run()
 	if fabric != nil && !fabric.log.IsZero() {
 		fabric.log.Info("Started fabric monitor", "monitorPeriod", fabricMonitorPeriod)
@@ -76,41 +82,53 @@ func (m *monitor) Run() {
 		time.Sleep(m.interval)
 
 		for idx := range m.fabric.switches {
-			s := &m.fabric.switches[idx]
+			m.fabric.lock()
+			m.poll(&m.fabric.switches[idx])
+			m.fabric.unlock()
+		}
+
+		m.lastPoll.Store(time.Now().UnixNano())
+	}
 
-			// The normal path is when the switch is operating without issue and we can
-			// poll the switch for any events then process those events
-			if s.isReady() {
+}
 
-				if events, err := s.dev.GetEvents(); err == nil {
+// poll processes any outstanding events of the switch; the caller must hold the fabric lock.
+func (m *monitor) poll(s *Switch) {
+	ctx := context.Background()
 
-					// In the steady state there will be no events.
-					// Refresh the port status to ensure we're up to date.
-					if len(events) == 0 {
-						s.refreshPortStatus()
-						continue
-					}
+	// The normal path is when the switch is operating without issue and we can
+	// poll the switch for any events then process those events
+	if s.isReady() {
 
-					for _, event := range events {
-						physPortID, isDown := m.getEventInfo(event)
+		if events, err := s.dev.GetEvents(ctx); err == nil {
 
-						if physPortID == invalidPhysicalPortId {
-							continue
-						}
+			// In the steady state there will be no events.
+			// Refresh the port status to ensure we're up to date.
+			if len(events) == 0 {
+				s.refreshPortStatus(ctx)
+				s.refreshPortMetrics(ctx)
+				return
+			}
 
-						if p := s.findPortByPhysicalPortId(physPortID); p != nil {
-							p.notify(isDown)
-						}
-					}
+			for _, event := range events {
+				physPortID, isDown := m.getEventInfo(event)
 
+				if physPortID == invalidPhysicalPortId {
 					continue
 				}
+
+				if p := s.findPortByPhysicalPortId(physPortID); p != nil {
+					p.notify(isDown)
+				}
 			}
 
-			m.checkSwitchStatus(s)
+			s.refreshPortMetrics(ctx)
+
+			return
 		}
 	}
 
+	m.checkSwitchStatus(s)
 }
 
 func (*monitor) checkSwitchStatus(s *Switch) {
@@ -118,7 +136,7 @@ func (*monitor) checkSwitchStatus(s *Switch) {
 	// Check if the switch path changed by trying to re-identify the switch.
 	// If the switch is found, it's likely the switch path has changed and we
 	// need to re-open the switch.
-	if err := s.identify(); err != nil {
+	if err := s.identify(context.Background()); err != nil {
 
 		s.setDown()
 
@@ -21,6 +21,7 @@ package fabric
 
 import (
 	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
 )
 
 // Router contains all the Redfish / Swordfish API calls that are hosted by
@@ -62,6 +63,21 @@ func (r *DefaultApiRouter) Close() error {
 	return nil // TODO: This should close the switchtec device files
 }
 
+// Ready -
+func (r *DefaultApiRouter) Ready() ec.HealthStatus {
+	return manager.ready()
+}
+
+// Live -
+func (r *DefaultApiRouter) Live() ec.HealthStatus {
+	return manager.live()
+}
+
+// Metrics -
+func (r *DefaultApiRouter) Metrics() []ec.MetricFamily {
+	return manager.metrics()
+}
+
 // Routes -
 func (r *DefaultApiRouter) Routes() ec.Routes {
 	s := r.servicer
@@ -71,78 +87,92 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Fabrics",
 			HandlerFunc: s.RedfishV1FabricsGet,
+			Response:    sf.FabricCollectionFabricCollection{},
 		},
 		{
 			Name:        "RedfishV1FabricsFabricIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Fabrics/{FabricId}",
 			HandlerFunc: s.RedfishV1FabricsFabricIdGet,
+			Response:    sf.FabricV120Fabric{},
 		},
 		{
 			Name:        "RedfishV1FabricsFabricIdSwitchesGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Fabrics/{FabricId}/Switches",
 			HandlerFunc: s.RedfishV1FabricsFabricIdSwitchesGet,
+			Response:    sf.SwitchCollectionSwitchCollection{},
 		},
 		{
 			Name:        "RedfishV1FabricsFabricIdSwitchesSwitchIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Fabrics/{FabricId}/Switches/{SwitchId}",
 			HandlerFunc: s.RedfishV1FabricsFabricIdSwitchesSwitchIdGet,
+			Response:    sf.SwitchV140Switch{},
 		},
 		{
 			Name:        "RedfishV1FabricsFabricIdSwitchesSwitchIdPortsGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Fabrics/{FabricId}/Switches/{SwitchId}/Ports",
 			HandlerFunc: s.RedfishV1FabricsFabricIdSwitchesSwitchIdPortsGet,
+			Response:    sf.PortCollectionPortCollection{},
 		},
 		{
 			Name:        "RedfishV1FabricsFabricIdSwitchesSwitchIdPortsPortIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Fabrics/{FabricId}/Switches/{SwitchId}/Ports/{PortId}",
 			HandlerFunc: s.RedfishV1FabricsFabricIdSwitchesSwitchIdPortsPortIdGet,
+			Response:    sf.PortV130Port{},
 		},
 		{
 			Name:        "RedfishV1FabricsFabricIdEndpointsGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Fabrics/{FabricId}/Endpoints",
 			HandlerFunc: s.RedfishV1FabricsFabricIdEndpointsGet,
+			Response:    sf.EndpointCollectionEndpointCollection{},
 		},
 		{
 			Name:        "RedfishV1FabricsFabricIdEndpointsEndpointIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Fabrics/{FabricId}/Endpoints/{EndpointId}",
 			HandlerFunc: s.RedfishV1FabricsFabricIdEndpointsEndpointIdGet,
+			Response:    sf.EndpointV150Endpoint{},
 		},
 		{
 			Name:        "RedfishV1FabricsFabricIdEndpointGroupsGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Fabrics/{FabricId}/EndpointGroups",
 			HandlerFunc: s.RedfishV1FabricsFabricIdEndpointGroupsGet,
+			Response:    sf.EndpointGroupCollectionEndpointGroupCollection{},
 		},
 		{
 			Name:        "RedfishV1FabricsFabricIdEndpointGroupsEndpointGroupIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Fabrics/{FabricId}/EndpointGroups/{EndpointGroupId}",
 			HandlerFunc: s.RedfishV1FabricsFabricIdEndpointGroupsEndpointGroupIdGet,
+			Response:    sf.EndpointGroupV130EndpointGroup{},
 		},
 		{
 			Name:        "RedfishV1FabricsFabricIdConnectionsGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Fabrics/{FabricId}/Connections",
 			HandlerFunc: s.RedfishV1FabricsFabricIdConnectionsGet,
+			Response:    sf.ConnectionCollectionConnectionCollection{},
 		},
 		{
 			Name:        "RedfishV1FabricsFabricIdConnectionsConnectionIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Fabrics/{FabricId}/Connections/{ConnectionId}",
 			HandlerFunc: s.RedfishV1FabricsFabricIdConnectionsConnectionIdGet,
+			Response:    sf.ConnectionV100Connection{},
 		},
 		{
 			Name:        "RedfishV1FabricsFabricIdConnectionsConnectionIdPatch",
 			Method:      ec.PATCH_METHOD,
 			Path:        "/redfish/v1/Fabrics/{FabricId}/Connections/{ConnectionId}",
 			HandlerFunc: s.RedfishV1FabricsFabricIdConnectionsConnectionIdPatch,
+			Response:    sf.ConnectionV100Connection{},
+			Model:       sf.ConnectionV100Connection{},
 		},
 	}
 }
@@ -97,7 +97,7 @@ func (*DefaultApiService) RedfishV1FabricsFabricIdSwitchesSwitchIdGet(w http.Res
 		Name:      "Switch",
 	}
 
-	err := FabricIdSwitchesSwitchIdGet(fabricId, switchId, &model)
+	err := FabricIdSwitchesSwitchIdGet(r.Context(), fabricId, switchId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -229,7 +229,7 @@ func (*DefaultApiService) RedfishV1FabricsFabricIdConnectionsConnectionIdGet(w h
 		Name:      "Connection",
 	}
 
-	err := FabricIdConnectionsConnectionIdGet(fabricId, connectionId, &model)
+	err := FabricIdConnectionsConnectionIdGet(r.Context(), fabricId, connectionId, &model)
 
 	EncodeResponse(model, err, w)
 }
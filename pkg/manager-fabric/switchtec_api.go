@@ -20,6 +20,7 @@
 package fabric
 
 import (
+	"context"
 	"github.com/NearNodeFlash/nnf-ec/internal/switchtec/pkg/switchtec"
 )
 
@@ -37,21 +38,21 @@ type SwitchtecDeviceInterface interface {
 
 	Close()
 
-	Identify() (int32, error)
+	Identify(context.Context) (int32, error)
 
-	GetFirmwareVersion() (string, error)
-	GetModel() (string, error)
-	GetManufacturer() (string, error)
-	GetSerialNumber() (string, error)
+	GetFirmwareVersion(context.Context) (string, error)
+	GetModel(context.Context) (string, error)
+	GetManufacturer(context.Context) (string, error)
+	GetSerialNumber(context.Context) (string, error)
 
-	GetPortStatus() ([]switchtec.PortLinkStat, error)
-	GetPortMetrics() (PortMetrics, error)
-	GetEvents() ([]switchtec.GfmsEvent, error)
+	GetPortStatus(context.Context) ([]switchtec.PortLinkStat, error)
+	GetPortMetrics(context.Context) (PortMetrics, error)
+	GetEvents(context.Context) ([]switchtec.GfmsEvent, error)
 
-	EnumerateEndpoint(uint8, func(epPort *switchtec.DumpEpPortDevice) error) error
-	ResetEndpoint(uint16) error
+	EnumerateEndpoint(context.Context, uint8, func(epPort *switchtec.DumpEpPortDevice) error) error
+	ResetEndpoint(context.Context, uint16) error
 
-	Bind(uint8, uint8, uint16) error
+	Bind(context.Context, uint8, uint8, uint16) error
 }
 
 type PortMetric struct {
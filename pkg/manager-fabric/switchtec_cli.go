@@ -21,6 +21,7 @@ package fabric
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -68,8 +69,8 @@ func (d *SwitchtecCliDevice) Path() *string { return &d.path }
 
 func (d *SwitchtecCliDevice) Close() {}
 
-func (d *SwitchtecCliDevice) Identify() (int32, error) {
-	rsp, err := d.run(fmt.Sprintf("fabric gfms-dump %s --type=PAX | awk '/PAX ID: [0-9]+/{printf $3}'", d.path))
+func (d *SwitchtecCliDevice) Identify(ctx context.Context) (int32, error) {
+	rsp, err := d.run(ctx, fmt.Sprintf("fabric gfms-dump %s --type=PAX | awk '/PAX ID: [0-9]+/{printf $3}'", d.path))
 	if err != nil {
 		return -1, err
 	}
@@ -78,24 +79,24 @@ func (d *SwitchtecCliDevice) Identify() (int32, error) {
 	return int32(d.id), err
 }
 
-func (d *SwitchtecCliDevice) GetFirmwareVersion() (string, error) {
-	return d.run(fmt.Sprintf("info %s | awk '/FW Version: /{printf $3,$4}' OFS=' '", d.path))
+func (d *SwitchtecCliDevice) GetFirmwareVersion(ctx context.Context) (string, error) {
+	return d.run(ctx, fmt.Sprintf("info %s | awk '/FW Version: /{printf $3,$4}' OFS=' '", d.path))
 }
 
-func (d *SwitchtecCliDevice) GetModel() (string, error) {
-	return d.run(fmt.Sprintf("info %s | awk '/Device ID: /{printf $3}'", d.path))
+func (d *SwitchtecCliDevice) GetModel(ctx context.Context) (string, error) {
+	return d.run(ctx, fmt.Sprintf("info %s | awk '/Device ID: /{printf $3}'", d.path))
 }
 
-func (d *SwitchtecCliDevice) GetManufacturer() (string, error) {
+func (d *SwitchtecCliDevice) GetManufacturer(ctx context.Context) (string, error) {
 	return "Microchip", nil
 }
 
-func (d *SwitchtecCliDevice) GetSerialNumber() (string, error) {
-	return d.run(fmt.Sprintf("mfg info %s | awk '/Chip Serial:/{printf $3}'", d.path))
+func (d *SwitchtecCliDevice) GetSerialNumber(ctx context.Context) (string, error) {
+	return d.run(ctx, fmt.Sprintf("mfg info %s | awk '/Chip Serial:/{printf $3}'", d.path))
 }
 
-func (d *SwitchtecCliDevice) GetPortStatus() ([]switchtec.PortLinkStat, error) {
-	rsp, err := d.run(fmt.Sprintf("status %s --pax=%d", d.path, d.id))
+func (d *SwitchtecCliDevice) GetPortStatus(ctx context.Context) ([]switchtec.PortLinkStat, error) {
+	rsp, err := d.run(ctx, fmt.Sprintf("status %s --pax=%d", d.path, d.id))
 	if err != nil {
 		return nil, err
 	}
@@ -148,13 +149,13 @@ func (d *SwitchtecCliDevice) GetPortStatus() ([]switchtec.PortLinkStat, error) {
 	return stats, scanner.Err()
 }
 
-func (d *SwitchtecCliDevice) GetEvents() ([]switchtec.GfmsEvent, error) {
+func (d *SwitchtecCliDevice) GetEvents(ctx context.Context) ([]switchtec.GfmsEvent, error) {
 	// TODO
 	// NJR: Unfortunately the switchtec behavior is to clear events on read - so at the moment
 	//      I dont have a system that returns events so I can figure out how to parse them. It'll
 	//      be something like
 	//
-	//          rsp, err := d.run(fmt.Sprintf("fabric gfms-events %s", d.path))
+	//          rsp, err := d.run(ctx, fmt.Sprintf("fabric gfms-events %s", d.path))
 	//
 
 	// UPDATE: I found a system that has some events. Long term we can look at the switchtec code to get the output format
@@ -198,14 +199,14 @@ func (d *SwitchtecCliDevice) GetEvents() ([]switchtec.GfmsEvent, error) {
 	return make([]switchtec.GfmsEvent, 0), nil
 }
 
-func (d *SwitchtecCliDevice) GetPortMetrics() (PortMetrics, error) {
+func (d *SwitchtecCliDevice) GetPortMetrics(ctx context.Context) (PortMetrics, error) {
 	// Switchtec CLI does not provide the current counters - it only provides a
 	// measure of bandwidth over a time period.
 	return make(PortMetrics, 0), nil
 }
 
-func (d *SwitchtecCliDevice) EnumerateEndpoint(physPortId uint8, handlerFunc func(epPort *switchtec.DumpEpPortDevice) error) error {
-	rsp, err := d.run(fmt.Sprintf("fabric gfms-dump %s --type=EP_PORT --ep_pid=%d", d.path, physPortId))
+func (d *SwitchtecCliDevice) EnumerateEndpoint(ctx context.Context, physPortId uint8, handlerFunc func(epPort *switchtec.DumpEpPortDevice) error) error {
+	rsp, err := d.run(ctx, fmt.Sprintf("fabric gfms-dump %s --type=EP_PORT --ep_pid=%d", d.path, physPortId))
 	if err != nil {
 		return err
 	}
@@ -283,13 +284,13 @@ func (d *SwitchtecCliDevice) EnumerateEndpoint(physPortId uint8, handlerFunc fun
 	})
 }
 
-func (d *SwitchtecCliDevice) ResetEndpoint(pdfid uint16) error {
+func (d *SwitchtecCliDevice) ResetEndpoint(ctx context.Context, pdfid uint16) error {
 	panic("not yet implemented")
 }
 
-func (d *SwitchtecCliDevice) Bind(hostPhysPortId, hostLogPortId uint8, pdfid uint16) error {
+func (d *SwitchtecCliDevice) Bind(ctx context.Context, hostPhysPortId, hostLogPortId uint8, pdfid uint16) error {
 	// Usage: switchtec fabric gfms-bind <device> --host_sw_idx=<NUM> --phys_port_id=<NUM> --log_port_id=<NUM> --pdfid=<STR> [OPTIONS]
-	rsp, err := d.run(fmt.Sprintf("fabric gfms-bind %s --pax=%d --host_sw_idx=%d --phys_port_id=%d --log_port_id=%d --pdfid=%#04x", d.path, d.id, d.id, hostPhysPortId, hostLogPortId, pdfid))
+	rsp, err := d.run(ctx, fmt.Sprintf("fabric gfms-bind %s --pax=%d --host_sw_idx=%d --phys_port_id=%d --log_port_id=%d --pdfid=%#04x", d.path, d.id, d.id, hostPhysPortId, hostLogPortId, pdfid))
 	if err != nil {
 		return err
 	}
@@ -301,10 +302,10 @@ func (d *SwitchtecCliDevice) Bind(hostPhysPortId, hostLogPortId uint8, pdfid uin
 	return nil
 }
 
-func (d *SwitchtecCliDevice) run(cmd string) (string, error) {
+func (d *SwitchtecCliDevice) run(ctx context.Context, cmd string) (string, error) {
 	cmd = fmt.Sprintf("switchtec %s", cmd)
 
-	rsp, err := logging.Cli.Trace(cmd, func(cmd string) ([]byte, error) {
+	rsp, err := logging.Cli.Trace(ctx, cmd, func(cmd string) ([]byte, error) {
 		return exec.Command("bash", "-c", fmt.Sprintf("/usr/local/bin/%s", cmd)).Output()
 	})
 
@@ -20,6 +20,7 @@
 package fabric
 
 import (
+	"context"
 	"os"
 	"strconv"
 
@@ -61,34 +62,34 @@ func (d *SwitchtecDevice) Close() {
 	d.dev.Close()
 }
 
-func (d *SwitchtecDevice) Identify() (int32, error) {
+func (d *SwitchtecDevice) Identify(ctx context.Context) (int32, error) {
 	return d.dev.Identify()
 }
 
-func (d *SwitchtecDevice) GetFirmwareVersion() (string, error) {
+func (d *SwitchtecDevice) GetFirmwareVersion(ctx context.Context) (string, error) {
 	return d.dev.GetFirmwareVersion()
 }
 
-func (d *SwitchtecDevice) GetModel() (string, error) {
+func (d *SwitchtecDevice) GetModel(ctx context.Context) (string, error) {
 	id, err := d.dev.GetDeviceId()
 
 	return strconv.Itoa(int(id)), err
 }
 
-func (d *SwitchtecDevice) GetManufacturer() (string, error) {
+func (d *SwitchtecDevice) GetManufacturer(ctx context.Context) (string, error) {
 	return "Microsemi", nil
 }
 
-func (d *SwitchtecDevice) GetSerialNumber() (string, error) {
+func (d *SwitchtecDevice) GetSerialNumber(ctx context.Context) (string, error) {
 	sn, err := d.dev.GetSerialNumber()
 	return strconv.Itoa(int(sn)), err
 }
 
-func (d *SwitchtecDevice) GetPortStatus() ([]switchtec.PortLinkStat, error) {
+func (d *SwitchtecDevice) GetPortStatus(ctx context.Context) ([]switchtec.PortLinkStat, error) {
 	return d.dev.LinkStat()
 }
 
-func (d *SwitchtecDevice) GetPortMetrics() (PortMetrics, error) {
+func (d *SwitchtecDevice) GetPortMetrics(ctx context.Context) (PortMetrics, error) {
 	portIds, counters, err := d.dev.BandwidthCounterAll(false)
 	if err != nil {
 		return nil, err
@@ -105,18 +106,18 @@ func (d *SwitchtecDevice) GetPortMetrics() (PortMetrics, error) {
 	return metrics, nil
 }
 
-func (d *SwitchtecDevice) GetEvents() ([]switchtec.GfmsEvent, error) {
+func (d *SwitchtecDevice) GetEvents(ctx context.Context) ([]switchtec.GfmsEvent, error) {
 	return d.dev.GetGfmsEvents()
 }
 
-func (d *SwitchtecDevice) EnumerateEndpoint(id uint8, f func(epPort *switchtec.DumpEpPortDevice) error) error {
+func (d *SwitchtecDevice) EnumerateEndpoint(ctx context.Context, id uint8, f func(epPort *switchtec.DumpEpPortDevice) error) error {
 	return d.dev.GfmsEpPortDeviceEnumerate(id, f)
 }
 
-func (d *SwitchtecDevice) ResetEndpoint(pdfid uint16) error {
+func (d *SwitchtecDevice) ResetEndpoint(ctx context.Context, pdfid uint16) error {
 	return d.dev.VfReset(pdfid)
 }
 
-func (d *SwitchtecDevice) Bind(hostPhysPortId, hostLogPortId uint8, pdfid uint16) error {
+func (d *SwitchtecDevice) Bind(ctx context.Context, hostPhysPortId, hostLogPortId uint8, pdfid uint16) error {
 	return d.dev.Bind(uint8(d.dev.ID()), hostPhysPortId, hostLogPortId, pdfid)
 }
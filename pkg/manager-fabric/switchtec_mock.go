@@ -20,6 +20,7 @@
 package fabric
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"os"
@@ -184,30 +185,30 @@ func (d *MockSwitchtecDevice) Close() {
 	d.id = -1
 }
 
-func (d *MockSwitchtecDevice) Identify() (int32, error) {
+func (d *MockSwitchtecDevice) Identify(ctx context.Context) (int32, error) {
 	return int32(d.id), nil
 }
 
-func (d *MockSwitchtecDevice) GetFirmwareVersion() (string, error) {
+func (d *MockSwitchtecDevice) GetFirmwareVersion(ctx context.Context) (string, error) {
 	return "MockFirmware", nil
 }
 
-func (d *MockSwitchtecDevice) GetModel() (string, error) {
+func (d *MockSwitchtecDevice) GetModel(ctx context.Context) (string, error) {
 	return "MockModel", nil
 }
 
-func (d *MockSwitchtecDevice) GetManufacturer() (string, error) {
+func (d *MockSwitchtecDevice) GetManufacturer(ctx context.Context) (string, error) {
 	return "MockMfg", nil
 }
 
-func (d *MockSwitchtecDevice) GetSerialNumber() (string, error) {
+func (d *MockSwitchtecDevice) GetSerialNumber(ctx context.Context) (string, error) {
 	return "MockSerialNumber", nil
 }
 
 var firstUpstreamPortDisabled bool   // For test, record the first upstream port as being down.
 var firstDownstreamPortDisabled bool // For test, record the first downstream port as being down.
 
-func (d *MockSwitchtecDevice) GetPortStatus() ([]switchtec.PortLinkStat, error) {
+func (d *MockSwitchtecDevice) GetPortStatus(ctx context.Context) ([]switchtec.PortLinkStat, error) {
 	stats := make([]switchtec.PortLinkStat, len(d.ports))
 
 	for idx := range stats {
@@ -229,7 +230,7 @@ func (d *MockSwitchtecDevice) GetPortStatus() ([]switchtec.PortLinkStat, error)
 	return stats, nil
 }
 
-func (d *MockSwitchtecDevice) GetPortMetrics() (PortMetrics, error) {
+func (d *MockSwitchtecDevice) GetPortMetrics(ctx context.Context) (PortMetrics, error) {
 
 	counters := make(PortMetrics, len(d.ports))
 
@@ -254,11 +255,11 @@ func (d *MockSwitchtecDevice) GetPortMetrics() (PortMetrics, error) {
 	return counters, nil
 }
 
-func (d *MockSwitchtecDevice) GetEvents() ([]switchtec.GfmsEvent, error) {
+func (d *MockSwitchtecDevice) GetEvents(ctx context.Context) ([]switchtec.GfmsEvent, error) {
 	return make([]switchtec.GfmsEvent, 0), nil
 }
 
-func (d *MockSwitchtecDevice) EnumerateEndpoint(physPortId uint8, handlerFunc func(epPort *switchtec.DumpEpPortDevice) error) error {
+func (d *MockSwitchtecDevice) EnumerateEndpoint(ctx context.Context, physPortId uint8, handlerFunc func(epPort *switchtec.DumpEpPortDevice) error) error {
 
 	for _, port := range d.ports {
 		if uint8(port.config.Port) == physPortId {
@@ -275,11 +276,11 @@ func (d *MockSwitchtecDevice) EnumerateEndpoint(physPortId uint8, handlerFunc fu
 	return nil
 }
 
-func (d *MockSwitchtecDevice) ResetEndpoint(pdfid uint16) error {
+func (d *MockSwitchtecDevice) ResetEndpoint(ctx context.Context, pdfid uint16) error {
 	return nil
 }
 
-func (d *MockSwitchtecDevice) Bind(hostPhysPortId, hostLogPortId uint8, pdfid uint16) error {
+func (d *MockSwitchtecDevice) Bind(ctx context.Context, hostPhysPortId, hostLogPortId uint8, pdfid uint16) error {
 
 	bindPort := func(hostPort *MockSwitchtecPort, hostLogPortId uint8, pdfid uint16) error {
 		for deviceIdx := range d.ctrl.devices {
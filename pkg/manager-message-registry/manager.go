@@ -22,6 +22,7 @@ package messageregistry
 import (
 	_ "embed"
 	"fmt"
+	"strings"
 
 	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
 	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
@@ -88,3 +89,44 @@ func (m *manager) RegistryIdGet(id string, model *sf.MessageRegistryFileV113Mess
 
 	return nil
 }
+
+// Message - Provides the message identified by the registry prefix and message key, with the message
+// arguments substituted into the message text
+func (m *manager) Message(registryPrefix, key string, args ...string) (sf.MessageV111Message, bool) {
+	for _, r := range m.registries {
+		if r.Model.RegistryPrefix != registryPrefix {
+			continue
+		}
+
+		msg, ok := r.Model.Messages[key]
+		if !ok {
+			return sf.MessageV111Message{}, false
+		}
+
+		// Substitute the highest numbered arguments first so %1 does not match the start of %10
+		text := msg.Message
+		for idx := len(args); idx > 0; idx-- {
+			text = strings.ReplaceAll(text, fmt.Sprintf("%%%d", idx), args[idx-1])
+		}
+
+		// Arguments naming a property of the request body are the related properties of the message
+		var related []string
+		for idx, description := range msg.ArgDescriptions {
+			description = strings.ToLower(description)
+			if idx < len(args) && strings.Contains(description, "name of the") && strings.Contains(description, "property") {
+				related = append(related, "#/"+args[idx])
+			}
+		}
+
+		return sf.MessageV111Message{
+			MessageId:         fmt.Sprintf("%s.%s.%s", r.Model.RegistryPrefix, r.Model.RegistryVersion, key),
+			Message:           text,
+			MessageArgs:       args,
+			MessageSeverity:   msg.MessageSeverity,
+			RelatedProperties: related,
+			Resolution:        msg.Resolution,
+		}, true
+	}
+
+	return sf.MessageV111Message{}, false
+}
@@ -73,3 +73,66 @@ func NvmeStateChangeNnf(arg0, arg1, arg2 string) events.Event {
 		MessageArgs:     []string{arg0, arg1, arg2},
 	}
 }
+
+// StoragePoolVolumeMissingNnf - event indicating that a storage pool volume could not be found
+// arg0: The storage pool identifier. This argument shall contain the storage pool resource identifier.
+// arg1: The durable name of the storage device. This argument shall contain the durable name of the storage device that should contain the namespace.
+// arg2: The namespace id on the storage device. This argument shall contain the namespace id that could not be found.
+func StoragePoolVolumeMissingNnf(arg0, arg1, arg2 string) events.Event {
+	return events.Event{
+		Message:         "The storage pool '%1' is missing namespace '%3' on storage '%2'",
+		MessageSeverity: "Warning",
+		MessageId:       "Nnf.1.0.0.StoragePoolVolumeMissing",
+		MessageArgs:     []string{arg0, arg1, arg2},
+	}
+}
+
+// UnknownVolumeFoundNnf - event indicating that a volume is not owned by any storage pool
+// arg0: The durable name of the storage device. This argument shall contain the durable name of the storage device containing the namespace.
+// arg1: The namespace id on the storage device. This argument shall contain the namespace id that is not owned by any storage pool.
+func UnknownVolumeFoundNnf(arg0, arg1 string) events.Event {
+	return events.Event{
+		Message:         "The namespace '%2' on storage '%1' is not owned by any storage pool",
+		MessageSeverity: "Warning",
+		MessageId:       "Nnf.1.0.0.UnknownVolumeFound",
+		MessageArgs:     []string{arg0, arg1},
+	}
+}
+
+// StorageGroupControllerMissingNnf - event indicating that a storage group is attached to a controller that no longer exists
+// arg0: The storage group identifier. This argument shall contain the storage group resource identifier.
+// arg1: The endpoint identifier. This argument shall contain the server endpoint resource identifier of the storage group.
+// arg2: The controller identifier. This argument shall contain the controller identifier the storage group is attached to.
+func StorageGroupControllerMissingNnf(arg0, arg1, arg2 string) events.Event {
+	return events.Event{
+		Message:         "The storage group '%1' is attached to endpoint '%2' controller '%3' which no longer exists",
+		MessageSeverity: "Warning",
+		MessageId:       "Nnf.1.0.0.StorageGroupControllerMissing",
+		MessageArgs:     []string{arg0, arg1, arg2},
+	}
+}
+
+// VolumeQuarantinedNnf - event indicating that a volume not owned by any storage pool was quarantined
+// arg0: The durable name of the storage device. This argument shall contain the durable name of the storage device containing the namespace.
+// arg1: The namespace id on the storage device. This argument shall contain the namespace id that was quarantined.
+func VolumeQuarantinedNnf(arg0, arg1 string) events.Event {
+	return events.Event{
+		Message:         "The namespace '%2' on storage '%1' was quarantined",
+		MessageSeverity: "Warning",
+		MessageId:       "Nnf.1.0.0.VolumeQuarantined",
+		MessageArgs:     []string{arg0, arg1},
+	}
+}
+
+// InterruptedOperationFoundNnf - event indicating that an operation was interrupted before it completed
+// arg0: The resource affected. This argument shall contain the URI of the resource the operation was acting on.
+// arg1: The operation interrupted. This argument shall contain the name of the operation that was interrupted.
+// arg2: The action taken. This argument shall contain the action taken when the interruption was found.
+func InterruptedOperationFoundNnf(arg0, arg1, arg2 string) events.Event {
+	return events.Event{
+		Message:         "The %2 operation on '%1' was interrupted; action taken: %3",
+		MessageSeverity: "Warning",
+		MessageId:       "Nnf.1.0.0.InterruptedOperationFound",
+		MessageArgs:     []string{arg0, arg1, arg2},
+	}
+}
@@ -21,6 +21,7 @@ package messageregistry
 
 import (
 	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
 )
 
 type DefaultApiRouter struct {
@@ -47,6 +48,10 @@ func (*DefaultApiRouter) Close() error {
 	return nil
 }
 
+func (*DefaultApiRouter) Message(registryPrefix, key string, args ...string) (sf.MessageV111Message, bool) {
+	return MessageRegistryManager.Message(registryPrefix, key, args...)
+}
+
 func (r *DefaultApiRouter) Routes() ec.Routes {
 	s := r.servicer
 	return ec.Routes{
@@ -55,12 +60,14 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Registries",
 			HandlerFunc: s.RedfishV1RegistriesGet,
+			Response:    sf.MessageRegistryFileCollectionMessageRegistryFileCollection{},
 		},
 		{
 			Name:        "RedfishV1RegistriesRegistryIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Registries/{RegistryId}",
 			HandlerFunc: r.servicer.RedfishV1RegistriesRegistryIdGet,
+			Response:    sf.MessageRegistryFileV113MessageRegistryFile{},
 		},
 		{
 			Name:        "RedfishV1RegistriesRegistryIdGetRegistryGet",
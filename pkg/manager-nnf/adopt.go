@@ -0,0 +1,279 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nnf
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	nvme2 "github.com/NearNodeFlash/nnf-ec/internal/switchtec/pkg/nvme"
+	"github.com/NearNodeFlash/nnf-ec/pkg/common"
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	event "github.com/NearNodeFlash/nnf-ec/pkg/manager-event"
+	msgreg "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry/registries"
+	nvme "github.com/NearNodeFlash/nnf-ec/pkg/manager-nvme"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// StoragePoolAdoptOem - Oem properties to create a storage pool from existing volumes rather than
+// allocating new ones.
+type StoragePoolAdoptOem struct {
+	Adopt StoragePoolAdopt `json:"Adopt"`
+}
+
+// StoragePoolAdopt describes the existing volumes to adopt into a new storage pool. Volumes are identified
+// either by an explicit list of drive serial number and namespace id, or by the storage pool UUID recorded
+// in the on-drive namespace metadata. Only one of the two may be provided.
+type StoragePoolAdopt struct {
+	Uid     string                   `json:"Uid,omitempty"`
+	Volumes []StoragePoolAdoptVolume `json:"Volumes,omitempty"`
+}
+
+// StoragePoolAdoptVolume identifies an existing volume by drive serial number and namespace id
+type StoragePoolAdoptVolume struct {
+	SerialNumber string `json:"SerialNumber"`
+	NamespaceId  int    `json:"NamespaceId"`
+}
+
+func (a *StoragePoolAdopt) requested() bool {
+	return len(a.Uid) != 0 || len(a.Volumes) != 0
+}
+
+// adoptStoragePool creates a new storage pool from the existing volumes described by adopt. The volumes must
+// not be owned by any other storage pool. The new pool is recorded through the persistent controller the same
+// as an allocated storage pool. A pool adopted by its list of volumes is given a new UUID, and the namespace
+// metadata of every adopted volume is rewritten to record the pool's UUID.
+func (s *StorageService) adoptStoragePool(ctx context.Context, model *sf.StoragePoolV150StoragePool, adopt *StoragePoolAdopt) (err error) {
+	log := s.log.WithValues(modelIdKey, model.Id)
+	log.V(2).Info("Adopting storage pool", "uid", adopt.Uid, "volumes", adopt.Volumes)
+	defer func() {
+		if err != nil {
+			log.Error(err, "Adopt storage pool failed")
+		}
+	}()
+
+	if len(adopt.Uid) != 0 && len(adopt.Volumes) != 0 {
+		return ec.NewErrBadRequest().WithEvent(msgreg.PropertyValueConflictBase("Volumes", "Uid"))
+	}
+
+	var uid uuid.UUID
+	var providingVolumes []nvme.ProvidingVolume
+
+	if len(adopt.Volumes) != 0 {
+		providingVolumes, err = s.findAdoptVolumes(adopt.Volumes)
+	} else {
+		uid, err = uuid.Parse(adopt.Uid)
+		if err != nil {
+			return ec.NewErrBadRequest().WithError(err).WithEvent(msgreg.PropertyValueFormatErrorBase(adopt.Uid, "Uid"))
+		}
+
+		s.mutex.RLock()
+		for _, p := range s.pools {
+			if p.uid == uid {
+				s.mutex.RUnlock()
+				return ec.NewErrNotAcceptable().WithEvent(msgreg.ResourceAlreadyExistsBase(StoragePoolOdataType, "Uid", adopt.Uid))
+			}
+		}
+		s.mutex.RUnlock()
+
+		providingVolumes, err = s.findAdoptVolumesByUid(ctx, uid)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	p := s.createStoragePool(model.Id, model.Name, model.Description, uid, nil)
+	defer p.mutex.Unlock()
+
+	if err := s.claimVolumes(p, providingVolumes); err != nil {
+		s.deleteStoragePool(p)
+		return err
+	}
+
+	updateFunc := func() error {
+		p.updateAllocatedVolume()
+
+		return p.writeNamespaceMetadata(ctx)
+	}
+
+	if err := s.persistentController.CreatePersistentObject(ctx, p, updateFunc, storagePoolStorageCreateStartLogEntryType, storagePoolStorageCreateCompleteLogEntryType); err != nil {
+		s.deleteStoragePool(p)
+		return ec.NewErrInternalServerError().WithResourceType(StorageServiceOdataType).WithError(err).WithCause("Failed to record adopted storage pool")
+	}
+
+	// The volumes are now under management; release any that were quarantined
+	for _, pv := range p.providingVolumes {
+		s.releaseQuarantinedVolume(pv)
+	}
+
+	event.EventManager.PublishResourceEvent(msgreg.ResourceCreatedResourceEvent(), p)
+
+	log.Info("Adopted storage pool", storagePoolIdKey, p.id, "volumes", len(p.providingVolumes), "capacityInBytes", p.allocatedVolume.capacityBytes)
+
+	return s.StorageServiceIdStoragePoolIdGet(ctx, s.id, p.id, model)
+}
+
+// writeNamespaceMetadata records the storage pool UUID and the position of each volume in the on-drive
+// namespace metadata, so the volumes can later be matched to the storage pool by its UUID.
+func (p *StoragePool) writeNamespaceMetadata(ctx context.Context) error {
+	for idx, pv := range p.providingVolumes {
+		volume := pv.Storage.FindVolume(pv.VolumeId)
+		if volume == nil {
+			return fmt.Errorf("volume %s not found on storage %s", pv.VolumeId, pv.Storage.SerialNumber())
+		}
+
+		data, err := common.EncodeNamespaceMetadata(p.uid, uint16(idx), uint16(len(p.providingVolumes)))
+		if err != nil {
+			return err
+		}
+
+		if err := volume.SetFeature(ctx, data); err != nil {
+			return fmt.Errorf("failed to write namespace metadata for volume %s on storage %s: %w", pv.VolumeId, pv.Storage.SerialNumber(), err)
+		}
+	}
+
+	return nil
+}
+
+// claimVolumes assigns the volumes to the storage pool provided no other storage pool owns them. The check
+// and the assignment are made with the service locked so two adoptions cannot claim the same volume.
+func (s *StorageService) claimVolumes(sp *StoragePool, providingVolumes []nvme.ProvidingVolume) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, pv := range providingVolumes {
+		for _, p := range s.pools {
+			for _, owned := range p.providingVolumes {
+				if owned.Storage.SerialNumber() == pv.Storage.SerialNumber() && owned.VolumeId == pv.VolumeId {
+					return ec.NewErrNotAcceptable().WithResourceType(StoragePoolOdataType).WithEvent(msgreg.ResourceInUseBase()).
+						WithCause(fmt.Sprintf("Volume '%s' on storage '%s' is owned by storage pool '%s'", pv.VolumeId, pv.Storage.SerialNumber(), p.id))
+				}
+			}
+		}
+	}
+
+	sp.providingVolumes = providingVolumes
+
+	return nil
+}
+
+// findAdoptVolumes locates each of the volumes in the list by drive serial number and namespace id.
+func (s *StorageService) findAdoptVolumes(volumes []StoragePoolAdoptVolume) ([]nvme.ProvidingVolume, error) {
+	providingVolumes := make([]nvme.ProvidingVolume, 0, len(volumes))
+
+	for _, v := range volumes {
+		nsid := strconv.Itoa(v.NamespaceId)
+
+		storage := s.findStorage(v.SerialNumber)
+		if storage == nil || !storage.IsEnabled() {
+			return nil, ec.NewErrNotAcceptable().WithEvent(msgreg.PropertyValueNotInListBase(v.SerialNumber, "SerialNumber"))
+		}
+
+		volume, err := storage.FindVolumeByNamespaceId(nvme2.NamespaceIdentifier(v.NamespaceId))
+		if err != nil {
+			return nil, ec.NewErrNotAcceptable().WithError(err).WithEvent(msgreg.PropertyValueNotInListBase(nsid, "NamespaceId"))
+		}
+
+		for _, pv := range providingVolumes {
+			if pv.Storage == storage && pv.VolumeId == volume.Id() {
+				return nil, ec.NewErrBadRequest().WithEvent(msgreg.PropertyValueIncorrectBase("Volumes", fmt.Sprintf("%s/%s", v.SerialNumber, nsid))).
+					WithCause("Volume listed more than once")
+			}
+		}
+
+		providingVolumes = append(providingVolumes, nvme.ProvidingVolume{Storage: storage, VolumeId: volume.Id()})
+	}
+
+	return providingVolumes, nil
+}
+
+// findAdoptVolumesByUid locates the volumes not owned by any storage pool whose on-drive namespace metadata
+// records the provided storage pool UUID. Every volume described by the metadata must be present.
+func (s *StorageService) findAdoptVolumesByUid(ctx context.Context, uid uuid.UUID) ([]nvme.ProvidingVolume, error) {
+	log := s.log.WithValues("uid", uid.String())
+
+	var ownedVolumes []nvme.ProvidingVolume
+	s.mutex.RLock()
+	for _, p := range s.pools {
+		ownedVolumes = append(ownedVolumes, p.providingVolumes...)
+	}
+	s.mutex.RUnlock()
+
+	type adoptVolume struct {
+		nvme.ProvidingVolume
+		metadata *common.NamespaceMetadata
+	}
+
+	var found []adoptVolume
+	for _, uv := range nvme.FindUnknownVolumes(ownedVolumes) {
+		md := s.quarantinedVolumeMetadata(uv)
+		if md == nil {
+			volume := uv.Storage.FindVolume(uv.VolumeId)
+			if volume == nil {
+				continue
+			}
+
+			data, err := volume.GetFeature(ctx)
+			if err != nil {
+				log.Error(err, "Failed to read namespace metadata", "serialNumber", uv.Storage.SerialNumber(), "volumeId", uv.VolumeId)
+				continue
+			}
+
+			if md, err = common.DecodeNamespaceMetadata(data); err != nil {
+				continue
+			}
+		}
+
+		if md.Id == uid {
+			found = append(found, adoptVolume{ProvidingVolume: uv, metadata: md})
+		}
+	}
+
+	if len(found) == 0 {
+		return nil, ec.NewErrNotAcceptable().WithEvent(msgreg.PropertyValueNotInListBase(uid.String(), "Uid")).
+			WithCause("No volumes found with matching storage pool metadata")
+	}
+
+	// Order the volumes as recorded in the metadata and check that none are missing or duplicated
+	sort.Slice(found, func(i, j int) bool { return found[i].metadata.Index < found[j].metadata.Index })
+
+	count := int(found[0].metadata.Count)
+	if len(found) != count {
+		return nil, ec.NewErrNotAcceptable().WithEvent(msgreg.PropertyValueIncorrectBase("Uid", uid.String())).
+			WithCause(fmt.Sprintf("Found %d of %d volumes with matching storage pool metadata", len(found), count))
+	}
+
+	providingVolumes := make([]nvme.ProvidingVolume, len(found))
+	for idx, v := range found {
+		if int(v.metadata.Index) != idx || int(v.metadata.Count) != count {
+			return nil, ec.NewErrNotAcceptable().WithEvent(msgreg.PropertyValueIncorrectBase("Uid", uid.String())).
+				WithCause("Storage pool metadata is inconsistent across volumes")
+		}
+
+		providingVolumes[idx] = v.ProvidingVolume
+	}
+
+	return providingVolumes, nil
+}
@@ -20,6 +20,7 @@
 package nnf
 
 import (
+	"context"
 	"errors"
 
 	events "github.com/NearNodeFlash/nnf-ec/pkg/manager-event"
@@ -75,104 +76,128 @@ func (aer *AerService) Id() string {
 	return aer.s.Id()
 }
 
-func (aer *AerService) StorageServicesGet(m *sf.StorageServiceCollectionStorageServiceCollection) error {
-	return aer.c(aer.s.StorageServicesGet(m))
+func (aer *AerService) StorageServicesGet(ctx context.Context, m *sf.StorageServiceCollectionStorageServiceCollection) error {
+	return aer.c(aer.s.StorageServicesGet(ctx, m))
 }
-func (aer *AerService) StorageServiceIdGet(id string, model *sf.StorageServiceV150StorageService) error {
-	return aer.c(aer.s.StorageServiceIdGet(id, model))
+func (aer *AerService) StorageServiceIdGet(ctx context.Context, id string, model *sf.StorageServiceV150StorageService) error {
+	return aer.c(aer.s.StorageServiceIdGet(ctx, id, model))
 }
-func (aer *AerService) StorageServiceIdCapacitySourceGet(id string, model *sf.CapacityCapacitySource) error {
-	return aer.c(aer.s.StorageServiceIdCapacitySourceGet(id, model))
+func (aer *AerService) StorageServiceIdCapacitySourceGet(ctx context.Context, id string, model *sf.CapacityCapacitySource) error {
+	return aer.c(aer.s.StorageServiceIdCapacitySourceGet(ctx, id, model))
 }
 
-func (aer *AerService) StorageServiceIdStoragePoolsGet(id string, model *sf.StoragePoolCollectionStoragePoolCollection) error {
-	return aer.c(aer.s.StorageServiceIdStoragePoolsGet(id, model))
+func (aer *AerService) StorageServiceIdAuditGet(ctx context.Context, id string, model *AuditReport) error {
+	return aer.c(aer.s.StorageServiceIdAuditGet(ctx, id, model))
 }
-func (aer *AerService) StorageServiceIdStoragePoolsPost(id string, model *sf.StoragePoolV150StoragePool) error {
-	return aer.c(aer.s.StorageServiceIdStoragePoolsPost(id, model))
+func (aer *AerService) StorageServiceIdAuditPost(ctx context.Context, id string, model *AuditReport) error {
+	return aer.c(aer.s.StorageServiceIdAuditPost(ctx, id, model))
 }
-func (aer *AerService) StorageServiceIdStoragePoolsPatch(id string, model *sf.StoragePoolCollectionStoragePoolCollection) error {
-	return aer.c(aer.s.StorageServiceIdStoragePoolsPatch(id, model))
+
+func (aer *AerService) StorageServiceIdQuarantinedVolumesGet(ctx context.Context, id string, model *QuarantinedVolumeCollection) error {
+	return aer.c(aer.s.StorageServiceIdQuarantinedVolumesGet(ctx, id, model))
+}
+func (aer *AerService) StorageServiceIdQuarantinedVolumeIdGet(ctx context.Context, id, qvid string, model *QuarantinedVolume) error {
+	return aer.c(aer.s.StorageServiceIdQuarantinedVolumeIdGet(ctx, id, qvid, model))
+}
+func (aer *AerService) StorageServiceIdQuarantinedVolumeIdDelete(ctx context.Context, id, qvid string) error {
+	return aer.c(aer.s.StorageServiceIdQuarantinedVolumeIdDelete(ctx, id, qvid))
+}
+
+func (aer *AerService) StorageServiceIdInterruptedOperationsGet(ctx context.Context, id string, model *InterruptedOperationCollection) error {
+	return aer.c(aer.s.StorageServiceIdInterruptedOperationsGet(ctx, id, model))
+}
+func (aer *AerService) StorageServiceIdInterruptedOperationIdGet(ctx context.Context, id, opid string, model *InterruptedOperation) error {
+	return aer.c(aer.s.StorageServiceIdInterruptedOperationIdGet(ctx, id, opid, model))
+}
+
+func (aer *AerService) StorageServiceIdStoragePoolsGet(ctx context.Context, id string, model *sf.StoragePoolCollectionStoragePoolCollection) error {
+	return aer.c(aer.s.StorageServiceIdStoragePoolsGet(ctx, id, model))
+}
+func (aer *AerService) StorageServiceIdStoragePoolsPost(ctx context.Context, id string, model *sf.StoragePoolV150StoragePool) error {
+	return aer.c(aer.s.StorageServiceIdStoragePoolsPost(ctx, id, model))
+}
+func (aer *AerService) StorageServiceIdStoragePoolsPatch(ctx context.Context, id string, model *sf.StoragePoolCollectionStoragePoolCollection) error {
+	return aer.c(aer.s.StorageServiceIdStoragePoolsPatch(ctx, id, model))
 }
-func (aer *AerService) StorageServiceIdStoragePoolIdGet(id0 string, id1 string, model *sf.StoragePoolV150StoragePool) error {
-	return aer.c(aer.s.StorageServiceIdStoragePoolIdGet(id0, id1, model))
+func (aer *AerService) StorageServiceIdStoragePoolIdGet(ctx context.Context, id0 string, id1 string, model *sf.StoragePoolV150StoragePool) error {
+	return aer.c(aer.s.StorageServiceIdStoragePoolIdGet(ctx, id0, id1, model))
 }
-func (aer *AerService) StorageServiceIdStoragePoolIdPut(id0 string, id1 string, model *sf.StoragePoolV150StoragePool) error {
-	return aer.c(aer.s.StorageServiceIdStoragePoolIdPut(id0, id1, model))
+func (aer *AerService) StorageServiceIdStoragePoolIdPut(ctx context.Context, id0 string, id1 string, model *sf.StoragePoolV150StoragePool) error {
+	return aer.c(aer.s.StorageServiceIdStoragePoolIdPut(ctx, id0, id1, model))
 }
-func (aer *AerService) StorageServiceIdStoragePoolIdDelete(id0 string, id1 string) error {
-	return aer.c(aer.s.StorageServiceIdStoragePoolIdDelete(id0, id1))
+func (aer *AerService) StorageServiceIdStoragePoolIdDelete(ctx context.Context, id0 string, id1 string) error {
+	return aer.c(aer.s.StorageServiceIdStoragePoolIdDelete(ctx, id0, id1))
 }
-func (aer *AerService) StorageServiceIdStoragePoolIdPatch(id0 string, id1 string, model *sf.StoragePoolV150StoragePool) error {
-	return aer.c(aer.s.StorageServiceIdStoragePoolIdPatch(id0, id1, model))
+func (aer *AerService) StorageServiceIdStoragePoolIdPatch(ctx context.Context, id0 string, id1 string, model *sf.StoragePoolV150StoragePool) error {
+	return aer.c(aer.s.StorageServiceIdStoragePoolIdPatch(ctx, id0, id1, model))
 }
-func (aer *AerService) StorageServiceIdStoragePoolIdCapacitySourcesGet(id0 string, id1 string, model *sf.CapacitySourceCollectionCapacitySourceCollection) error {
-	return aer.c(aer.s.StorageServiceIdStoragePoolIdCapacitySourcesGet(id0, id1, model))
+func (aer *AerService) StorageServiceIdStoragePoolIdCapacitySourcesGet(ctx context.Context, id0 string, id1 string, model *sf.CapacitySourceCollectionCapacitySourceCollection) error {
+	return aer.c(aer.s.StorageServiceIdStoragePoolIdCapacitySourcesGet(ctx, id0, id1, model))
 }
-func (aer *AerService) StorageServiceIdStoragePoolIdCapacitySourceIdGet(id0 string, id1 string, id2 string, model *sf.CapacityCapacitySource) error {
-	return aer.c(aer.s.StorageServiceIdStoragePoolIdCapacitySourceIdGet(id0, id1, id2, model))
+func (aer *AerService) StorageServiceIdStoragePoolIdCapacitySourceIdGet(ctx context.Context, id0 string, id1 string, id2 string, model *sf.CapacityCapacitySource) error {
+	return aer.c(aer.s.StorageServiceIdStoragePoolIdCapacitySourceIdGet(ctx, id0, id1, id2, model))
 }
-func (aer *AerService) StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVolumesGet(id0 string, id1 string, id2 string, model *sf.VolumeCollectionVolumeCollection) error {
-	return aer.c(aer.s.StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVolumesGet(id0, id1, id2, model))
+func (aer *AerService) StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVolumesGet(ctx context.Context, id0 string, id1 string, id2 string, model *sf.VolumeCollectionVolumeCollection) error {
+	return aer.c(aer.s.StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVolumesGet(ctx, id0, id1, id2, model))
 }
-func (aer *AerService) StorageServiceIdStoragePoolIdAllocatedVolumesGet(id0 string, id1 string, model *sf.VolumeCollectionVolumeCollection) error {
-	return aer.c(aer.s.StorageServiceIdStoragePoolIdAllocatedVolumesGet(id0, id1, model))
+func (aer *AerService) StorageServiceIdStoragePoolIdAllocatedVolumesGet(ctx context.Context, id0 string, id1 string, model *sf.VolumeCollectionVolumeCollection) error {
+	return aer.c(aer.s.StorageServiceIdStoragePoolIdAllocatedVolumesGet(ctx, id0, id1, model))
 }
-func (aer *AerService) StorageServiceIdStoragePoolIdAllocatedVolumeIdGet(id0 string, id1 string, id2 string, model *sf.VolumeV161Volume) error {
-	return aer.c(aer.s.StorageServiceIdStoragePoolIdAllocatedVolumeIdGet(id0, id1, id2, model))
+func (aer *AerService) StorageServiceIdStoragePoolIdAllocatedVolumeIdGet(ctx context.Context, id0 string, id1 string, id2 string, model *sf.VolumeV161Volume) error {
+	return aer.c(aer.s.StorageServiceIdStoragePoolIdAllocatedVolumeIdGet(ctx, id0, id1, id2, model))
 }
 
-func (aer *AerService) StorageServiceIdStorageGroupsGet(id string, model *sf.StorageGroupCollectionStorageGroupCollection) error {
-	return aer.c(aer.s.StorageServiceIdStorageGroupsGet(id, model))
+func (aer *AerService) StorageServiceIdStorageGroupsGet(ctx context.Context, id string, model *sf.StorageGroupCollectionStorageGroupCollection) error {
+	return aer.c(aer.s.StorageServiceIdStorageGroupsGet(ctx, id, model))
 }
-func (aer *AerService) StorageServiceIdStorageGroupPost(id string, model *sf.StorageGroupV150StorageGroup) error {
-	return aer.c(aer.s.StorageServiceIdStorageGroupPost(id, model))
+func (aer *AerService) StorageServiceIdStorageGroupPost(ctx context.Context, id string, model *sf.StorageGroupV150StorageGroup) error {
+	return aer.c(aer.s.StorageServiceIdStorageGroupPost(ctx, id, model))
 }
-func (aer *AerService) StorageServiceIdStorageGroupIdPut(id0 string, id1 string, model *sf.StorageGroupV150StorageGroup) error {
-	return aer.c(aer.s.StorageServiceIdStorageGroupIdPut(id0, id1, model))
+func (aer *AerService) StorageServiceIdStorageGroupIdPut(ctx context.Context, id0 string, id1 string, model *sf.StorageGroupV150StorageGroup) error {
+	return aer.c(aer.s.StorageServiceIdStorageGroupIdPut(ctx, id0, id1, model))
 }
-func (aer *AerService) StorageServiceIdStorageGroupIdGet(id0 string, id1 string, model *sf.StorageGroupV150StorageGroup) error {
-	return aer.c(aer.s.StorageServiceIdStorageGroupIdGet(id0, id1, model))
+func (aer *AerService) StorageServiceIdStorageGroupIdGet(ctx context.Context, id0 string, id1 string, model *sf.StorageGroupV150StorageGroup) error {
+	return aer.c(aer.s.StorageServiceIdStorageGroupIdGet(ctx, id0, id1, model))
 }
-func (aer *AerService) StorageServiceIdStorageGroupIdDelete(id0 string, id1 string) error {
-	return aer.c(aer.s.StorageServiceIdStorageGroupIdDelete(id0, id1))
+func (aer *AerService) StorageServiceIdStorageGroupIdDelete(ctx context.Context, id0 string, id1 string) error {
+	return aer.c(aer.s.StorageServiceIdStorageGroupIdDelete(ctx, id0, id1))
 }
 
-func (aer *AerService) StorageServiceIdEndpointsGet(id string, model *sf.EndpointCollectionEndpointCollection) error {
-	return aer.c(aer.s.StorageServiceIdEndpointsGet(id, model))
+func (aer *AerService) StorageServiceIdEndpointsGet(ctx context.Context, id string, model *sf.EndpointCollectionEndpointCollection) error {
+	return aer.c(aer.s.StorageServiceIdEndpointsGet(ctx, id, model))
 }
-func (aer *AerService) StorageServiceIdEndpointIdGet(id0 string, id1 string, model *sf.EndpointV150Endpoint) error {
-	return aer.c(aer.s.StorageServiceIdEndpointIdGet(id0, id1, model))
+func (aer *AerService) StorageServiceIdEndpointIdGet(ctx context.Context, id0 string, id1 string, model *sf.EndpointV150Endpoint) error {
+	return aer.c(aer.s.StorageServiceIdEndpointIdGet(ctx, id0, id1, model))
 }
 
-func (aer *AerService) StorageServiceIdFileSystemsGet(id string, model *sf.FileSystemCollectionFileSystemCollection) error {
-	return aer.c(aer.s.StorageServiceIdFileSystemsGet(id, model))
+func (aer *AerService) StorageServiceIdFileSystemsGet(ctx context.Context, id string, model *sf.FileSystemCollectionFileSystemCollection) error {
+	return aer.c(aer.s.StorageServiceIdFileSystemsGet(ctx, id, model))
 }
-func (aer *AerService) StorageServiceIdFileSystemsPost(id string, model *sf.FileSystemV122FileSystem) error {
-	return aer.c(aer.s.StorageServiceIdFileSystemsPost(id, model))
+func (aer *AerService) StorageServiceIdFileSystemsPost(ctx context.Context, id string, model *sf.FileSystemV122FileSystem) error {
+	return aer.c(aer.s.StorageServiceIdFileSystemsPost(ctx, id, model))
 }
-func (aer *AerService) StorageServiceIdFileSystemIdPut(id0 string, id1 string, model *sf.FileSystemV122FileSystem) error {
-	return aer.c(aer.s.StorageServiceIdFileSystemIdPut(id0, id1, model))
+func (aer *AerService) StorageServiceIdFileSystemIdPut(ctx context.Context, id0 string, id1 string, model *sf.FileSystemV122FileSystem) error {
+	return aer.c(aer.s.StorageServiceIdFileSystemIdPut(ctx, id0, id1, model))
 }
-func (aer *AerService) StorageServiceIdFileSystemIdGet(id0 string, id1 string, model *sf.FileSystemV122FileSystem) error {
-	return aer.c(aer.s.StorageServiceIdFileSystemIdGet(id0, id1, model))
+func (aer *AerService) StorageServiceIdFileSystemIdGet(ctx context.Context, id0 string, id1 string, model *sf.FileSystemV122FileSystem) error {
+	return aer.c(aer.s.StorageServiceIdFileSystemIdGet(ctx, id0, id1, model))
 }
-func (aer *AerService) StorageServiceIdFileSystemIdDelete(id0 string, id1 string) error {
-	return aer.c(aer.s.StorageServiceIdFileSystemIdDelete(id0, id1))
+func (aer *AerService) StorageServiceIdFileSystemIdDelete(ctx context.Context, id0 string, id1 string) error {
+	return aer.c(aer.s.StorageServiceIdFileSystemIdDelete(ctx, id0, id1))
 }
 
-func (aer *AerService) StorageServiceIdFileSystemIdExportedSharesGet(id0 string, id1 string, model *sf.FileShareCollectionFileShareCollection) error {
-	return aer.c(aer.s.StorageServiceIdFileSystemIdExportedSharesGet(id0, id1, model))
+func (aer *AerService) StorageServiceIdFileSystemIdExportedSharesGet(ctx context.Context, id0 string, id1 string, model *sf.FileShareCollectionFileShareCollection) error {
+	return aer.c(aer.s.StorageServiceIdFileSystemIdExportedSharesGet(ctx, id0, id1, model))
 }
-func (aer *AerService) StorageServiceIdFileSystemIdExportedSharesPost(id0 string, id1 string, model *sf.FileShareV120FileShare) error {
-	return aer.c(aer.s.StorageServiceIdFileSystemIdExportedSharesPost(id0, id1, model))
+func (aer *AerService) StorageServiceIdFileSystemIdExportedSharesPost(ctx context.Context, id0 string, id1 string, model *sf.FileShareV120FileShare) error {
+	return aer.c(aer.s.StorageServiceIdFileSystemIdExportedSharesPost(ctx, id0, id1, model))
 }
-func (aer *AerService) StorageServiceIdFileSystemIdExportedShareIdPut(id0 string, id1 string, id2 string, model *sf.FileShareV120FileShare) error {
-	return aer.c(aer.s.StorageServiceIdFileSystemIdExportedShareIdPut(id0, id1, id2, model))
+func (aer *AerService) StorageServiceIdFileSystemIdExportedShareIdPut(ctx context.Context, id0 string, id1 string, id2 string, model *sf.FileShareV120FileShare) error {
+	return aer.c(aer.s.StorageServiceIdFileSystemIdExportedShareIdPut(ctx, id0, id1, id2, model))
 }
-func (aer *AerService) StorageServiceIdFileSystemIdExportedShareIdGet(id0 string, id1 string, id2 string, model *sf.FileShareV120FileShare) error {
-	return aer.c(aer.s.StorageServiceIdFileSystemIdExportedShareIdGet(id0, id1, id2, model))
+func (aer *AerService) StorageServiceIdFileSystemIdExportedShareIdGet(ctx context.Context, id0 string, id1 string, id2 string, model *sf.FileShareV120FileShare) error {
+	return aer.c(aer.s.StorageServiceIdFileSystemIdExportedShareIdGet(ctx, id0, id1, id2, model))
 }
-func (aer *AerService) StorageServiceIdFileSystemIdExportedShareIdDelete(id0 string, id1 string, id2 string) error {
-	return aer.c(aer.s.StorageServiceIdFileSystemIdExportedShareIdDelete(id0, id1, id2))
+func (aer *AerService) StorageServiceIdFileSystemIdExportedShareIdDelete(ctx context.Context, id0 string, id1 string, id2 string) error {
+	return aer.c(aer.s.StorageServiceIdFileSystemIdExportedShareIdDelete(ctx, id0, id1, id2))
 }
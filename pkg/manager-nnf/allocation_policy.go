@@ -20,9 +20,11 @@
 package nnf
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
 	nvme "github.com/NearNodeFlash/nnf-ec/pkg/manager-nvme"
 
 	openapi "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/common"
@@ -32,7 +34,7 @@ import (
 type AllocationPolicy interface {
 	Initialize(capacityBytes uint64) error
 	CheckAndAdjustCapacity() error
-	Allocate() ([]nvme.ProvidingVolume, error)
+	Allocate(ctx context.Context) ([]nvme.ProvidingVolume, error)
 }
 
 // AllocationPolicyType -
@@ -192,12 +194,14 @@ func (p *SpareAllocationPolicy) CheckAndAdjustCapacity() error {
 }
 
 // Allocate - allocate the storage
-func (p *SpareAllocationPolicy) Allocate() ([]nvme.ProvidingVolume, error) {
+func (p *SpareAllocationPolicy) Allocate(ctx context.Context) ([]nvme.ProvidingVolume, error) {
 
 	driveCount := uint64(len(p.storage))
 	perStorageCapacityBytes := p.capacityBytes / driveCount
 	remainingCapacityBytes := p.capacityBytes
 
+	task := ec.TaskFromContext(ctx)
+
 	volumes := []nvme.ProvidingVolume{}
 	for idx, storage := range p.storage {
 
@@ -210,19 +214,21 @@ func (p *SpareAllocationPolicy) Allocate() ([]nvme.ProvidingVolume, error) {
 			capacityBytes = remainingCapacityBytes
 		}
 
-		volume, err := createVolume(storage, capacityBytes)
+		volume, err := createVolume(ctx, storage, capacityBytes)
 
 		if err != nil {
-			return volumes, fmt.Errorf("Create Volume Failure: %s", err)
+			return volumes, fmt.Errorf("Create Volume Failure: %w", err)
 		}
 
 		remainingCapacityBytes = remainingCapacityBytes - volume.GetCapacityBytes()
 		volumes = append(volumes, nvme.ProvidingVolume{Storage: storage, VolumeId: volume.Id()})
+
+		task.SetPercentComplete(int64(100 * (idx + 1) / len(p.storage)))
 	}
 
 	return volumes, nil
 }
 
-func createVolume(storage *nvme.Storage, capacityBytes uint64) (*nvme.Volume, error) {
-	return nvme.CreateVolume(storage, capacityBytes)
+func createVolume(ctx context.Context, storage *nvme.Storage, capacityBytes uint64) (*nvme.Volume, error) {
+	return nvme.CreateVolume(ctx, storage, capacityBytes)
 }
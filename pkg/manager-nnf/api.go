@@ -20,6 +20,7 @@
 package nnf
 
 import (
+	"context"
 	"net/http"
 
 	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
@@ -41,6 +42,16 @@ type Api interface {
 
 	RedfishV1StorageServicesStorageServiceIdCapacitySourceGet(w http.ResponseWriter, r *http.Request)
 
+	RedfishV1StorageServicesStorageServiceIdOemAuditGet(w http.ResponseWriter, r *http.Request)
+	RedfishV1StorageServicesStorageServiceIdOemAuditPost(w http.ResponseWriter, r *http.Request)
+
+	RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesGet(w http.ResponseWriter, r *http.Request)
+	RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesQuarantinedVolumeIdGet(w http.ResponseWriter, r *http.Request)
+	RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesQuarantinedVolumeIdDelete(w http.ResponseWriter, r *http.Request)
+
+	RedfishV1StorageServicesStorageServiceIdOemInterruptedOperationsGet(w http.ResponseWriter, r *http.Request)
+	RedfishV1StorageServicesStorageServiceIdOemInterruptedOperationsInterruptedOperationIdGet(w http.ResponseWriter, r *http.Request)
+
 	RedfishV1StorageServicesStorageServiceIdStoragePoolsGet(w http.ResponseWriter, r *http.Request)
 	RedfishV1StorageServicesStorageServiceIdStoragePoolsPost(w http.ResponseWriter, r *http.Request)
 	RedfishV1StorageServicesStorageServiceIdStoragePoolsPatch(w http.ResponseWriter, r *http.Request)
@@ -84,42 +95,52 @@ type StorageServiceApi interface {
 
 	Id() string
 
-	StorageServicesGet(*sf.StorageServiceCollectionStorageServiceCollection) error
-	StorageServiceIdGet(string, *sf.StorageServiceV150StorageService) error
-
-	StorageServiceIdCapacitySourceGet(string, *sf.CapacityCapacitySource) error
-
-	StorageServiceIdStoragePoolsGet(string, *sf.StoragePoolCollectionStoragePoolCollection) error
-	StorageServiceIdStoragePoolsPost(string, *sf.StoragePoolV150StoragePool) error
-	StorageServiceIdStoragePoolsPatch(string, *sf.StoragePoolCollectionStoragePoolCollection) error
-	StorageServiceIdStoragePoolIdGet(string, string, *sf.StoragePoolV150StoragePool) error
-	StorageServiceIdStoragePoolIdPut(string, string, *sf.StoragePoolV150StoragePool) error
-	StorageServiceIdStoragePoolIdDelete(string, string) error
-	StorageServiceIdStoragePoolIdPatch(string, string, *sf.StoragePoolV150StoragePool) error
-	StorageServiceIdStoragePoolIdCapacitySourcesGet(string, string, *sf.CapacitySourceCollectionCapacitySourceCollection) error
-	StorageServiceIdStoragePoolIdCapacitySourceIdGet(string, string, string, *sf.CapacityCapacitySource) error
-	StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVolumesGet(string, string, string, *sf.VolumeCollectionVolumeCollection) error
-	StorageServiceIdStoragePoolIdAllocatedVolumesGet(string, string, *sf.VolumeCollectionVolumeCollection) error
-	StorageServiceIdStoragePoolIdAllocatedVolumeIdGet(string, string, string, *sf.VolumeV161Volume) error
-
-	StorageServiceIdStorageGroupsGet(string, *sf.StorageGroupCollectionStorageGroupCollection) error
-	StorageServiceIdStorageGroupPost(string, *sf.StorageGroupV150StorageGroup) error
-	StorageServiceIdStorageGroupIdPut(string, string, *sf.StorageGroupV150StorageGroup) error
-	StorageServiceIdStorageGroupIdGet(string, string, *sf.StorageGroupV150StorageGroup) error
-	StorageServiceIdStorageGroupIdDelete(string, string) error
-
-	StorageServiceIdEndpointsGet(string, *sf.EndpointCollectionEndpointCollection) error
-	StorageServiceIdEndpointIdGet(string, string, *sf.EndpointV150Endpoint) error
-
-	StorageServiceIdFileSystemsGet(string, *sf.FileSystemCollectionFileSystemCollection) error
-	StorageServiceIdFileSystemsPost(string, *sf.FileSystemV122FileSystem) error
-	StorageServiceIdFileSystemIdPut(string, string, *sf.FileSystemV122FileSystem) error
-	StorageServiceIdFileSystemIdGet(string, string, *sf.FileSystemV122FileSystem) error
-	StorageServiceIdFileSystemIdDelete(string, string) error
-
-	StorageServiceIdFileSystemIdExportedSharesGet(string, string, *sf.FileShareCollectionFileShareCollection) error
-	StorageServiceIdFileSystemIdExportedSharesPost(string, string, *sf.FileShareV120FileShare) error
-	StorageServiceIdFileSystemIdExportedShareIdPut(string, string, string, *sf.FileShareV120FileShare) error
-	StorageServiceIdFileSystemIdExportedShareIdGet(string, string, string, *sf.FileShareV120FileShare) error
-	StorageServiceIdFileSystemIdExportedShareIdDelete(string, string, string) error
+	StorageServicesGet(context.Context, *sf.StorageServiceCollectionStorageServiceCollection) error
+	StorageServiceIdGet(context.Context, string, *sf.StorageServiceV150StorageService) error
+
+	StorageServiceIdCapacitySourceGet(context.Context, string, *sf.CapacityCapacitySource) error
+
+	StorageServiceIdAuditGet(context.Context, string, *AuditReport) error
+	StorageServiceIdAuditPost(context.Context, string, *AuditReport) error
+
+	StorageServiceIdQuarantinedVolumesGet(context.Context, string, *QuarantinedVolumeCollection) error
+	StorageServiceIdQuarantinedVolumeIdGet(context.Context, string, string, *QuarantinedVolume) error
+	StorageServiceIdQuarantinedVolumeIdDelete(context.Context, string, string) error
+
+	StorageServiceIdInterruptedOperationsGet(context.Context, string, *InterruptedOperationCollection) error
+	StorageServiceIdInterruptedOperationIdGet(context.Context, string, string, *InterruptedOperation) error
+
+	StorageServiceIdStoragePoolsGet(context.Context, string, *sf.StoragePoolCollectionStoragePoolCollection) error
+	StorageServiceIdStoragePoolsPost(context.Context, string, *sf.StoragePoolV150StoragePool) error
+	StorageServiceIdStoragePoolsPatch(context.Context, string, *sf.StoragePoolCollectionStoragePoolCollection) error
+	StorageServiceIdStoragePoolIdGet(context.Context, string, string, *sf.StoragePoolV150StoragePool) error
+	StorageServiceIdStoragePoolIdPut(context.Context, string, string, *sf.StoragePoolV150StoragePool) error
+	StorageServiceIdStoragePoolIdDelete(context.Context, string, string) error
+	StorageServiceIdStoragePoolIdPatch(context.Context, string, string, *sf.StoragePoolV150StoragePool) error
+	StorageServiceIdStoragePoolIdCapacitySourcesGet(context.Context, string, string, *sf.CapacitySourceCollectionCapacitySourceCollection) error
+	StorageServiceIdStoragePoolIdCapacitySourceIdGet(context.Context, string, string, string, *sf.CapacityCapacitySource) error
+	StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVolumesGet(context.Context, string, string, string, *sf.VolumeCollectionVolumeCollection) error
+	StorageServiceIdStoragePoolIdAllocatedVolumesGet(context.Context, string, string, *sf.VolumeCollectionVolumeCollection) error
+	StorageServiceIdStoragePoolIdAllocatedVolumeIdGet(context.Context, string, string, string, *sf.VolumeV161Volume) error
+
+	StorageServiceIdStorageGroupsGet(context.Context, string, *sf.StorageGroupCollectionStorageGroupCollection) error
+	StorageServiceIdStorageGroupPost(context.Context, string, *sf.StorageGroupV150StorageGroup) error
+	StorageServiceIdStorageGroupIdPut(context.Context, string, string, *sf.StorageGroupV150StorageGroup) error
+	StorageServiceIdStorageGroupIdGet(context.Context, string, string, *sf.StorageGroupV150StorageGroup) error
+	StorageServiceIdStorageGroupIdDelete(context.Context, string, string) error
+
+	StorageServiceIdEndpointsGet(context.Context, string, *sf.EndpointCollectionEndpointCollection) error
+	StorageServiceIdEndpointIdGet(context.Context, string, string, *sf.EndpointV150Endpoint) error
+
+	StorageServiceIdFileSystemsGet(context.Context, string, *sf.FileSystemCollectionFileSystemCollection) error
+	StorageServiceIdFileSystemsPost(context.Context, string, *sf.FileSystemV122FileSystem) error
+	StorageServiceIdFileSystemIdPut(context.Context, string, string, *sf.FileSystemV122FileSystem) error
+	StorageServiceIdFileSystemIdGet(context.Context, string, string, *sf.FileSystemV122FileSystem) error
+	StorageServiceIdFileSystemIdDelete(context.Context, string, string) error
+
+	StorageServiceIdFileSystemIdExportedSharesGet(context.Context, string, string, *sf.FileShareCollectionFileShareCollection) error
+	StorageServiceIdFileSystemIdExportedSharesPost(context.Context, string, string, *sf.FileShareV120FileShare) error
+	StorageServiceIdFileSystemIdExportedShareIdPut(context.Context, string, string, string, *sf.FileShareV120FileShare) error
+	StorageServiceIdFileSystemIdExportedShareIdGet(context.Context, string, string, string, *sf.FileShareV120FileShare) error
+	StorageServiceIdFileSystemIdExportedShareIdDelete(context.Context, string, string, string) error
 }
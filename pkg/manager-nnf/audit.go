@@ -0,0 +1,237 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nnf
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	event "github.com/NearNodeFlash/nnf-ec/pkg/manager-event"
+	msgreg "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry/registries"
+	nvme "github.com/NearNodeFlash/nnf-ec/pkg/manager-nvme"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// AuditReport is the drift report built by the consistency audit. The audit compares the objects recovered
+// from the persistent store against the hardware and records any disagreements. The audit itself never
+// modifies the storage service or the hardware.
+type AuditReport struct {
+	OdataId   string `json:"@odata.id"`
+	OdataType string `json:"@odata.type"`
+	Id        string `json:"Id"`
+	Name      string `json:"Name"`
+
+	// The time the audit was last run.
+	Timestamp string `json:"Timestamp,omitempty"`
+
+	// True if the audit found no discrepancies, false otherwise.
+	Consistent bool `json:"Consistent"`
+
+	// Volumes recorded by a storage pool that no longer exist on the storage devices.
+	MissingVolumes []AuditMissingVolume `json:"MissingVolumes"`
+
+	// Volumes present on the storage devices that are not owned by any storage pool.
+	UnknownVolumes []AuditUnknownVolume `json:"UnknownVolumes"`
+
+	// Storage groups attached to a controller that is no longer present on the fabric.
+	StaleStorageGroups []AuditStaleStorageGroup `json:"StaleStorageGroups"`
+}
+
+// AuditMissingVolume describes a storage pool volume that could not be found
+type AuditMissingVolume struct {
+	StoragePool  sf.OdataV4IdRef `json:"StoragePool"`
+	SerialNumber string          `json:"SerialNumber"`
+	NamespaceId  string          `json:"NamespaceId"`
+}
+
+// AuditUnknownVolume describes a volume that is not owned by any storage pool
+type AuditUnknownVolume struct {
+	Volume        sf.OdataV4IdRef `json:"Volume"`
+	SerialNumber  string          `json:"SerialNumber"`
+	NamespaceId   string          `json:"NamespaceId"`
+	CapacityBytes uint64          `json:"CapacityBytes"`
+}
+
+// AuditStaleStorageGroup describes a storage group whose endpoint controller no longer exists
+type AuditStaleStorageGroup struct {
+	StorageGroup   sf.OdataV4IdRef `json:"StorageGroup"`
+	ServerEndpoint sf.OdataV4IdRef `json:"ServerEndpoint"`
+	ControllerId   uint16          `json:"ControllerId"`
+}
+
+const (
+	AuditReportId        = "Audit"
+	AuditReportOdataType = "#NnfAuditReport.v1_0_0.NnfAuditReport"
+)
+
+func (s *StorageService) auditOdataId() string {
+	return s.OdataId() + "/Oem/" + AuditReportId
+}
+
+// audit builds a new drift report from the current state of the storage service and the hardware. An event
+// is published for every discrepancy found. This should be called after the persistent store is replayed
+// and before any corrective action (like deleting unknown volumes) is taken. Quarantined volumes whose
+// namespace no longer exists are removed from quarantine.
+func (s *StorageService) audit() *AuditReport {
+	log := s.log.WithName("audit")
+
+	s.pruneQuarantinedVolumes()
+
+	// The report is built with the service locked; the events are published once the lock is released
+	var events []func()
+	defer func() {
+		for _, publish := range events {
+			publish()
+		}
+	}()
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	report := &AuditReport{
+		OdataId:            s.auditOdataId(),
+		OdataType:          AuditReportOdataType,
+		Id:                 AuditReportId,
+		Name:               "Storage Service Consistency Audit",
+		Timestamp:          time.Now().Format(time.RFC3339),
+		MissingVolumes:     make([]AuditMissingVolume, 0),
+		UnknownVolumes:     make([]AuditUnknownVolume, 0),
+		StaleStorageGroups: make([]AuditStaleStorageGroup, 0),
+	}
+
+	// Storage pools whose namespaces no longer exist. Recovery of the storage pool records these as
+	// missing volumes.
+	var providingVolumes []nvme.ProvidingVolume
+	for _, sp := range s.pools {
+		providingVolumes = append(providingVolumes, sp.providingVolumes...)
+
+		for _, mv := range sp.missingVolumes {
+			nsid := strconv.FormatUint(uint64(mv.NamespaceID), 10)
+
+			log.Info("Storage pool volume missing", storagePoolIdKey, sp.id, "serialNumber", mv.SerialNumber, "namespaceId", nsid)
+			report.MissingVolumes = append(report.MissingVolumes, AuditMissingVolume{
+				StoragePool:  sf.OdataV4IdRef{OdataId: sp.OdataId()},
+				SerialNumber: mv.SerialNumber,
+				NamespaceId:  nsid,
+			})
+
+			e := msgreg.StoragePoolVolumeMissingNnf(sp.id, mv.SerialNumber, nsid)
+			events = append(events, func() { event.EventManager.PublishResourceEvent(e, sp) })
+		}
+	}
+
+	// Namespaces that are not owned by any storage pool
+	for _, uv := range nvme.FindUnknownVolumes(providingVolumes) {
+		volume := uv.Storage.FindVolume(uv.VolumeId)
+		if volume == nil {
+			continue
+		}
+
+		nsid := strconv.FormatUint(uint64(volume.GetNamespaceId()), 10)
+
+		log.Info("Unknown volume found", "serialNumber", uv.Storage.SerialNumber(), "namespaceId", nsid)
+		report.UnknownVolumes = append(report.UnknownVolumes, AuditUnknownVolume{
+			Volume:        sf.OdataV4IdRef{OdataId: volume.GetOdataId()},
+			SerialNumber:  uv.Storage.SerialNumber(),
+			NamespaceId:   nsid,
+			CapacityBytes: volume.GetCapacityBytes(),
+		})
+
+		e := msgreg.UnknownVolumeFoundNnf(uv.Storage.SerialNumber(), nsid)
+		events = append(events, func() { event.EventManager.Publish(e) })
+	}
+
+	// Storage groups attached to a controller that no longer exists. An endpoint that never established
+	// a link, or whose link dropped, no longer has a controller present on the fabric.
+	for _, sg := range s.groups {
+		if sg.endpoint.state != sf.UNAVAILABLE_OFFLINE_RST {
+			continue
+		}
+
+		controllerId := strconv.Itoa(int(sg.endpoint.controllerId))
+
+		log.Info("Storage group controller missing", storageGroupIdKey, sg.id, endpointIdKey, sg.endpoint.id, "controllerId", controllerId)
+		report.StaleStorageGroups = append(report.StaleStorageGroups, AuditStaleStorageGroup{
+			StorageGroup:   sf.OdataV4IdRef{OdataId: sg.OdataId()},
+			ServerEndpoint: sf.OdataV4IdRef{OdataId: sg.endpoint.OdataId()},
+			ControllerId:   sg.endpoint.controllerId,
+		})
+
+		e := msgreg.StorageGroupControllerMissingNnf(sg.id, sg.endpoint.id, controllerId)
+		events = append(events, func() { event.EventManager.PublishResourceEvent(e, sg) })
+	}
+
+	report.Consistent = len(report.MissingVolumes) == 0 &&
+		len(report.UnknownVolumes) == 0 &&
+		len(report.StaleStorageGroups) == 0
+
+	log.Info("Audit complete", "consistent", report.Consistent,
+		"missingVolumes", len(report.MissingVolumes),
+		"unknownVolumes", len(report.UnknownVolumes),
+		"staleStorageGroups", len(report.StaleStorageGroups))
+
+	return report
+}
+
+// StorageServiceIdAuditGet returns the drift report from the most recent consistency audit
+func (*StorageService) StorageServiceIdAuditGet(ctx context.Context, storageServiceId string, model *AuditReport) error {
+	s := findStorageService(storageServiceId)
+	if s == nil {
+		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
+	}
+
+	if s.auditReport == nil {
+		return ec.NewErrorNotReady().WithResourceType(AuditReportOdataType).WithCause(fmt.Sprintf("Storage service '%s' audit has not run", s.id))
+	}
+
+	*model = *s.auditReport
+
+	return nil
+}
+
+// StorageServiceIdAuditPost runs a new consistency audit and returns the resulting drift report. The audit
+// does not change any storage resources.
+func (*StorageService) StorageServiceIdAuditPost(ctx context.Context, storageServiceId string, model *AuditReport) error {
+	s := findStorageService(storageServiceId)
+	if s == nil {
+		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
+	}
+
+	s.mutex.RLock()
+	state := s.state
+	s.mutex.RUnlock()
+
+	if state != sf.ENABLED_RST {
+		return ec.NewErrorNotReady().WithResourceType(AuditReportOdataType).WithCause(fmt.Sprintf("Storage service '%s' is not enabled", s.id))
+	}
+
+	report := s.audit()
+
+	s.mutex.Lock()
+	s.auditReport = report
+	s.mutex.Unlock()
+
+	*model = *report
+
+	return nil
+}
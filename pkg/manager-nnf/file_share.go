@@ -20,6 +20,7 @@
 package nnf
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -47,9 +48,9 @@ func (sh *FileShare) OdataIdRef(ref string) sf.OdataV4IdRef {
 	return sf.OdataV4IdRef{OdataId: fmt.Sprintf("%s%s", sh.OdataId(), ref)}
 }
 
-func (sh *FileShare) getStatus() *sf.ResourceStatus {
+func (sh *FileShare) getStatus(ctx context.Context) *sf.ResourceStatus {
 	sg := sh.storageService.findStorageGroup(sh.storageGroupId)
-	status, _ := sg.serverStorage.GetStatus()
+	status, _ := sg.serverStorage.GetStatus(ctx)
 	return &sf.ResourceStatus{
 		Health: sf.OK_RH,
 		State:  status.State(),
@@ -125,7 +126,7 @@ func (sh *FileShare) GenerateStateData(state uint32) ([]byte, error) {
 	return nil, nil
 }
 
-func (sh *FileShare) Rollback(state uint32) error {
+func (sh *FileShare) Rollback(ctx context.Context, state uint32) error {
 	switch state {
 	case fileShareCreateStartLogEntryType:
 		fs := sh.storageService.findFileSystem(sh.fileSystemId)
@@ -208,6 +209,7 @@ func (rh *fileShareRecoveryReplayHandler) Entry(t uint32, data []byte) error {
 }
 
 func (rh *fileShareRecoveryReplayHandler) Done() (bool, error) {
+	ctx := context.Background()
 	switch rh.lastLogEntryType {
 	case fileShareCreateStartLogEntryType:
 		// In this case there may be some residual file system operations on the node that need to be rolled back
@@ -220,7 +222,7 @@ func (rh *fileShareRecoveryReplayHandler) Done() (bool, error) {
 
 		mountRoot := rh.fileShare.mountRoot
 		if len(mountRoot) != 0 {
-			if err := sg.serverStorage.MountFileSystem(rh.fileSystem.fsApi, mountRoot); err != nil {
+			if err := sg.serverStorage.MountFileSystem(ctx, rh.fileSystem.fsApi, mountRoot); err != nil {
 				return false, err
 			}
 		}
@@ -238,3 +240,18 @@ func (rh *fileShareRecoveryReplayHandler) Done() (bool, error) {
 
 	return false, nil
 }
+
+func (rh *fileShareRecoveryReplayHandler) InterruptedOperation(op *persistent.InterruptedOperation) bool {
+	object := rh.storageService.OdataId() + "/FileSystems/" + rh.fileSystemId + "/ExportedFileShares/" + rh.fileShareId
+
+	switch rh.lastLogEntryType {
+	case fileShareCreateStartLogEntryType:
+		return reportInterruptedOperation(op, object, createInterruptedOperation, retainedInterruptedAction)
+	case fileShareUpdateStartLogEntryType:
+		return reportInterruptedOperation(op, object, updateInterruptedOperation, retainedInterruptedAction)
+	case fileShareDeleteStartLogEntryType:
+		return reportInterruptedOperation(op, object, deleteInterruptedOperation, retainedInterruptedAction)
+	}
+
+	return false
+}
@@ -20,6 +20,7 @@
 package nnf
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -89,6 +90,8 @@ func (fs *FileSystem) createFileShare(id string, sg *StorageGroup, mountRoot str
 		storageService: fs.storageService,
 	})
 
+	fs.storageService.shares.Add(1)
+
 	return &fs.shares[len(fs.shares)-1]
 }
 
@@ -100,6 +103,7 @@ func (fs *FileSystem) deleteFileShare(sh *FileShare) {
 	for shareIdx, share := range fs.shares {
 		if share.id == sh.id {
 			fs.shares = append(fs.shares[:shareIdx], fs.shares[shareIdx+1:]...)
+			fs.storageService.shares.Add(-1)
 			break
 		}
 	}
@@ -133,7 +137,7 @@ func (fs *FileSystem) GenerateStateData(state uint32) ([]byte, error) {
 	return nil, nil
 }
 
-func (fs *FileSystem) Rollback(state uint32) error {
+func (fs *FileSystem) Rollback(ctx context.Context, state uint32) error {
 	switch state {
 	case fileSystemCreateStartLogEntryType:
 		fs.storageService.deleteFileSystem(fs)
@@ -209,3 +213,16 @@ func (rh *fileSystemRecoveryReplyHandler) Done() (bool, error) {
 
 	return false, nil
 }
+
+func (rh *fileSystemRecoveryReplyHandler) InterruptedOperation(op *persistent.InterruptedOperation) bool {
+	object := rh.storageService.OdataId() + "/FileSystems/" + rh.fileSystemId
+
+	switch rh.lastLogEntryType {
+	case fileSystemCreateStartLogEntryType:
+		return reportInterruptedOperation(op, object, createInterruptedOperation, retainedInterruptedAction)
+	case fileSystemDeleteStartLogEntryType:
+		return reportInterruptedOperation(op, object, deleteInterruptedOperation, retainedInterruptedAction)
+	}
+
+	return false
+}
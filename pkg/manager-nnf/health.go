@@ -0,0 +1,91 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package nnf
+
+import (
+	"fmt"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// Health - The storage service is ready once it moves from Starting to Enabled, which occurs after the
+// fabric is ready and the persistent store is replayed, provided the persistent store remains open. The
+// readiness probe reads the service summary so it is never held up behind storage operations.
+
+// serviceSummary is a snapshot of the storage service's state and the size of its collections
+type serviceSummary struct {
+	state       sf.ResourceState
+	health      sf.ResourceHealth
+	pools       int
+	groups      int
+	fileSystems int
+}
+
+// summarize publishes a new summary of the storage service. The caller must hold the service lock
+// whenever the state or collections summarized have changed.
+func (s *StorageService) summarize() {
+	s.summary.Store(&serviceSummary{
+		state:       s.state,
+		health:      s.health,
+		pools:       len(s.pools),
+		groups:      len(s.groups),
+		fileSystems: len(s.fileSystems),
+	})
+}
+
+func (s *StorageService) currentSummary() serviceSummary {
+	if summary := s.summary.Load(); summary != nil {
+		return *summary
+	}
+
+	return serviceSummary{state: sf.DISABLED_RST, health: sf.CRITICAL_RH}
+}
+
+func (s *StorageService) ready() ec.HealthStatus {
+	summary := s.currentSummary()
+	storeOpen := s.store.IsOpen()
+
+	status := ec.HealthStatus{
+		Healthy: true,
+		Details: map[string]interface{}{
+			"state":       summary.state,
+			"health":      summary.health,
+			"storeOpen":   storeOpen,
+			"pools":       summary.pools,
+			"fileSystems": summary.fileSystems,
+		},
+	}
+
+	if summary.state != sf.ENABLED_RST {
+		status.Healthy = false
+		status.Reason = fmt.Sprintf("storage service state is %s", summary.state)
+	} else if !storeOpen {
+		status.Healthy = false
+		status.Reason = "persistent store is not open"
+	}
+
+	return status
+}
+
+// live reports the storage service is live; wedged device commands are detected by the fabric and
+// NVMe managers.
+func (s *StorageService) live() ec.HealthStatus {
+	return ec.HealthStatus{Healthy: true}
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nnf
+
+import (
+	"context"
+	"strconv"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	event "github.com/NearNodeFlash/nnf-ec/pkg/manager-event"
+	msgreg "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry/registries"
+	"github.com/NearNodeFlash/nnf-ec/pkg/persistent"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// InterruptedOperation describes an operation that was started but never completed, as found when the
+// persistent store was replayed. This is typically the result of a crash part way through a request.
+type InterruptedOperation struct {
+	OdataId   string `json:"@odata.id"`
+	OdataType string `json:"@odata.type"`
+	Id        string `json:"Id"`
+	Name      string `json:"Name"`
+
+	// The object the operation was acting on
+	Object sf.OdataV4IdRef `json:"Object"`
+
+	// The operation that was interrupted
+	Operation string `json:"Operation"`
+
+	// The last entry recorded for the object before the interruption
+	LastEntry string `json:"LastEntry"`
+
+	// The action taken when the interruption was found
+	Action string `json:"Action"`
+}
+
+// InterruptedOperationCollection is the collection of all interrupted operations found during replay
+type InterruptedOperationCollection struct {
+	OdataId           string            `json:"@odata.id"`
+	OdataType         string            `json:"@odata.type"`
+	Name              string            `json:"Name"`
+	MembersOdataCount int64             `json:"Members@odata.count"`
+	Members           []sf.OdataV4IdRef `json:"Members"`
+}
+
+const (
+	InterruptedOperationsId                 = "InterruptedOperations"
+	InterruptedOperationOdataType           = "#NnfInterruptedOperation.v1_0_0.NnfInterruptedOperation"
+	InterruptedOperationCollectionOdataType = "#NnfInterruptedOperationCollection.NnfInterruptedOperationCollection"
+)
+
+// Interrupted operations
+const (
+	createInterruptedOperation = "Create"
+	updateInterruptedOperation = "Update"
+	deleteInterruptedOperation = "Delete"
+)
+
+// Actions taken for an interrupted operation
+const (
+	// The partially applied operation was undone and the object removed
+	rolledBackInterruptedAction = "RolledBack"
+
+	// The partially applied operation could not be undone; the object remains and should be deleted by the client
+	rollbackFailedInterruptedAction = "RollbackFailed"
+
+	// The object was kept as last recorded; the operation can be retried by the client
+	retainedInterruptedAction = "Retained"
+
+	// The object was recovered from its last complete state; the operation can be retried by the client
+	recoveredInterruptedAction = "Recovered"
+)
+
+func (s *StorageService) interruptedOperationsOdataId() string {
+	return s.OdataId() + "/Oem/" + InterruptedOperationsId
+}
+
+// reportInterruptedOperation fills in the interrupted operation on behalf of a replay handler. The last entry
+// of an interrupted operation is always the start entry of that operation.
+func reportInterruptedOperation(op *persistent.InterruptedOperation, object, operation, action string) bool {
+	op.Object = object
+	op.Operation = operation
+	op.LastEntry = operation + "Start"
+	op.Action = action
+
+	return true
+}
+
+// recordInterruptedOperations records the operations found interrupted by the most recent replay of the
+// persistent store and publishes an event for each one.
+func (s *StorageService) recordInterruptedOperations() {
+	s.interruptedOperations = s.store.InterruptedOperations()
+
+	for _, op := range s.interruptedOperations {
+		s.log.Info("Interrupted operation found", "key", op.Key, "object", op.Object, "operation", op.Operation, "lastEntry", op.LastEntry, "action", op.Action)
+
+		event.EventManager.Publish(msgreg.InterruptedOperationFoundNnf(op.Object, op.Operation, op.Action))
+	}
+}
+
+// StorageServiceIdInterruptedOperationsGet -
+func (*StorageService) StorageServiceIdInterruptedOperationsGet(ctx context.Context, storageServiceId string, model *InterruptedOperationCollection) error {
+	s := findStorageService(storageServiceId)
+	if s == nil {
+		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
+	}
+
+	model.MembersOdataCount = int64(len(s.interruptedOperations))
+	model.Members = make([]sf.OdataV4IdRef, model.MembersOdataCount)
+	for idx := range s.interruptedOperations {
+		model.Members[idx] = sf.OdataV4IdRef{OdataId: s.interruptedOperationsOdataId() + "/" + strconv.Itoa(idx)}
+	}
+
+	return nil
+}
+
+// StorageServiceIdInterruptedOperationIdGet -
+func (*StorageService) StorageServiceIdInterruptedOperationIdGet(ctx context.Context, storageServiceId, interruptedOperationId string, model *InterruptedOperation) error {
+	s := findStorageService(storageServiceId)
+	if s == nil {
+		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
+	}
+
+	idx, err := strconv.Atoi(interruptedOperationId)
+	if err != nil || idx < 0 || idx >= len(s.interruptedOperations) {
+		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(InterruptedOperationOdataType, interruptedOperationId))
+	}
+
+	op := s.interruptedOperations[idx]
+
+	model.Id = interruptedOperationId
+	model.OdataId = s.interruptedOperationsOdataId() + "/" + interruptedOperationId
+	model.Object = sf.OdataV4IdRef{OdataId: op.Object}
+	model.Operation = op.Operation
+	model.LastEntry = op.LastEntry
+	model.Action = op.Action
+
+	return nil
+}
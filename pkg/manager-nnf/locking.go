@@ -0,0 +1,345 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package nnf
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// Locked Service wraps the provided Storage Service API with the locks required to handle parallel
+// requests. The storage service's read-write lock guards only the service's collections of pools,
+// groups, endpoints and file systems, and is never held across hardware operations. Methods that
+// only read those collections take it shared; the storage service takes it exclusively, and briefly,
+// when it adds or removes a resource.
+//
+// Each storage pool has its own lock that serializes operations on the pool and on the storage groups,
+// file system and file shares built upon it. The pool lock is held for the duration of the operation,
+// including any hardware commands, so operations on different pools proceed in parallel. A pool lock
+// is always taken before the service lock, never while holding it.
+//
+// The storage pool, storage group and file system GETs do not take the pool lock, so they do not wait on
+// an operation in progress. The storage service read-locks itself while it reads these resources, as the
+// same GETs are called by operations that already hold the pool lock.
+type LockedService struct {
+	s    StorageServiceApi
+	ss   *StorageService
+	lock *sync.RWMutex
+}
+
+func NewLockedService(s *StorageService) StorageServiceApi {
+	return &LockedService{s: s, ss: s, lock: &s.mutex}
+}
+
+func (l *LockedService) read() func() {
+	l.lock.RLock()
+	return l.lock.RUnlock
+}
+
+func (l *LockedService) write() func() {
+	l.lock.Lock()
+	return l.lock.Unlock
+}
+
+// Delay a client waits before retrying a request refused while the storage service is starting
+const serviceStartingRetryDelay = 5 * time.Second
+
+// enabled returns an error asking the client to retry if the storage service is still starting. Requests
+// that modify the storage service are refused until it is enabled, as its resources are still being recovered.
+func (l *LockedService) enabled() error {
+	defer l.read()()
+	if l.ss.state == sf.STARTING_RST {
+		return ec.NewErrServiceUnavailable().WithRetryDelay(serviceStartingRetryDelay).WithResourceType(StorageServiceOdataType).WithCause("Storage service is starting")
+	}
+
+	return nil
+}
+
+// pool locks the storage pool returned by find and returns the function to unlock it. The pool is
+// found again once locked, in case it was deleted or replaced while waiting on the lock; if there is
+// no such pool nothing is locked and the wrapped method reports the missing resource.
+func (l *LockedService) pool(find func() *StoragePool) func() {
+	for {
+		p := find()
+		if p == nil {
+			return func() {}
+		}
+
+		p.mutex.Lock()
+		if find() == p {
+			return p.mutex.Unlock
+		}
+		p.mutex.Unlock()
+	}
+}
+
+func (l *LockedService) storagePool(storagePoolId string) func() {
+	return l.pool(func() *StoragePool { return l.ss.findStoragePool(storagePoolId) })
+}
+
+func (l *LockedService) storageGroupPool(storageGroupId string) func() {
+	return l.pool(func() *StoragePool {
+		if sg := l.ss.findStorageGroup(storageGroupId); sg != nil {
+			return l.ss.findStoragePool(sg.storagePoolId)
+		}
+		return nil
+	})
+}
+
+func (l *LockedService) fileSystemPool(fileSystemId string) func() {
+	return l.pool(func() *StoragePool {
+		if fs := l.ss.findFileSystem(fileSystemId); fs != nil {
+			return l.ss.findStoragePool(fs.storagePoolId)
+		}
+		return nil
+	})
+}
+
+// Initialize runs before the storage service handles any requests and is not locked. This
+// permits the storage service to receive events published during initialization.
+func (l *LockedService) Initialize(log ec.Logger, ctrl NnfControllerInterface) error {
+	return l.s.Initialize(log, ctrl)
+}
+
+func (l *LockedService) Close() error {
+	defer l.write()()
+	return l.s.Close()
+}
+
+func (l *LockedService) Id() string {
+	return l.s.Id()
+}
+
+func (l *LockedService) StorageServicesGet(ctx context.Context, m *sf.StorageServiceCollectionStorageServiceCollection) error {
+	defer l.read()()
+	return l.s.StorageServicesGet(ctx, m)
+}
+func (l *LockedService) StorageServiceIdGet(ctx context.Context, id string, model *sf.StorageServiceV150StorageService) error {
+	defer l.read()()
+	return l.s.StorageServiceIdGet(ctx, id, model)
+}
+func (l *LockedService) StorageServiceIdCapacitySourceGet(ctx context.Context, id string, model *sf.CapacityCapacitySource) error {
+	defer l.read()()
+	return l.s.StorageServiceIdCapacitySourceGet(ctx, id, model)
+}
+
+func (l *LockedService) StorageServiceIdAuditGet(ctx context.Context, id string, model *AuditReport) error {
+	defer l.read()()
+	return l.s.StorageServiceIdAuditGet(ctx, id, model)
+}
+func (l *LockedService) StorageServiceIdAuditPost(ctx context.Context, id string, model *AuditReport) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	return l.s.StorageServiceIdAuditPost(ctx, id, model)
+}
+
+func (l *LockedService) StorageServiceIdQuarantinedVolumesGet(ctx context.Context, id string, model *QuarantinedVolumeCollection) error {
+	defer l.read()()
+	return l.s.StorageServiceIdQuarantinedVolumesGet(ctx, id, model)
+}
+func (l *LockedService) StorageServiceIdQuarantinedVolumeIdGet(ctx context.Context, id, qvid string, model *QuarantinedVolume) error {
+	defer l.read()()
+	return l.s.StorageServiceIdQuarantinedVolumeIdGet(ctx, id, qvid, model)
+}
+func (l *LockedService) StorageServiceIdQuarantinedVolumeIdDelete(ctx context.Context, id, qvid string) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	return l.s.StorageServiceIdQuarantinedVolumeIdDelete(ctx, id, qvid)
+}
+
+func (l *LockedService) StorageServiceIdInterruptedOperationsGet(ctx context.Context, id string, model *InterruptedOperationCollection) error {
+	defer l.read()()
+	return l.s.StorageServiceIdInterruptedOperationsGet(ctx, id, model)
+}
+func (l *LockedService) StorageServiceIdInterruptedOperationIdGet(ctx context.Context, id, opid string, model *InterruptedOperation) error {
+	defer l.read()()
+	return l.s.StorageServiceIdInterruptedOperationIdGet(ctx, id, opid, model)
+}
+
+func (l *LockedService) StorageServiceIdStoragePoolsGet(ctx context.Context, id string, model *sf.StoragePoolCollectionStoragePoolCollection) error {
+	defer l.read()()
+	return l.s.StorageServiceIdStoragePoolsGet(ctx, id, model)
+}
+func (l *LockedService) StorageServiceIdStoragePoolsPost(ctx context.Context, id string, model *sf.StoragePoolV150StoragePool) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	return l.s.StorageServiceIdStoragePoolsPost(ctx, id, model)
+}
+func (l *LockedService) StorageServiceIdStoragePoolsPatch(ctx context.Context, id string, model *sf.StoragePoolCollectionStoragePoolCollection) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	return l.s.StorageServiceIdStoragePoolsPatch(ctx, id, model)
+}
+func (l *LockedService) StorageServiceIdStoragePoolIdGet(ctx context.Context, id0 string, id1 string, model *sf.StoragePoolV150StoragePool) error {
+	return l.s.StorageServiceIdStoragePoolIdGet(ctx, id0, id1, model)
+}
+func (l *LockedService) StorageServiceIdStoragePoolIdPut(ctx context.Context, id0 string, id1 string, model *sf.StoragePoolV150StoragePool) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	defer l.storagePool(id1)()
+	return l.s.StorageServiceIdStoragePoolIdPut(ctx, id0, id1, model)
+}
+func (l *LockedService) StorageServiceIdStoragePoolIdDelete(ctx context.Context, id0 string, id1 string) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	defer l.storagePool(id1)()
+	return l.s.StorageServiceIdStoragePoolIdDelete(ctx, id0, id1)
+}
+func (l *LockedService) StorageServiceIdStoragePoolIdPatch(ctx context.Context, id0 string, id1 string, model *sf.StoragePoolV150StoragePool) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	defer l.storagePool(id1)()
+	return l.s.StorageServiceIdStoragePoolIdPatch(ctx, id0, id1, model)
+}
+func (l *LockedService) StorageServiceIdStoragePoolIdCapacitySourcesGet(ctx context.Context, id0 string, id1 string, model *sf.CapacitySourceCollectionCapacitySourceCollection) error {
+	return l.s.StorageServiceIdStoragePoolIdCapacitySourcesGet(ctx, id0, id1, model)
+}
+func (l *LockedService) StorageServiceIdStoragePoolIdCapacitySourceIdGet(ctx context.Context, id0 string, id1 string, id2 string, model *sf.CapacityCapacitySource) error {
+	return l.s.StorageServiceIdStoragePoolIdCapacitySourceIdGet(ctx, id0, id1, id2, model)
+}
+func (l *LockedService) StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVolumesGet(ctx context.Context, id0 string, id1 string, id2 string, model *sf.VolumeCollectionVolumeCollection) error {
+	return l.s.StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVolumesGet(ctx, id0, id1, id2, model)
+}
+func (l *LockedService) StorageServiceIdStoragePoolIdAllocatedVolumesGet(ctx context.Context, id0 string, id1 string, model *sf.VolumeCollectionVolumeCollection) error {
+	return l.s.StorageServiceIdStoragePoolIdAllocatedVolumesGet(ctx, id0, id1, model)
+}
+func (l *LockedService) StorageServiceIdStoragePoolIdAllocatedVolumeIdGet(ctx context.Context, id0 string, id1 string, id2 string, model *sf.VolumeV161Volume) error {
+	return l.s.StorageServiceIdStoragePoolIdAllocatedVolumeIdGet(ctx, id0, id1, id2, model)
+}
+
+func (l *LockedService) StorageServiceIdStorageGroupsGet(ctx context.Context, id string, model *sf.StorageGroupCollectionStorageGroupCollection) error {
+	defer l.read()()
+	return l.s.StorageServiceIdStorageGroupsGet(ctx, id, model)
+}
+func (l *LockedService) StorageServiceIdStorageGroupPost(ctx context.Context, id string, model *sf.StorageGroupV150StorageGroup) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	defer l.storagePool(path.Base(model.Links.StoragePool.OdataId))()
+	return l.s.StorageServiceIdStorageGroupPost(ctx, id, model)
+}
+func (l *LockedService) StorageServiceIdStorageGroupIdPut(ctx context.Context, id0 string, id1 string, model *sf.StorageGroupV150StorageGroup) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	// An existing group is locked through its pool; otherwise the put creates the group in the linked pool
+	defer l.pool(func() *StoragePool {
+		if sg := l.ss.findStorageGroup(id1); sg != nil {
+			return l.ss.findStoragePool(sg.storagePoolId)
+		}
+		return l.ss.findStoragePool(path.Base(model.Links.StoragePool.OdataId))
+	})()
+	return l.s.StorageServiceIdStorageGroupIdPut(ctx, id0, id1, model)
+}
+func (l *LockedService) StorageServiceIdStorageGroupIdGet(ctx context.Context, id0 string, id1 string, model *sf.StorageGroupV150StorageGroup) error {
+	return l.s.StorageServiceIdStorageGroupIdGet(ctx, id0, id1, model)
+}
+func (l *LockedService) StorageServiceIdStorageGroupIdDelete(ctx context.Context, id0 string, id1 string) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	defer l.storageGroupPool(id1)()
+	return l.s.StorageServiceIdStorageGroupIdDelete(ctx, id0, id1)
+}
+
+func (l *LockedService) StorageServiceIdEndpointsGet(ctx context.Context, id string, model *sf.EndpointCollectionEndpointCollection) error {
+	defer l.read()()
+	return l.s.StorageServiceIdEndpointsGet(ctx, id, model)
+}
+func (l *LockedService) StorageServiceIdEndpointIdGet(ctx context.Context, id0 string, id1 string, model *sf.EndpointV150Endpoint) error {
+	defer l.read()()
+	return l.s.StorageServiceIdEndpointIdGet(ctx, id0, id1, model)
+}
+
+func (l *LockedService) StorageServiceIdFileSystemsGet(ctx context.Context, id string, model *sf.FileSystemCollectionFileSystemCollection) error {
+	defer l.read()()
+	return l.s.StorageServiceIdFileSystemsGet(ctx, id, model)
+}
+func (l *LockedService) StorageServiceIdFileSystemsPost(ctx context.Context, id string, model *sf.FileSystemV122FileSystem) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	defer l.storagePool(path.Base(model.Links.StoragePool.OdataId))()
+	return l.s.StorageServiceIdFileSystemsPost(ctx, id, model)
+}
+func (l *LockedService) StorageServiceIdFileSystemIdPut(ctx context.Context, id0 string, id1 string, model *sf.FileSystemV122FileSystem) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	// An existing file system is locked through its pool; otherwise the put creates the file system in the linked pool
+	defer l.pool(func() *StoragePool {
+		if fs := l.ss.findFileSystem(id1); fs != nil {
+			return l.ss.findStoragePool(fs.storagePoolId)
+		}
+		return l.ss.findStoragePool(path.Base(model.Links.StoragePool.OdataId))
+	})()
+	return l.s.StorageServiceIdFileSystemIdPut(ctx, id0, id1, model)
+}
+func (l *LockedService) StorageServiceIdFileSystemIdGet(ctx context.Context, id0 string, id1 string, model *sf.FileSystemV122FileSystem) error {
+	return l.s.StorageServiceIdFileSystemIdGet(ctx, id0, id1, model)
+}
+func (l *LockedService) StorageServiceIdFileSystemIdDelete(ctx context.Context, id0 string, id1 string) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	defer l.fileSystemPool(id1)()
+	return l.s.StorageServiceIdFileSystemIdDelete(ctx, id0, id1)
+}
+
+func (l *LockedService) StorageServiceIdFileSystemIdExportedSharesGet(ctx context.Context, id0 string, id1 string, model *sf.FileShareCollectionFileShareCollection) error {
+	defer l.fileSystemPool(id1)()
+	return l.s.StorageServiceIdFileSystemIdExportedSharesGet(ctx, id0, id1, model)
+}
+func (l *LockedService) StorageServiceIdFileSystemIdExportedSharesPost(ctx context.Context, id0 string, id1 string, model *sf.FileShareV120FileShare) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	defer l.fileSystemPool(id1)()
+	return l.s.StorageServiceIdFileSystemIdExportedSharesPost(ctx, id0, id1, model)
+}
+func (l *LockedService) StorageServiceIdFileSystemIdExportedShareIdPut(ctx context.Context, id0 string, id1 string, id2 string, model *sf.FileShareV120FileShare) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	defer l.fileSystemPool(id1)()
+	return l.s.StorageServiceIdFileSystemIdExportedShareIdPut(ctx, id0, id1, id2, model)
+}
+func (l *LockedService) StorageServiceIdFileSystemIdExportedShareIdGet(ctx context.Context, id0 string, id1 string, id2 string, model *sf.FileShareV120FileShare) error {
+	defer l.fileSystemPool(id1)()
+	return l.s.StorageServiceIdFileSystemIdExportedShareIdGet(ctx, id0, id1, id2, model)
+}
+func (l *LockedService) StorageServiceIdFileSystemIdExportedShareIdDelete(ctx context.Context, id0 string, id1 string, id2 string) error {
+	if err := l.enabled(); err != nil {
+		return err
+	}
+	defer l.fileSystemPool(id1)()
+	return l.s.StorageServiceIdFileSystemIdExportedShareIdDelete(ctx, id0, id1, id2)
+}
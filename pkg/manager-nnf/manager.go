@@ -20,9 +20,12 @@
 package nnf
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/google/uuid"
 
@@ -55,26 +58,51 @@ var storageService = StorageService{
 	health: sf.CRITICAL_RH,
 }
 
-func NewDefaultStorageService(unknownVolumes bool, replaceMissingVolumes bool) StorageServiceApi {
+// StorageServiceOption configures an optional behavior of the default storage service
+type StorageServiceOption func(*StorageService)
+
+// WithQuarantineUnknownVolumes detaches and retains volumes not represented by a storage pool at the end of
+// initialization, in place of deleting them.
+func WithQuarantineUnknownVolumes(quarantine bool) StorageServiceOption {
+	return func(s *StorageService) { s.quarantineUnknownVolumes = quarantine }
+}
+
+func NewDefaultStorageService(unknownVolumes bool, replaceMissingVolumes bool, opts ...StorageServiceOption) StorageServiceApi {
 	storageService.deleteUnknownVolumes = unknownVolumes
 	storageService.replaceMissingVolumes = replaceMissingVolumes
-	return NewAerService(&storageService) // Wrap the default storage service with Advanced Error Reporting capabilities
+	storageService.quarantineUnknownVolumes = false
+	for _, opt := range opts {
+		opt(&storageService)
+	}
+
+	return NewAerService(NewLockedService(&storageService)) // Wrap the default storage service with locking and Advanced Error Reporting capabilities
 }
 
 type StorageService struct {
+	// The mutex protects the storage service's collections of storage pools, storage groups and file
+	// systems, its endpoints, quarantined volumes, interrupted operations and audit report, and the
+	// volumes owned by each storage pool. It is only held while these are read or changed; work on
+	// the hardware is done under the lock of the storage pool involved (see LockedService).
+	mutex sync.RWMutex
+
 	id     string
 	state  sf.ResourceState
 	health sf.ResourceHealth
 
+	// Snapshot of the state and resource counts, and the number of file shares, read without the
+	// service lock by the health and metrics endpoints (see summarize)
+	summary atomic.Pointer[serviceSummary]
+	shares  atomic.Int64
+
 	config                   *ConfigFile
 	store                    *persistent.Store
 	serverControllerProvider server.ServerControllerProvider
 	persistentController     PersistentControllerApi
 
-	pools       []StoragePool
-	groups      []StorageGroup
+	pools       []*StoragePool
+	groups      []*StorageGroup
 	endpoints   []Endpoint
-	fileSystems []FileSystem
+	fileSystems []*FileSystem
 
 	// Index of the Id field of any Storage Service resource (Pools, Groups, Endpoints, FileSystems)
 	// That is, given a Storage Service resource OdataId field, ResourceIndex will correspond to the
@@ -85,6 +113,18 @@ type StorageService struct {
 	deleteUnknownVolumes bool
 	// This flag controls whether we replace volumes that are missing from storage pools.
 	replaceMissingVolumes bool
+	// This flag controls whether we quarantine volumes that don't appear in storage pools we know about.
+	// Quarantine takes precedence over deleting unknown volumes.
+	quarantineUnknownVolumes bool
+
+	// Volumes not owned by any storage pool that were detached and retained for operator action
+	quarantinedVolumes []quarantinedVolume
+
+	// Operations found interrupted when the persistent store was replayed
+	interruptedOperations []persistent.InterruptedOperation
+
+	// Drift report from the most recent consistency audit; nil until the audit first runs.
+	auditReport *AuditReport
 
 	log ec.Logger
 }
@@ -102,9 +142,17 @@ func (s *StorageService) GetStore() *persistent.Store {
 }
 
 func (s *StorageService) findStoragePool(storagePoolId string) *StoragePool {
-	for poolIdx, pool := range s.pools {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.findStoragePoolLocked(storagePoolId)
+}
+
+// findStoragePoolLocked is findStoragePool for a caller that holds the service lock
+func (s *StorageService) findStoragePoolLocked(storagePoolId string) *StoragePool {
+	for _, pool := range s.pools {
 		if pool.id == storagePoolId {
-			return &s.pools[poolIdx]
+			return pool
 		}
 	}
 
@@ -112,9 +160,17 @@ func (s *StorageService) findStoragePool(storagePoolId string) *StoragePool {
 }
 
 func (s *StorageService) findStorageGroup(storageGroupId string) *StorageGroup {
-	for groupIdx, group := range s.groups {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.findStorageGroupLocked(storageGroupId)
+}
+
+// findStorageGroupLocked is findStorageGroup for a caller that holds the service lock
+func (s *StorageService) findStorageGroupLocked(storageGroupId string) *StorageGroup {
+	for _, group := range s.groups {
 		if group.id == storageGroupId {
-			return &s.groups[groupIdx]
+			return group
 		}
 	}
 
@@ -132,19 +188,47 @@ func (s *StorageService) findEndpoint(endpointId string) *Endpoint {
 }
 
 func (s *StorageService) findFileSystem(fileSystemId string) *FileSystem {
-	for fileSystemIdx, fileSystem := range s.fileSystems {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.findFileSystemLocked(fileSystemId)
+}
+
+// findFileSystemLocked is findFileSystem for a caller that holds the service lock
+func (s *StorageService) findFileSystemLocked(fileSystemId string) *FileSystem {
+	for _, fileSystem := range s.fileSystems {
 		if fileSystem.id == fileSystemId {
-			return &s.fileSystems[fileSystemIdx]
+			return fileSystem
 		}
 	}
 
 	return nil
 }
 
+// storagePools returns a copy of the storage service's list of storage pools
+func (s *StorageService) storagePools() []*StoragePool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return append([]*StoragePool(nil), s.pools...)
+}
+
+// storageGroups returns a copy of the storage service's list of storage groups
+func (s *StorageService) storageGroups() []*StorageGroup {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return append([]*StorageGroup(nil), s.groups...)
+}
+
 // Create a storage pool object with the provided variables and add it to the storage service's list of storage
 // pools. If an ID is not provided, an unused one will be used. If an ID is provided, the caller must check
-// that the ID does not already exist.
+// that the ID does not already exist. The storage pool is returned locked so no other request can use it
+// before it is ready; the caller must unlock it.
 func (s *StorageService) createStoragePool(id, name, description string, uid uuid.UUID, policy AllocationPolicy) *StoragePool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	defer s.summarize()
 
 	// If no ID is supplied, find a free Storage Pool Id
 	if len(id) == 0 {
@@ -161,33 +245,40 @@ func (s *StorageService) createStoragePool(id, name, description string, uid uui
 		id = strconv.Itoa(poolId)
 	}
 
-	if uid.Variant() == uuid.Invalid {
+	if uid == uuid.Nil {
 		uid = s.allocateStoragePoolUid()
 	}
 
-	s.pools = append(s.pools, StoragePool{
+	p := &StoragePool{
 		id:             id,
 		name:           name,
 		description:    description,
 		uid:            uid,
 		policy:         policy,
 		storageService: s,
-	})
+	}
 
-	return &s.pools[len(s.pools)-1]
+	p.mutex.Lock()
+
+	s.pools = append(s.pools, p)
+
+	return p
 }
 
 func (s *StorageService) deleteStoragePool(sp *StoragePool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	defer s.summarize()
 
 	for storagePoolIdx, storagePool := range s.pools {
-		if storagePool.id == sp.id {
+		if storagePool == sp {
 			s.pools = append(s.pools[:storagePoolIdx], s.pools[storagePoolIdx+1:]...)
 			break
 		}
 	}
 }
 
-func (s *StorageService) patchStoragePool(sp *StoragePool, forceRescan bool) error {
+func (s *StorageService) patchStoragePool(ctx context.Context, sp *StoragePool, forceRescan bool) error {
 	log := s.log
 
 	// In a test environment where you may be deleting volumes underneath nnf-ec and
@@ -198,13 +289,13 @@ func (s *StorageService) patchStoragePool(sp *StoragePool, forceRescan bool) err
 	}
 
 	// Look for missing volumes
-	err := sp.checkVolumes()
+	err := sp.checkVolumes(ctx)
 	if err != nil {
 		log.Error(err, "Unable to rescan volumes")
 		return err
 	}
 
-	err = sp.replaceMissingVolumes()
+	err = sp.replaceMissingVolumes(ctx)
 	if err != nil {
 		log.Error(err, "Unable to replace missing volumes")
 		return err
@@ -216,7 +307,7 @@ func (s *StorageService) patchStoragePool(sp *StoragePool, forceRescan bool) err
 		return nil
 	}
 
-	if err := s.persistentController.UpdatePersistentObject(sp, updateFunc, storagePoolStorageUpdateStartLogEntryType, storagePoolStorageUpdateCompleteLogEntryType); err != nil {
+	if err := s.persistentController.UpdatePersistentObject(ctx, sp, updateFunc, storagePoolStorageUpdateStartLogEntryType, storagePoolStorageUpdateCompleteLogEntryType); err != nil {
 		return ec.NewErrInternalServerError().WithResourceType(StoragePoolOdataType).WithError(err).WithCause("Failed to update storage pool")
 	}
 
@@ -238,6 +329,25 @@ func (s *StorageService) findStorage(sn string) *nvme.Storage {
 // that the ID does not already exist.
 func (s *StorageService) createStorageGroup(id string, sp *StoragePool, endpoint *Endpoint) *StorageGroup {
 
+	expectedNamespaces := make([]server.StorageNamespace, len(sp.providingVolumes))
+	for idx, pv := range sp.providingVolumes {
+		volume := pv.Storage.FindVolume(pv.VolumeId)
+		if volume == nil {
+			err := fmt.Errorf("Volume not found")
+			s.log.Error(err, "Storage pool createStorageGroup volume not found", "volumeid", pv.VolumeId)
+			continue
+		}
+
+		expectedNamespaces[idx] = server.StorageNamespace{
+			SerialNumber: pv.Storage.SerialNumber(),
+			Id:           volume.GetNamespaceId(),
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	defer s.summarize()
+
 	if len(id) == 0 {
 		// Find a free Storage Group Id
 		var groupId = -1
@@ -254,30 +364,15 @@ func (s *StorageService) createStorageGroup(id string, sp *StoragePool, endpoint
 		id = strconv.Itoa(groupId)
 	}
 
-	expectedNamespaces := make([]server.StorageNamespace, len(sp.providingVolumes))
-	for idx, pv := range sp.providingVolumes {
-		volume := pv.Storage.FindVolume(pv.VolumeId)
-		if volume == nil {
-			err := fmt.Errorf("Volume not found")
-			s.log.Error(err, "Storage pool createStorageGroup volume not found", "volumeid", pv.VolumeId)
-			continue
-		}
-
-		expectedNamespaces[idx] = server.StorageNamespace{
-			SerialNumber: pv.Storage.SerialNumber(),
-			Id:           volume.GetNamespaceId(),
-		}
-	}
-
-	s.groups = append(s.groups, StorageGroup{
+	sg := &StorageGroup{
 		id:             id,
 		endpoint:       endpoint,
 		serverStorage:  endpoint.serverCtrl.NewStorage(sp.uid, expectedNamespaces),
 		storagePoolId:  sp.id,
 		storageService: s,
-	})
+	}
 
-	sg := &s.groups[len(s.groups)-1]
+	s.groups = append(s.groups, sg)
 
 	sp.storageGroupIds = append(sp.storageGroupIds, id)
 
@@ -285,7 +380,11 @@ func (s *StorageService) createStorageGroup(id string, sp *StoragePool, endpoint
 }
 
 func (s *StorageService) deleteStorageGroup(sg *StorageGroup) {
-	sp := s.findStoragePool(sg.storagePoolId)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	defer s.summarize()
+
+	sp := s.findStoragePoolLocked(sg.storagePoolId)
 
 	for storageGroupIdx, storageGroupId := range sp.storageGroupIds {
 		if storageGroupId == sg.id {
@@ -295,13 +394,15 @@ func (s *StorageService) deleteStorageGroup(sg *StorageGroup) {
 	}
 
 	for storageGroupIdx, storageGroup := range s.groups {
-		if storageGroup.id == sg.id {
+		if storageGroup == sg {
 			s.groups = append(s.groups[:storageGroupIdx], s.groups[storageGroupIdx+1:]...)
 			break
 		}
 	}
 }
 
+// allocateStoragePoolUid returns a storage pool UUID not used by any other storage pool. The caller must
+// hold the service lock.
 func (s *StorageService) allocateStoragePoolUid() uuid.UUID {
 	for {
 	Retry:
@@ -321,6 +422,9 @@ func (s *StorageService) allocateStoragePoolUid() uuid.UUID {
 // systems. If an ID is not provided, an unused one will be used. If an ID is provided, the caller must check
 // that the ID does not already exist.
 func (s *StorageService) createFileSystem(id string, sp *StoragePool, fsApi server.FileSystemApi, fsOem server.FileSystemOem) *FileSystem {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	defer s.summarize()
 
 	if len(id) == 0 {
 		// Find a free File System Id
@@ -339,24 +443,31 @@ func (s *StorageService) createFileSystem(id string, sp *StoragePool, fsApi serv
 
 	sp.fileSystemId = id
 
-	s.fileSystems = append(s.fileSystems, FileSystem{
+	fs := &FileSystem{
 		id:             id,
 		fsApi:          fsApi,
 		fsOem:          fsOem,
 		storagePoolId:  sp.id,
 		storageService: s,
-	})
+	}
+
+	s.fileSystems = append(s.fileSystems, fs)
 
-	return &s.fileSystems[len(s.fileSystems)-1]
+	return fs
 }
 
 func (s *StorageService) deleteFileSystem(fs *FileSystem) {
-	sp := s.findStoragePool(fs.storagePoolId)
+	s.shares.Add(-int64(len(fs.shares)))
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	defer s.summarize()
 
+	sp := s.findStoragePoolLocked(fs.storagePoolId)
 	sp.fileSystemId = ""
 
 	for fileSystemIdx, fileSystem := range s.fileSystems {
-		if fileSystem.id == fs.id {
+		if fileSystem == fs {
 			s.fileSystems = append(s.fileSystems[:fileSystemIdx], s.fileSystems[fileSystemIdx+1:]...)
 			break
 		}
@@ -388,6 +499,20 @@ func findStoragePool(storageServiceId, storagePoolId string) (*StorageService, *
 	return s, s.findStoragePool(storagePoolId)
 }
 
+// lockedStoragePool finds the storage pool with the storage service read-locked, so a request can read the
+// storage pool without waiting on an operation that holds the storage pool's lock. The caller must unlock the
+// storage service if one is returned.
+func lockedStoragePool(storageServiceId, storagePoolId string) (*StorageService, *StoragePool) {
+	s := findStorageService(storageServiceId)
+	if s == nil {
+		return nil, nil
+	}
+
+	s.mutex.RLock()
+
+	return s, s.findStoragePoolLocked(storagePoolId)
+}
+
 func findStorageGroup(storageServiceId, storageGroupId string) (*StorageService, *StorageGroup) {
 	s := findStorageService(storageServiceId)
 	if s == nil {
@@ -428,9 +553,10 @@ func (s *StorageService) Id() string {
 	return s.id
 }
 
-func (s *StorageService) cleanupVolumes() {
+func (s *StorageService) cleanupVolumes(ctx context.Context) {
 	// Build a list of all providing volumes from all storage pools
 	var providingVolumes []nvme.ProvidingVolume
+	s.mutex.RLock()
 	for _, pool := range s.pools {
 		for _, volume := range pool.providingVolumes {
 			providingVolumes = append(providingVolumes, nvme.ProvidingVolume{
@@ -439,8 +565,9 @@ func (s *StorageService) cleanupVolumes() {
 			})
 		}
 	}
+	s.mutex.RUnlock()
 
-	nvme.CleanupVolumes(providingVolumes)
+	nvme.CleanupVolumes(ctx, providingVolumes)
 }
 
 // Initialize is responsible for initializing the NNF Storage Service; the
@@ -460,9 +587,11 @@ func (s *StorageService) Initialize(log ec.Logger, ctrl NnfControllerInterface)
 
 	// Reserve space for the most common allocation types. 32 is the current
 	// limit for the number of supported namespaces.
-	storageService.pools = make([]StoragePool, 0, 32)
-	storageService.groups = make([]StorageGroup, 0, 32)
-	storageService.fileSystems = make([]FileSystem, 0, 32)
+	storageService.pools = make([]*StoragePool, 0, 32)
+	storageService.groups = make([]*StorageGroup, 0, 32)
+	storageService.fileSystems = make([]*FileSystem, 0, 32)
+	storageService.shares.Store(0)
+	storageService.summarize()
 
 	const name = "nnf"
 	log.V(2).Info("Creating logger", "name", name)
@@ -533,6 +662,7 @@ func (s *StorageService) Close() error {
 }
 
 func (s *StorageService) EventHandler(e event.Event) error {
+	ctx := context.Background()
 	log := s.log.WithValues("eventId", e.Id, "eventMessage", e.Message, "eventArgs", e.MessageArgs)
 
 	// Upstream link events
@@ -542,6 +672,9 @@ func (s *StorageService) EventHandler(e event.Event) error {
 	if linkEstablished || linkDropped {
 		log.V(2).Info("Link event")
 
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
 		var switchId, portId string
 		if err := e.Args(&switchId, &portId); err != nil {
 			return ec.NewErrInternalServerError().WithError(err).WithCause("event parameters illformed")
@@ -583,7 +716,9 @@ func (s *StorageService) EventHandler(e event.Event) error {
 	}
 
 	// Fabric is ready
-	// All devices are enumerated and discovery is complete.
+	// All devices are enumerated and discovery is complete. The fabric is ready before the element
+	// controller serves any requests, so recovery takes the service lock only to change the storage
+	// service's collections, as any request would.
 	if e.Is(msgreg.FabricReadyNnf("")) {
 		log.V(1).Info("Fabric ready")
 
@@ -592,24 +727,44 @@ func (s *StorageService) EventHandler(e event.Event) error {
 			return err
 		}
 
-		// Remove any namespaces that are not part of a Storage Pool
-		if s.deleteUnknownVolumes {
+		s.recordInterruptedOperations()
+
+		// Audit the recovered objects against the hardware before any corrective action is taken
+		report := s.audit()
+
+		s.mutex.Lock()
+		s.auditReport = report
+		s.mutex.Unlock()
+
+		// Quarantine or remove any namespaces that are not part of a Storage Pool
+		if s.quarantineUnknownVolumes {
+			if s.deleteUnknownVolumes {
+				log.Info("Quarantine of unknown volumes overrides delete of unknown volumes")
+			}
+
+			log.V(2).Info("Quarantine unknown volumes")
+			s.quarantineVolumes(ctx)
+		} else if s.deleteUnknownVolumes {
 			log.V(2).Info("Cleanup unknown volumes")
-			s.cleanupVolumes()
+			s.cleanupVolumes(ctx)
 		}
 
 		if s.replaceMissingVolumes {
 			log.V(2).Info("Replace missing volumes")
-			for spIdx := range s.pools {
-				pool := &s.pools[spIdx]
-				if err := pool.storageService.patchStoragePool(pool, false /* rescan */); err != nil {
+			for _, pool := range s.storagePools() {
+				pool.mutex.Lock()
+				if err := pool.storageService.patchStoragePool(ctx, pool, false /* rescan */); err != nil {
 					log.Error(err, "Failed to replace missing volumes", "poolId", pool.id)
 				}
+				pool.mutex.Unlock()
 			}
 		}
 
+		s.mutex.Lock()
 		s.state = sf.ENABLED_RST
 		s.health = sf.OK_RH
+		s.summarize()
+		s.mutex.Unlock()
 
 		var fabricID string
 		if err := e.Args(&fabricID); err != nil {
@@ -621,16 +776,21 @@ func (s *StorageService) EventHandler(e event.Event) error {
 			return ec.NewErrInternalServerError().WithError(err).WithCause("fabric not found")
 		}
 
+		s.mutex.Lock()
 		if s.health == sf.OK_RH {
 			s.health = f.Status.Health
 		}
+		health := s.health
+		s.summarize()
+		s.mutex.Unlock()
 
-		log.Info("Storage Service Enabled", "health", s.health)
+		log.Info("Storage Service Enabled", "health", health)
 
 		nvme.StartNVMeMonitor(s.log)
 	}
 
-	// Check for storage pool events
+	// Check for storage pool events. The storage pool patched event is published by the storage
+	// service itself while the storage pool is locked, so its storage groups can be recovered here.
 	if e.Is(msgreg.StoragePoolPatchedNnf("", "", "", "", "")) {
 		// After a storage pool is patched, check for new volumes that need to be attached
 		log.V(1).Info("Storage Pool Patched")
@@ -646,9 +806,9 @@ func (s *StorageService) EventHandler(e event.Event) error {
 		log = log.WithValues("poolId", storagePoolID, "oldStorageSN", oldStorageSN, "oldNamespaceId", oldNamespaceID, "newStorageSN", newStorageSN, "newNamespaceId", newNamespaceID)
 
 		// We may have multiple storage groups associated with the same storage pool
-		for _, sg := range s.groups {
+		for _, sg := range s.storageGroups() {
 			if sg.storagePoolId == storagePoolID {
-				if err := sg.recoverPool(); err != nil {
+				if err := sg.recoverPool(ctx); err != nil {
 					return ec.NewErrInternalServerError().WithError(err).WithCause("unable to update storage group")
 				}
 			}
@@ -679,7 +839,7 @@ func (s *StorageService) EventHandler(e event.Event) error {
 //       Delete: There is no model parameter.
 //
 
-func (*StorageService) StorageServicesGet(model *sf.StorageServiceCollectionStorageServiceCollection) error {
+func (*StorageService) StorageServicesGet(ctx context.Context, model *sf.StorageServiceCollectionStorageServiceCollection) error {
 
 	model.MembersodataCount = 1
 	model.Members = make([]sf.OdataV4IdRef, model.MembersodataCount)
@@ -690,7 +850,7 @@ func (*StorageService) StorageServicesGet(model *sf.StorageServiceCollectionStor
 	return nil
 }
 
-func (*StorageService) StorageServiceIdGet(storageServiceId string, model *sf.StorageServiceV150StorageService) error {
+func (*StorageService) StorageServiceIdGet(ctx context.Context, storageServiceId string, model *sf.StorageServiceV150StorageService) error {
 	s := findStorageService(storageServiceId)
 	if s == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
@@ -707,10 +867,17 @@ func (*StorageService) StorageServiceIdGet(storageServiceId string, model *sf.St
 	model.FileSystems = s.OdataIdRef("/FileSystems")
 
 	model.Links.CapacitySource = s.OdataIdRef("/CapacitySource")
+
+	model.Oem = map[string]interface{}{
+		AuditReportId:           sf.OdataV4IdRef{OdataId: s.auditOdataId()},
+		QuarantinedVolumesId:    sf.OdataV4IdRef{OdataId: s.quarantinedVolumesOdataId()},
+		InterruptedOperationsId: sf.OdataV4IdRef{OdataId: s.interruptedOperationsOdataId()},
+	}
+
 	return nil
 }
 
-func (*StorageService) StorageServiceIdCapacitySourceGet(storageServiceId string, model *sf.CapacityCapacitySource) error {
+func (*StorageService) StorageServiceIdCapacitySourceGet(ctx context.Context, storageServiceId string, model *sf.CapacityCapacitySource) error {
 	s := findStorageService(storageServiceId)
 	if s == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
@@ -738,7 +905,7 @@ func (*StorageService) StorageServiceIdCapacitySourceGet(storageServiceId string
 	return nil
 }
 
-func (*StorageService) StorageServiceIdStoragePoolsGet(storageServiceId string, model *sf.StoragePoolCollectionStoragePoolCollection) error {
+func (*StorageService) StorageServiceIdStoragePoolsGet(ctx context.Context, storageServiceId string, model *sf.StoragePoolCollectionStoragePoolCollection) error {
 	s := findStorageService(storageServiceId)
 	if s == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
@@ -754,7 +921,7 @@ func (*StorageService) StorageServiceIdStoragePoolsGet(storageServiceId string,
 }
 
 // StorageServiceIdStoragePoolsPost - create a storage pool
-func (*StorageService) StorageServiceIdStoragePoolsPost(storageServiceId string, model *sf.StoragePoolV150StoragePool) (err error) {
+func (*StorageService) StorageServiceIdStoragePoolsPost(ctx context.Context, storageServiceId string, model *sf.StoragePoolV150StoragePool) (err error) {
 	s := findStorageService(storageServiceId)
 	if s == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
@@ -768,9 +935,21 @@ func (*StorageService) StorageServiceIdStoragePoolsPost(storageServiceId string,
 		}
 	}()
 
+	// Storage pools can be built from existing volumes rather than allocating new ones
+	if value, ok := model.Oem["Adopt"]; ok {
+		adopt := StoragePoolAdoptOem{}
+		if err := openapi.UnmarshalOem(model.Oem, &adopt); err != nil {
+			return ec.NewErrBadRequest().WithResourceType(StoragePoolOdataType).WithError(err).WithEvent(msgreg.PropertyValueTypeErrorBase(fmt.Sprintf("%+v", value), "Adopt"))
+		}
+
+		if adopt.Adopt.requested() {
+			return s.adoptStoragePool(ctx, model, &adopt.Adopt)
+		}
+	}
+
 	policy := NewAllocationPolicy(s.config.AllocationConfig, model.Oem)
 	if policy == nil {
-		return ec.NewErrNotAcceptable().WithEvent(msgreg.PropertyValueTypeErrorBase("Oem", fmt.Sprintf("%+v", model.Oem)))
+		return ec.NewErrNotAcceptable().WithEvent(msgreg.PropertyValueTypeErrorBase(fmt.Sprintf("%+v", model.Oem), "Oem"))
 	}
 
 	capacityInBytes := model.CapacityBytes
@@ -793,23 +972,26 @@ func (*StorageService) StorageServiceIdStoragePoolsPost(storageServiceId string,
 	}
 
 	p := s.createStoragePool(model.Id, model.Name, model.Description, uuid.UUID{}, policy)
+	defer p.mutex.Unlock()
 
-	updateFunc := func() (err error) {
-		p.providingVolumes, err = policy.Allocate()
+	updateFunc := func() error {
+		providingVolumes, err := policy.Allocate(ctx)
 		if err != nil {
 			return err
 		}
 
-		p.allocatedVolume = AllocatedVolume{
-			id:            DefaultAllocatedVolumeId,
-			capacityBytes: p.GetCapacityBytes(),
-		}
+		p.setProvidingVolumes(providingVolumes)
+
+		p.updateAllocatedVolume()
 
 		return nil
 	}
 
-	if err := s.persistentController.CreatePersistentObject(p, updateFunc, storagePoolStorageCreateStartLogEntryType, storagePoolStorageCreateCompleteLogEntryType); err != nil {
+	if err := s.persistentController.CreatePersistentObject(ctx, p, updateFunc, storagePoolStorageCreateStartLogEntryType, storagePoolStorageCreateCompleteLogEntryType); err != nil {
 		s.deleteStoragePool(p)
+		if retryable, delay := ec.IsRetryable(err); retryable {
+			return ec.NewErrServiceUnavailable().WithRetryDelay(delay).WithResourceType(StorageServiceOdataType).WithError(err).WithCause("Storage devices busy")
+		}
 		return ec.NewErrInternalServerError().WithResourceType(StorageServiceOdataType).WithError(err).WithCause("Failed to allocate storage volumes")
 	}
 
@@ -817,11 +999,11 @@ func (*StorageService) StorageServiceIdStoragePoolsPost(storageServiceId string,
 
 	log.Info("Created storage pool", storagePoolIdKey, p.id, "volumes", len(p.providingVolumes), "capacityInBytes", p.allocatedVolume.capacityBytes)
 
-	return s.StorageServiceIdStoragePoolIdGet(storageServiceId, p.id, model)
+	return s.StorageServiceIdStoragePoolIdGet(ctx, storageServiceId, p.id, model)
 }
 
 // StorageServiceIdStoragePoolsPatch updates the storage pools in the storage service.
-func (*StorageService) StorageServiceIdStoragePoolsPatch(storageServiceId string, model *sf.StoragePoolCollectionStoragePoolCollection) (err error) {
+func (*StorageService) StorageServiceIdStoragePoolsPatch(ctx context.Context, storageServiceId string, model *sf.StoragePoolCollectionStoragePoolCollection) (err error) {
 	s := findStorageService(storageServiceId)
 	if s == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
@@ -835,23 +1017,27 @@ func (*StorageService) StorageServiceIdStoragePoolsPatch(storageServiceId string
 		}
 	}()
 
-	// Patch each storage pool
-	for _, sp := range s.pools {
+	// Patch each storage pool, locking each in turn
+	pools := s.storagePools()
+	for _, sp := range pools {
 		log := log.WithValues(storagePoolIdKey, sp.id)
 		log.V(2).Info("Patching storage pool")
 
 		poolModel := &sf.StoragePoolV150StoragePool{
 			Id: sp.OdataId(),
 		}
-		err = s.StorageServiceIdStoragePoolIdPatch(storageServiceId, sp.id, poolModel)
+
+		sp.mutex.Lock()
+		err = s.StorageServiceIdStoragePoolIdPatch(ctx, storageServiceId, sp.id, poolModel)
+		sp.mutex.Unlock()
 		if err != nil {
 			break
 		}
 	}
 
-	model.MembersodataCount = int64(len(s.pools))
+	model.MembersodataCount = int64(len(pools))
 	model.Members = make([]sf.OdataV4IdRef, model.MembersodataCount)
-	for poolIdx, pool := range s.pools {
+	for poolIdx, pool := range pools {
 		model.Members[poolIdx] = sf.OdataV4IdRef{OdataId: pool.OdataId()}
 	}
 
@@ -859,27 +1045,33 @@ func (*StorageService) StorageServiceIdStoragePoolsPatch(storageServiceId string
 }
 
 // StorageServiceIdStoragePoolIdPut -
-func (*StorageService) StorageServiceIdStoragePoolIdPut(storageServiceId, storagePoolId string, model *sf.StoragePoolV150StoragePool) error {
+func (*StorageService) StorageServiceIdStoragePoolIdPut(ctx context.Context, storageServiceId, storagePoolId string, model *sf.StoragePoolV150StoragePool) error {
 	s, p := findStoragePool(storageServiceId, storagePoolId)
 	if s == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
 	}
 	if p != nil {
-		return s.StorageServiceIdStoragePoolIdGet(storageServiceId, storagePoolId, model)
+		return s.StorageServiceIdStoragePoolIdGet(ctx, storageServiceId, storagePoolId, model)
 	}
 
 	model.Id = storagePoolId
 
-	return s.StorageServiceIdStoragePoolsPost(storageServiceId, model)
+	return s.StorageServiceIdStoragePoolsPost(ctx, storageServiceId, model)
 }
 
 // StorageServiceIdStoragePoolIdGet -
-func (*StorageService) StorageServiceIdStoragePoolIdGet(storageServiceId, storagePoolId string, model *sf.StoragePoolV150StoragePool) error {
-	s, p := findStoragePool(storageServiceId, storagePoolId)
+func (*StorageService) StorageServiceIdStoragePoolIdGet(ctx context.Context, storageServiceId, storagePoolId string, model *sf.StoragePoolV150StoragePool) error {
+	s, p := lockedStoragePool(storageServiceId, storagePoolId)
+	if s != nil {
+		defer s.mutex.RUnlock()
+	}
+
 	if p == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StoragePoolOdataType, storagePoolId))
 	}
 
+	setResourceState(ctx, p)
+
 	model.Id = p.id
 	model.OdataId = p.OdataId()
 	model.AllocatedVolumes = p.OdataIdRef("/AllocatedVolumes")
@@ -908,12 +1100,12 @@ func (*StorageService) StorageServiceIdStoragePoolIdGet(storageServiceId, storag
 	model.Links.StorageGroupsodataCount = int64(len(p.storageGroupIds))
 	model.Links.StorageGroups = make([]sf.OdataV4IdRef, model.Links.StorageGroupsodataCount)
 	for storageGroupIdx, storageGroupId := range p.storageGroupIds {
-		sg := s.findStorageGroup(storageGroupId)
+		sg := s.findStorageGroupLocked(storageGroupId)
 		model.Links.StorageGroups[storageGroupIdx] = sf.OdataV4IdRef{OdataId: sg.OdataId()}
 	}
 
 	if p.fileSystemId != "" {
-		fs := s.findFileSystem(p.fileSystemId)
+		fs := s.findFileSystemLocked(p.fileSystemId)
 		model.Links.FileSystem = sf.OdataV4IdRef{OdataId: fs.OdataId()}
 	}
 
@@ -921,7 +1113,7 @@ func (*StorageService) StorageServiceIdStoragePoolIdGet(storageServiceId, storag
 }
 
 // StorageServiceIdStoragePoolIdDelete -
-func (*StorageService) StorageServiceIdStoragePoolIdDelete(storageServiceId, storagePoolId string) (err error) {
+func (*StorageService) StorageServiceIdStoragePoolIdDelete(ctx context.Context, storageServiceId, storagePoolId string) (err error) {
 	s, p := findStoragePool(storageServiceId, storagePoolId)
 
 	if p == nil {
@@ -936,52 +1128,57 @@ func (*StorageService) StorageServiceIdStoragePoolIdDelete(storageServiceId, sto
 		}
 	}()
 
+	// The storage pool is deleted along with its file system, file shares and storage groups as one persistent
+	// operation, so replay never finds an object whose storage pool has been removed.
+	ops := make([]PersistentObjectOperation, 0)
 	if p.fileSystemId != "" {
-		if err := s.StorageServiceIdFileSystemIdDelete(s.id, p.fileSystemId); err != nil {
-			return ec.NewErrInternalServerError().WithResourceType(StoragePoolOdataType).WithError(err).WithCause(fmt.Sprintf("Failed to delete file system '%s'", p.fileSystemId))
-		}
-
-		if len(p.fileSystemId) != 0 {
-			return ec.NewErrInternalServerError().WithResourceType(StoragePoolOdataType).WithCause(fmt.Sprintf("File system '%s' not removed from storage pool", p.fileSystemId))
-		}
-	}
-
-	// Make a copy of the storage groups to be deleted; We can't trust the storage pool's list as
-	// it is modified in place within the delete logic
-	storageGroupIds := make([]string, len(p.storageGroupIds))
-	copy(storageGroupIds, p.storageGroupIds)
-
-	for _, storageGroupId := range storageGroupIds {
-		if err := s.StorageServiceIdStorageGroupIdDelete(s.id, storageGroupId); err != nil {
-			return ec.NewErrInternalServerError().WithResourceType(StoragePoolOdataType).WithError(err).WithCause(fmt.Sprintf("Failed to delete storage group '%s'", storageGroupId))
+		fs := s.findFileSystem(p.fileSystemId)
+		if fs == nil {
+			return ec.NewErrInternalServerError().WithResourceType(StoragePoolOdataType).WithCause(fmt.Sprintf("File system '%s' not found", p.fileSystemId))
 		}
-	}
-
-	if len(p.storageGroupIds) != 0 {
-		return ec.NewErrInternalServerError().WithResourceType(StoragePoolOdataType).WithCause(fmt.Sprintf("Storage groups not removed from storage pool"))
-	}
 
-	deleteFunc := func() error {
-		err := p.deallocateVolumes()
+		fsOps, err := s.fileSystemDeleteOperations(ctx, fs)
 		if err != nil {
-			log.Error(err, "deallocateVolumes failed, but returning success anyway")
+			return ec.NewErrInternalServerError().WithResourceType(StoragePoolOdataType).WithError(err).WithCause(fmt.Sprintf("Failed to delete file system '%s'", p.fileSystemId))
 		}
 
-		return nil
+		ops = append(ops, fsOps...)
 	}
 
-	if err := s.persistentController.DeletePersistentObject(p, deleteFunc, storagePoolStorageDeleteStartLogEntryType, storagePoolStorageDeleteCompleteLogEntryType); err != nil {
-		err := ec.NewErrInternalServerError().WithResourceType(StoragePoolOdataType).WithError(err).WithCause(fmt.Sprintf("Failed to delete storage pool"))
-		if err != nil {
-			log.Error(err, "DeletePersistentObject failed, but returning success anyway")
+	for _, storageGroupId := range p.storageGroupIds {
+		sg := s.findStorageGroup(storageGroupId)
+		if sg == nil {
+			return ec.NewErrInternalServerError().WithResourceType(StoragePoolOdataType).WithCause(fmt.Sprintf("Storage group '%s' not found", storageGroupId))
 		}
 
-		return nil
+		ops = append(ops, PersistentObjectOperation{
+			Object:        sg,
+			Action:        DeletePersistentObjectAction,
+			StartingState: storageGroupDeleteStartLogEntryType,
+			EndingState:   storageGroupDeleteCompleteLogEntryType,
+			Func:          s.storageGroupDeleteFunc(ctx, sg, p),
+		})
 	}
 
-	event.EventManager.PublishResourceEvent(msgreg.ResourceRemovedResourceEvent(), p)
+	ops = append(ops, PersistentObjectOperation{
+		Object:        p,
+		Action:        DeletePersistentObjectAction,
+		StartingState: storagePoolStorageDeleteStartLogEntryType,
+		EndingState:   storagePoolStorageDeleteCompleteLogEntryType,
+		Func: func() error {
+			if err := p.deallocateVolumes(ctx); err != nil {
+				log.Error(err, "deallocateVolumes failed, but returning success anyway")
+			}
+
+			return nil
+		},
+	})
 
-	s.deleteStoragePool(p)
+	completed, err := s.persistentController.ExecutePersistentObjectOperations(ctx, ops)
+	s.removeDeletedObjects(ops[:completed])
+	if err != nil {
+		return ec.NewErrInternalServerError().WithResourceType(StoragePoolOdataType).WithError(err).WithCause("Failed to delete storage pool")
+	}
 
 	log.Info("Deleted storage pool")
 
@@ -989,7 +1186,7 @@ func (*StorageService) StorageServiceIdStoragePoolIdDelete(storageServiceId, sto
 }
 
 // StorageServiceIdStoragePoolIdPatch -
-func (*StorageService) StorageServiceIdStoragePoolIdPatch(storageServiceID, storagePoolID string, model *sf.StoragePoolV150StoragePool) (err error) {
+func (*StorageService) StorageServiceIdStoragePoolIdPatch(ctx context.Context, storageServiceID, storagePoolID string, model *sf.StoragePoolV150StoragePool) (err error) {
 	s, p := findStoragePool(storageServiceID, storagePoolID)
 	if p == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StoragePoolOdataType, storagePoolID))
@@ -1013,7 +1210,7 @@ func (*StorageService) StorageServiceIdStoragePoolIdPatch(storageServiceID, stor
 	}
 
 	// Replace any missing volumes
-	if err = s.patchStoragePool(p, true /* forceRescan */); err != nil {
+	if err = s.patchStoragePool(ctx, p, true /* forceRescan */); err != nil {
 		log.Error(err, "Failed to check and replace volumes in storage pool")
 		return ec.NewErrInternalServerError().WithResourceType(StoragePoolOdataType).WithError(err).WithCause("Failed to update storage pool resources")
 	}
@@ -1021,12 +1218,16 @@ func (*StorageService) StorageServiceIdStoragePoolIdPatch(storageServiceID, stor
 	log.Info("Patched storage pool")
 
 	// Return the updated storage pool model
-	return s.StorageServiceIdStoragePoolIdGet(storageServiceID, storagePoolID, model)
+	return s.StorageServiceIdStoragePoolIdGet(ctx, storageServiceID, storagePoolID, model)
 }
 
 // StorageServiceIdStoragePoolIdCapacitySourcesGet -
-func (*StorageService) StorageServiceIdStoragePoolIdCapacitySourcesGet(storageServiceId, storagePoolId string, model *sf.CapacitySourceCollectionCapacitySourceCollection) error {
-	_, p := findStoragePool(storageServiceId, storagePoolId)
+func (*StorageService) StorageServiceIdStoragePoolIdCapacitySourcesGet(ctx context.Context, storageServiceId, storagePoolId string, model *sf.CapacitySourceCollectionCapacitySourceCollection) error {
+	s, p := lockedStoragePool(storageServiceId, storagePoolId)
+	if s != nil {
+		defer s.mutex.RUnlock()
+	}
+
 	if p == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StoragePoolOdataType, storagePoolId))
 	}
@@ -1038,8 +1239,12 @@ func (*StorageService) StorageServiceIdStoragePoolIdCapacitySourcesGet(storageSe
 }
 
 // StorageServiceIdStoragePoolIdCapacitySourceIdGet -
-func (*StorageService) StorageServiceIdStoragePoolIdCapacitySourceIdGet(storageServiceId, storagePoolId, capacitySourceId string, model *sf.CapacityCapacitySource) error {
-	_, p := findStoragePool(storageServiceId, storagePoolId)
+func (*StorageService) StorageServiceIdStoragePoolIdCapacitySourceIdGet(ctx context.Context, storageServiceId, storagePoolId, capacitySourceId string, model *sf.CapacityCapacitySource) error {
+	s, p := lockedStoragePool(storageServiceId, storagePoolId)
+	if s != nil {
+		defer s.mutex.RUnlock()
+	}
+
 	if p == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StoragePoolOdataType, storagePoolId))
 	}
@@ -1048,29 +1253,36 @@ func (*StorageService) StorageServiceIdStoragePoolIdCapacitySourceIdGet(storageS
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(CapacitySourceOdataType, capacitySourceId))
 	}
 
-	s := p.capacitySourcesGet()[0]
+	cs := p.capacitySourcesGet()[0]
 
-	model.Id = s.Id
-	model.ProvidedCapacity = s.ProvidedCapacity
-	model.ProvidingVolumes = s.ProvidingVolumes
+	model.Id = cs.Id
+	model.ProvidedCapacity = cs.ProvidedCapacity
+	model.ProvidingVolumes = cs.ProvidingVolumes
 
 	return nil
 }
 
 // StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVolumesGet -
-func (*StorageService) StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVolumesGet(storageServiceId, storagePoolId, capacitySourceId string, model *sf.VolumeCollectionVolumeCollection) error {
-	_, p := findStoragePool(storageServiceId, storagePoolId)
+func (*StorageService) StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVolumesGet(ctx context.Context, storageServiceId, storagePoolId, capacitySourceId string, model *sf.VolumeCollectionVolumeCollection) error {
+	s, p := lockedStoragePool(storageServiceId, storagePoolId)
 	if p == nil {
+		if s != nil {
+			s.mutex.RUnlock()
+		}
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StoragePoolOdataType, storagePoolId))
 	}
 
+	// The volumes are found once the service is unlocked, as the drive's lock is held for its commands
+	providingVolumes := append([]nvme.ProvidingVolume(nil), p.providingVolumes...)
+	s.mutex.RUnlock()
+
 	if !p.isCapacitySource(capacitySourceId) {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(CapacitySourceOdataType, capacitySourceId))
 	}
 
-	model.MembersodataCount = int64(len(p.providingVolumes))
+	model.MembersodataCount = int64(len(providingVolumes))
 	model.Members = make([]sf.OdataV4IdRef, model.MembersodataCount)
-	for idx, pv := range p.providingVolumes {
+	for idx, pv := range providingVolumes {
 		volume := pv.Storage.FindVolume(pv.VolumeId)
 		if volume != nil {
 			model.Members[idx] = sf.OdataV4IdRef{OdataId: volume.GetOdataId()}
@@ -1081,8 +1293,12 @@ func (*StorageService) StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVol
 }
 
 // StorageServiceIdStoragePoolIdAllocatedVolumesGet -
-func (*StorageService) StorageServiceIdStoragePoolIdAllocatedVolumesGet(storageServiceId, storagePoolId string, model *sf.VolumeCollectionVolumeCollection) error {
-	_, p := findStoragePool(storageServiceId, storagePoolId)
+func (*StorageService) StorageServiceIdStoragePoolIdAllocatedVolumesGet(ctx context.Context, storageServiceId, storagePoolId string, model *sf.VolumeCollectionVolumeCollection) error {
+	s, p := lockedStoragePool(storageServiceId, storagePoolId)
+	if s != nil {
+		defer s.mutex.RUnlock()
+	}
+
 	if p == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StoragePoolOdataType, storagePoolId))
 	}
@@ -1096,8 +1312,12 @@ func (*StorageService) StorageServiceIdStoragePoolIdAllocatedVolumesGet(storageS
 }
 
 // StorageServiceIdStoragePoolIdAllocatedVolumeIdGet -
-func (*StorageService) StorageServiceIdStoragePoolIdAllocatedVolumeIdGet(storageServiceId, storagePoolId, volumeId string, model *sf.VolumeV161Volume) error {
-	_, p := findStoragePool(storageServiceId, storagePoolId)
+func (*StorageService) StorageServiceIdStoragePoolIdAllocatedVolumeIdGet(ctx context.Context, storageServiceId, storagePoolId, volumeId string, model *sf.VolumeV161Volume) error {
+	s, p := lockedStoragePool(storageServiceId, storagePoolId)
+	if s != nil {
+		defer s.mutex.RUnlock()
+	}
+
 	if p == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StoragePoolOdataType, storagePoolId))
 	}
@@ -1125,7 +1345,7 @@ func (*StorageService) StorageServiceIdStoragePoolIdAllocatedVolumeIdGet(storage
 }
 
 // StorageServiceIdStorageGroupsGet -
-func (*StorageService) StorageServiceIdStorageGroupsGet(storageServiceId string, model *sf.StorageGroupCollectionStorageGroupCollection) error {
+func (*StorageService) StorageServiceIdStorageGroupsGet(ctx context.Context, storageServiceId string, model *sf.StorageGroupCollectionStorageGroupCollection) error {
 	s := findStorageService(storageServiceId)
 	if s == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
@@ -1141,7 +1361,7 @@ func (*StorageService) StorageServiceIdStorageGroupsGet(storageServiceId string,
 }
 
 // StorageServiceIdStorageGroupPost creates a new storage group in the storage service.
-func (*StorageService) StorageServiceIdStorageGroupPost(storageServiceId string, model *sf.StorageGroupV150StorageGroup) (err error) {
+func (*StorageService) StorageServiceIdStorageGroupPost(ctx context.Context, storageServiceId string, model *sf.StorageGroupV150StorageGroup) (err error) {
 	s := findStorageService(storageServiceId)
 	if s == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
@@ -1195,7 +1415,7 @@ func (*StorageService) StorageServiceIdStorageGroupPost(storageServiceId string,
 				return ec.NewErrInternalServerError().WithResourceType(StorageGroupOdataType).WithCause(fmt.Sprintf("Storage group '%s' attach volume '%s' not found", sg.id, pv.VolumeId))
 			}
 
-			if err := volume.AttachController(sg.endpoint.controllerId); err != nil {
+			if err := volume.AttachController(ctx, sg.endpoint.controllerId); err != nil {
 				return ec.NewErrInternalServerError().WithResourceType(StorageGroupOdataType).WithError(err).WithCause(fmt.Sprintf("Storage group '%s' attach volume '%s' failed", sg.id, pv.VolumeId))
 			}
 		}
@@ -1203,8 +1423,11 @@ func (*StorageService) StorageServiceIdStorageGroupPost(storageServiceId string,
 		return nil
 	}
 
-	if err := s.persistentController.CreatePersistentObject(sg, updateFunc, storageGroupCreateStartLogEntryType, storageGroupCreateCompleteLogEntryType); err != nil {
+	if err := s.persistentController.CreatePersistentObject(ctx, sg, updateFunc, storageGroupCreateStartLogEntryType, storageGroupCreateCompleteLogEntryType); err != nil {
 		s.deleteStorageGroup(sg)
+		if retryable, delay := ec.IsRetryable(err); retryable {
+			return ec.NewErrServiceUnavailable().WithRetryDelay(delay).WithResourceType(StorageGroupOdataType).WithError(err).WithCause("Storage devices busy")
+		}
 		return ec.NewErrInternalServerError().WithResourceType(StorageGroupOdataType).WithError(err).WithCause("failed to create storage group")
 	}
 
@@ -1212,36 +1435,49 @@ func (*StorageService) StorageServiceIdStorageGroupPost(storageServiceId string,
 
 	log.Info("Created storage group", storageGroupIdKey, sg.id)
 
-	return s.StorageServiceIdStorageGroupIdGet(storageServiceId, sg.id, model)
+	return s.StorageServiceIdStorageGroupIdGet(ctx, storageServiceId, sg.id, model)
 }
 
 // StorageServiceIdStorageGroupIdPut handles PUT requests for a specific storage group
-func (*StorageService) StorageServiceIdStorageGroupIdPut(storageServiceId, storageGroupId string, model *sf.StorageGroupV150StorageGroup) error {
+func (*StorageService) StorageServiceIdStorageGroupIdPut(ctx context.Context, storageServiceId, storageGroupId string, model *sf.StorageGroupV150StorageGroup) error {
 	s, sg := findStorageGroup(storageServiceId, storageGroupId)
 	if s == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
 	}
 	if sg != nil {
-		return s.StorageServiceIdStorageGroupIdGet(storageServiceId, storageGroupId, model)
+		return s.StorageServiceIdStorageGroupIdGet(ctx, storageServiceId, storageGroupId, model)
 	}
 
 	model.Id = storageGroupId
 
-	return s.StorageServiceIdStorageGroupPost(storageServiceId, model)
+	return s.StorageServiceIdStorageGroupPost(ctx, storageServiceId, model)
 }
 
 // StorageServiceIdStorageGroupIdGet handles GET requests for a specific storage group
-func (*StorageService) StorageServiceIdStorageGroupIdGet(storageServiceId, storageGroupId string, model *sf.StorageGroupV150StorageGroup) error {
-	s, sg := findStorageGroup(storageServiceId, storageGroupId)
+func (*StorageService) StorageServiceIdStorageGroupIdGet(ctx context.Context, storageServiceId, storageGroupId string, model *sf.StorageGroupV150StorageGroup) error {
+	s := findStorageService(storageServiceId)
+	if s == nil {
+		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageGroupOdataType, storageGroupId))
+	}
+
+	// The storage group is read with the service read-locked, and its status is queried from the server
+	// once unlocked, so the request waits on neither the storage pool's operations nor the server.
+	s.mutex.RLock()
+
+	sg := s.findStorageGroupLocked(storageGroupId)
 	if sg == nil {
+		s.mutex.RUnlock()
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageGroupOdataType, storageGroupId))
 	}
 
-	sp := s.findStoragePool(sg.storagePoolId)
+	sp := s.findStoragePoolLocked(sg.storagePoolId)
 	if sp == nil {
+		s.mutex.RUnlock()
 		return ec.NewErrInternalServerError().WithCause(fmt.Sprintf("Storage group '%s' does not have associated storage pool '%s'", storageGroupId, sg.storagePoolId))
 	}
 
+	setResourceState(ctx, sg)
+
 	model.Id = sg.id
 	model.OdataId = sg.OdataId()
 
@@ -1262,13 +1498,45 @@ func (*StorageService) StorageServiceIdStorageGroupIdGet(storageServiceId, stora
 	model.Links.ServerEndpoint = sf.OdataV4IdRef{OdataId: sg.endpoint.OdataId()}
 	model.Links.StoragePool = sf.OdataV4IdRef{OdataId: sp.OdataId()}
 
-	model.Status = sg.status()
+	s.mutex.RUnlock()
+
+	model.Status = sg.status(ctx)
 
 	return nil
 }
 
+// storageGroupDeleteFunc returns the function that detaches the storage group's endpoint from the storage
+// pool's volumes and notifies the server the namespaces were removed
+func (s *StorageService) storageGroupDeleteFunc(ctx context.Context, sg *StorageGroup, sp *StoragePool) func() error {
+	log := s.log.WithValues(storageGroupIdKey, sg.id)
+
+	return func() error {
+		// Detach the endpoint from the NVMe namespaces
+		for _, pv := range sp.providingVolumes {
+			volume := pv.Storage.FindVolume(pv.VolumeId)
+			if volume == nil {
+				err := fmt.Errorf("Volume not found")
+				log.Error(err, "Storage group detach volume not found", "storageGroup", sg.id, "volumeid", pv.VolumeId)
+				continue
+			}
+
+			if err := volume.DetachController(ctx, sg.endpoint.controllerId); err != nil {
+				log.Error(err, "Storage group failed to detach controller", "storageGroup", sg.id, "controller", sg.endpoint.controllerId)
+				continue
+			}
+		}
+
+		// Notify the Server the namespaces were removed
+		if err := sg.serverStorage.Delete(ctx); err != nil {
+			log.Error(err, "Storage group server delete failed", "storageGroup", sg.id)
+		}
+
+		return nil
+	}
+}
+
 // StorageServiceIdStorageGroupIdDelete -
-func (*StorageService) StorageServiceIdStorageGroupIdDelete(storageServiceId, storageGroupId string) (err error) {
+func (*StorageService) StorageServiceIdStorageGroupIdDelete(ctx context.Context, storageServiceId, storageGroupId string) (err error) {
 	s, sg := findStorageGroup(storageServiceId, storageGroupId)
 
 	if sg == nil {
@@ -1293,31 +1561,9 @@ func (*StorageService) StorageServiceIdStorageGroupIdDelete(storageServiceId, st
 		return ec.NewErrInternalServerError().WithCause(fmt.Sprintf("Storage group '%s' does not have associated storage pool '%s'", storageGroupId, sg.storagePoolId))
 	}
 
-	deleteFunc := func() error {
-		// Detach the endpoint from the NVMe namespaces
-		for _, pv := range sp.providingVolumes {
-			volume := pv.Storage.FindVolume(pv.VolumeId)
-			if volume == nil {
-				err := fmt.Errorf("Volume not found")
-				log.Error(err, "Storage group detach volume not found", "storageGroup", storageGroupId, "volumeid", pv.VolumeId)
-				continue
-			}
-
-			if err := volume.DetachController(sg.endpoint.controllerId); err != nil {
-				log.Error(err, "Storage group failed to detach controller", "storageGroup", storageGroupId, "controller", sg.endpoint.controllerId)
-				continue
-			}
-		}
-
-		// Notify the Server the namespaces were removed
-		if err := sg.serverStorage.Delete(); err != nil {
-			log.Error(err, "Storage group server delete failed", "storageGroup", storageGroupId)
-		}
-
-		return nil
-	}
+	deleteFunc := s.storageGroupDeleteFunc(ctx, sg, sp)
 
-	if err := s.persistentController.DeletePersistentObject(sg, deleteFunc, storageGroupDeleteStartLogEntryType, storageGroupDeleteCompleteLogEntryType); err != nil {
+	if err := s.persistentController.DeletePersistentObject(ctx, sg, deleteFunc, storageGroupDeleteStartLogEntryType, storageGroupDeleteCompleteLogEntryType); err != nil {
 		return ec.NewErrInternalServerError().WithResourceType(StorageGroupOdataType).WithError(err).WithCause("Failed to delete storage group")
 	}
 
@@ -1331,7 +1577,7 @@ func (*StorageService) StorageServiceIdStorageGroupIdDelete(storageServiceId, st
 }
 
 // StorageServiceIdEndpointsGet -
-func (*StorageService) StorageServiceIdEndpointsGet(storageServiceId string, model *sf.EndpointCollectionEndpointCollection) error {
+func (*StorageService) StorageServiceIdEndpointsGet(ctx context.Context, storageServiceId string, model *sf.EndpointCollectionEndpointCollection) error {
 	s := findStorageService(storageServiceId)
 	if s == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
@@ -1347,7 +1593,7 @@ func (*StorageService) StorageServiceIdEndpointsGet(storageServiceId string, mod
 }
 
 // StorageServiceIdEndpointIdGet -
-func (*StorageService) StorageServiceIdEndpointIdGet(storageServiceId, endpointId string, model *sf.EndpointV150Endpoint) error {
+func (*StorageService) StorageServiceIdEndpointIdGet(ctx context.Context, storageServiceId, endpointId string, model *sf.EndpointV150Endpoint) error {
 	_, ep := findEndpoint(storageServiceId, endpointId)
 	if ep == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(EndpointOdataType, endpointId))
@@ -1363,14 +1609,14 @@ func (*StorageService) StorageServiceIdEndpointIdGet(storageServiceId, endpointI
 
 	model.OdataId = ep.OdataId() // Done twice so the fabric manager doesn't hijak the @odata.id
 
-	serverInfo := ep.serverCtrl.GetServerInfo()
+	serverInfo := ep.serverCtrl.GetServerInfo(ctx)
 	model.Oem["LNetNids"] = serverInfo.LNetNids
 
 	return nil
 }
 
 // StorageServiceIdFileSystemsGet -
-func (*StorageService) StorageServiceIdFileSystemsGet(storageServiceId string, model *sf.FileSystemCollectionFileSystemCollection) error {
+func (*StorageService) StorageServiceIdFileSystemsGet(ctx context.Context, storageServiceId string, model *sf.FileSystemCollectionFileSystemCollection) error {
 	s := findStorageService(storageServiceId)
 	if s == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
@@ -1386,7 +1632,7 @@ func (*StorageService) StorageServiceIdFileSystemsGet(storageServiceId string, m
 }
 
 // StorageServiceIdFileSystemsPost -
-func (*StorageService) StorageServiceIdFileSystemsPost(storageServiceId string, model *sf.FileSystemV122FileSystem) (err error) {
+func (*StorageService) StorageServiceIdFileSystemsPost(ctx context.Context, storageServiceId string, model *sf.FileSystemV122FileSystem) (err error) {
 	s := findStorageService(storageServiceId)
 	if s == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
@@ -1432,7 +1678,7 @@ func (*StorageService) StorageServiceIdFileSystemsPost(storageServiceId string,
 
 	fs := s.createFileSystem(model.Id, sp, fsApi, oem)
 
-	if err := s.persistentController.CreatePersistentObject(fs, func() error { return nil }, fileSystemCreateStartLogEntryType, fileSystemCreateCompleteLogEntryType); err != nil {
+	if err := s.persistentController.CreatePersistentObject(ctx, fs, func() error { return nil }, fileSystemCreateStartLogEntryType, fileSystemCreateCompleteLogEntryType); err != nil {
 		s.deleteFileSystem(fs)
 		return ec.NewErrInternalServerError().WithResourceType(FileSystemOdataType).WithError(err).WithCause(fmt.Sprintf("File system '%s' failed to create", fs.id))
 	}
@@ -1441,36 +1687,46 @@ func (*StorageService) StorageServiceIdFileSystemsPost(storageServiceId string,
 
 	log.Info("Created file system", fileSystemIdKey, fs.id)
 
-	return s.StorageServiceIdFileSystemIdGet(storageServiceId, fs.id, model)
+	return s.StorageServiceIdFileSystemIdGet(ctx, storageServiceId, fs.id, model)
 }
 
 // StorageServiceIdFileSystemIdPut -
-func (*StorageService) StorageServiceIdFileSystemIdPut(storageServiceId, fileSystemId string, model *sf.FileSystemV122FileSystem) error {
+func (*StorageService) StorageServiceIdFileSystemIdPut(ctx context.Context, storageServiceId, fileSystemId string, model *sf.FileSystemV122FileSystem) error {
 	s, fs := findFileSystem(storageServiceId, fileSystemId)
 	if s == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
 	}
 	if fs != nil {
-		return s.StorageServiceIdFileSystemIdGet(storageServiceId, fileSystemId, model)
+		return s.StorageServiceIdFileSystemIdGet(ctx, storageServiceId, fileSystemId, model)
 	}
 
 	model.Id = fileSystemId
 
-	return s.StorageServiceIdFileSystemsPost(storageServiceId, model)
+	return s.StorageServiceIdFileSystemsPost(ctx, storageServiceId, model)
 }
 
 // StorageServiceIdFileSystemIdGet -
-func (*StorageService) StorageServiceIdFileSystemIdGet(storageServiceId, fileSystemId string, model *sf.FileSystemV122FileSystem) error {
-	s, fs := findFileSystem(storageServiceId, fileSystemId)
+func (*StorageService) StorageServiceIdFileSystemIdGet(ctx context.Context, storageServiceId, fileSystemId string, model *sf.FileSystemV122FileSystem) error {
+	s := findStorageService(storageServiceId)
+	if s == nil {
+		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(FileSystemOdataType, fileSystemId))
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	fs := s.findFileSystemLocked(fileSystemId)
 	if fs == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(FileSystemOdataType, fileSystemId))
 	}
 
-	sp := s.findStoragePool(fs.storagePoolId)
+	sp := s.findStoragePoolLocked(fs.storagePoolId)
 	if sp == nil {
 		return ec.NewErrInternalServerError().WithCause(fmt.Sprintf("Could not find storage pool for file system Storage Pool ID: %s", fs.storagePoolId))
 	}
 
+	setResourceState(ctx, fs)
+
 	model.Id = fs.id
 	model.OdataId = fs.OdataId()
 
@@ -1483,8 +1739,79 @@ func (*StorageService) StorageServiceIdFileSystemIdGet(storageServiceId, fileSys
 	return nil
 }
 
+// fileSystemDeleteOperations returns the persistent operations that delete the file system and its file shares.
+// They are run as one persistent operation so replay never finds a file share whose file system has been removed.
+// Should a file share fail to delete, the file shares already deleted are removed and the file system and its
+// remaining file shares are left in the delete start state so the delete can be retried.
+func (s *StorageService) fileSystemDeleteOperations(ctx context.Context, fs *FileSystem) ([]PersistentObjectOperation, error) {
+	// The operations refer to copies of the file shares, as the file system's shares are modified in place
+	// as each is removed
+	shares := append([]FileShare(nil), fs.shares...)
+
+	task := ec.TaskFromContext(ctx)
+	ops := make([]PersistentObjectOperation, 0, len(shares)+1)
+	for shareIdx := range shares {
+		sh := &shares[shareIdx]
+
+		deleteFunc, err := s.fileShareDeleteFunc(ctx, fs, sh)
+		if err != nil {
+			return nil, err
+		}
+
+		percentComplete := int64(100 * (shareIdx + 1) / len(shares))
+		ops = append(ops, PersistentObjectOperation{
+			Object:        sh,
+			Action:        DeletePersistentObjectAction,
+			StartingState: fileShareDeleteStartLogEntryType,
+			EndingState:   fileShareDeleteCompleteLogEntryType,
+			Func: func() error {
+				if err := deleteFunc(); err != nil {
+					return err
+				}
+
+				task.SetPercentComplete(percentComplete)
+				return nil
+			},
+		})
+	}
+
+	ops = append(ops, PersistentObjectOperation{
+		Object:        fs,
+		Action:        DeletePersistentObjectAction,
+		StartingState: fileSystemDeleteStartLogEntryType,
+		EndingState:   fileSystemDeleteCompleteLogEntryType,
+	})
+
+	return ops, nil
+}
+
+// removeDeletedObjects removes the objects deleted by the completed persistent operations from the storage service
+func (s *StorageService) removeDeletedObjects(ops []PersistentObjectOperation) {
+	for _, op := range ops {
+		if op.Action != DeletePersistentObjectAction {
+			continue
+		}
+
+		if resource, ok := op.Object.(event.Resource); ok {
+			event.EventManager.PublishResourceEvent(msgreg.ResourceRemovedResourceEvent(), resource)
+		}
+
+		switch obj := op.Object.(type) {
+		case *FileShare:
+			s.findFileSystem(obj.fileSystemId).deleteFileShare(obj)
+			s.log.Info("Deleted file share", fileSystemIdKey, obj.fileSystemId, fileShareIdKey, obj.id)
+		case *FileSystem:
+			s.deleteFileSystem(obj)
+		case *StorageGroup:
+			s.deleteStorageGroup(obj)
+		case *StoragePool:
+			s.deleteStoragePool(obj)
+		}
+	}
+}
+
 // StorageServiceIdFileSystemIdDelete -
-func (*StorageService) StorageServiceIdFileSystemIdDelete(storageServiceId, fileSystemId string) (err error) {
+func (*StorageService) StorageServiceIdFileSystemIdDelete(ctx context.Context, storageServiceId, fileSystemId string) (err error) {
 	s, fs := findFileSystem(storageServiceId, fileSystemId)
 	if fs == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(FileSystemOdataType, fileSystemId))
@@ -1498,34 +1825,24 @@ func (*StorageService) StorageServiceIdFileSystemIdDelete(storageServiceId, file
 		}
 	}()
 
-	// Create a copy of file share IDs; The deletion of a share will modify the fs.shares[] so we cannot
-	// iterate on that array directly as it is editted in place.
-	shareIds := make([]string, len(fs.shares))
-	for idx, sh := range fs.shares {
-		shareIds[idx] = sh.id
-	}
-
-	for _, shid := range shareIds {
-		if err := s.StorageServiceIdFileSystemIdExportedShareIdDelete(s.id, fs.id, shid); err != nil {
-			return ec.NewErrInternalServerError().WithResourceType(FileSystemOdataType).WithError(err).WithCause(fmt.Sprintf("Exported share '%s' failed delete", shid))
-		}
+	ops, err := s.fileSystemDeleteOperations(ctx, fs)
+	if err != nil {
+		return err
 	}
 
-	if err := s.persistentController.DeletePersistentObject(fs, func() error { return nil }, fileSystemDeleteStartLogEntryType, fileSystemDeleteCompleteLogEntryType); err != nil {
+	completed, err := s.persistentController.ExecutePersistentObjectOperations(ctx, ops)
+	s.removeDeletedObjects(ops[:completed])
+	if err != nil {
 		return ec.NewErrInternalServerError().WithResourceType(FileSystemOdataType).WithError(err).WithCause("Failed to delete file system")
 	}
 
-	event.EventManager.PublishResourceEvent(msgreg.ResourceRemovedResourceEvent(), fs)
-
-	s.deleteFileSystem(fs)
-
 	log.Info("Deleted file system")
 
 	return nil
 }
 
 // StorageServiceIdFileSystemIdExportedSharesGet -
-func (*StorageService) StorageServiceIdFileSystemIdExportedSharesGet(storageServiceId, fileSystemId string, model *sf.FileShareCollectionFileShareCollection) error {
+func (*StorageService) StorageServiceIdFileSystemIdExportedSharesGet(ctx context.Context, storageServiceId, fileSystemId string, model *sf.FileShareCollectionFileShareCollection) error {
 	_, fs := findFileSystem(storageServiceId, fileSystemId)
 	if fs == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(FileSystemOdataType, fileSystemId))
@@ -1540,7 +1857,7 @@ func (*StorageService) StorageServiceIdFileSystemIdExportedSharesGet(storageServ
 }
 
 // StorageServiceIdFileSystemIdExportedSharesPost -
-func (*StorageService) StorageServiceIdFileSystemIdExportedSharesPost(storageServiceId, fileSystemId string, model *sf.FileShareV120FileShare) (err error) {
+func (*StorageService) StorageServiceIdFileSystemIdExportedSharesPost(ctx context.Context, storageServiceId, fileSystemId string, model *sf.FileShareV120FileShare) (err error) {
 	s, fs := findFileSystem(storageServiceId, fileSystemId)
 	if fs == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(FileSystemOdataType, fileSystemId))
@@ -1580,7 +1897,7 @@ func (*StorageService) StorageServiceIdFileSystemIdExportedSharesPost(storageSer
 	}
 
 	// Wait for the storage group to be ready (enabled) to ensure the disks are present on the system
-	state := sg.status().State
+	state := sg.status(ctx).State
 	if state == sf.STARTING_RST {
 		log.V(2).Info("Storage group starting", storageGroupIdKey, sg.id)
 		return ec.NewErrorNotReady().WithResourceType(StorageGroupOdataType).WithCause(fmt.Sprintf("Storage group '%s' is starting", sg.id))
@@ -1591,13 +1908,17 @@ func (*StorageService) StorageServiceIdFileSystemIdExportedSharesPost(storageSer
 
 	sh := fs.createFileShare(model.Id, sg, model.FileSharePath)
 
+	task := ec.TaskFromContext(ctx)
 	createFunc := func() error {
-		if err := sg.serverStorage.CreateFileSystem(fs.fsApi, model.Oem); err != nil {
+		task.AddMessage("Creating file system")
+		if err := sg.serverStorage.CreateFileSystem(ctx, fs.fsApi, model.Oem); err != nil {
 			return ec.NewErrInternalServerError().WithResourceType(FileShareOdataType).WithError(err).WithCause(fmt.Sprintf("File share '%s' create failed", sh.id))
 		}
 
-		if err := sg.serverStorage.MountFileSystem(fs.fsApi, sh.mountRoot); err != nil {
-			if deleteErr := sg.serverStorage.DeleteFileSystem(fs.fsApi); deleteErr != nil {
+		task.SetPercentComplete(50)
+		task.AddMessage("Mounting file system")
+		if err := sg.serverStorage.MountFileSystem(ctx, fs.fsApi, sh.mountRoot); err != nil {
+			if deleteErr := sg.serverStorage.DeleteFileSystem(ctx, fs.fsApi); deleteErr != nil {
 				return ec.NewErrInternalServerError().WithResourceType(FileShareOdataType).WithError(deleteErr).WithCause(fmt.Sprintf("File share '%s' failed delete after mount failure", sh.id))
 			}
 
@@ -1607,7 +1928,7 @@ func (*StorageService) StorageServiceIdFileSystemIdExportedSharesPost(storageSer
 		return nil
 	}
 
-	if err := s.persistentController.CreatePersistentObject(sh, createFunc, fileShareCreateStartLogEntryType, fileShareCreateCompleteLogEntryType); err != nil {
+	if err := s.persistentController.CreatePersistentObject(ctx, sh, createFunc, fileShareCreateStartLogEntryType, fileShareCreateCompleteLogEntryType); err != nil {
 		return ec.NewErrInternalServerError().WithError(err).WithCause(fmt.Sprintf("File share '%s' failed to create", sh.id))
 	}
 
@@ -1615,11 +1936,11 @@ func (*StorageService) StorageServiceIdFileSystemIdExportedSharesPost(storageSer
 
 	log.Info("Created file share", fileShareIdKey, sh.id, "path", sh.mountRoot)
 
-	return s.StorageServiceIdFileSystemIdExportedShareIdGet(storageServiceId, fileSystemId, sh.id, model)
+	return s.StorageServiceIdFileSystemIdExportedShareIdGet(ctx, storageServiceId, fileSystemId, sh.id, model)
 }
 
 // StorageServiceIdFileSystemIdExportedShareIdPut -
-func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdPut(storageServiceId, fileSystemId, exportedShareId string, model *sf.FileShareV120FileShare) (err error) {
+func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdPut(ctx context.Context, storageServiceId, fileSystemId, exportedShareId string, model *sf.FileShareV120FileShare) (err error) {
 	s, fs, sh := findFileShare(storageServiceId, fileSystemId, exportedShareId)
 	if fs == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(FileShareOdataType, exportedShareId))
@@ -1627,7 +1948,7 @@ func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdPut(storageSer
 
 	if sh == nil {
 		model.Id = exportedShareId
-		return s.StorageServiceIdFileSystemIdExportedSharesPost(storageServiceId, fileSystemId, model)
+		return s.StorageServiceIdFileSystemIdExportedSharesPost(ctx, storageServiceId, fileSystemId, model)
 	}
 
 	log := s.log.WithValues(fileShareIdKey, sh.id, fileSystemIdKey, fs.id)
@@ -1639,7 +1960,7 @@ func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdPut(storageSer
 	}()
 
 	newPath := model.FileSharePath
-	if err := s.StorageServiceIdFileSystemIdExportedShareIdGet(storageServiceId, fileSystemId, exportedShareId, model); err != nil {
+	if err := s.StorageServiceIdFileSystemIdExportedShareIdGet(ctx, storageServiceId, fileSystemId, exportedShareId, model); err != nil {
 		return err
 	}
 
@@ -1681,7 +2002,7 @@ func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdPut(storageSer
 		sh.mountRoot = newPath
 
 		updateFunc = func() error {
-			if err := sg.serverStorage.MountFileSystem(fs.fsApi, sh.mountRoot); err != nil {
+			if err := sg.serverStorage.MountFileSystem(ctx, fs.fsApi, sh.mountRoot); err != nil {
 				return ec.NewErrInternalServerError().WithResourceType(FileShareOdataType).WithError(err).WithCause(fmt.Sprintf("Failed to mount file share '%s' at path '%s'", sh.id, sh.mountRoot))
 			}
 
@@ -1690,7 +2011,7 @@ func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdPut(storageSer
 
 	} else {
 		updateFunc = func() error {
-			if err := sg.serverStorage.UnmountFileSystem(fs.fsApi, sh.mountRoot); err != nil {
+			if err := sg.serverStorage.UnmountFileSystem(ctx, fs.fsApi, sh.mountRoot); err != nil {
 				return ec.NewErrInternalServerError().WithResourceType(FileShareOdataType).WithError(err).WithCause(fmt.Sprintf("Failed to unmount file share '%s' at path '%s'", sh.id, sh.mountRoot))
 			}
 
@@ -1700,7 +2021,7 @@ func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdPut(storageSer
 		}
 	}
 
-	if err := s.persistentController.UpdatePersistentObject(sh, updateFunc, fileShareUpdateStartLogEntryType, fileShareUpdateCompleteLogEntryType); err != nil {
+	if err := s.persistentController.UpdatePersistentObject(ctx, sh, updateFunc, fileShareUpdateStartLogEntryType, fileShareUpdateCompleteLogEntryType); err != nil {
 		return ec.NewErrInternalServerError().WithError(err).WithCause(fmt.Sprintf("File share '%s' failed to update", sh.id))
 	}
 
@@ -1708,12 +2029,12 @@ func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdPut(storageSer
 
 	log.V(1).Info("Updated file share")
 
-	return s.StorageServiceIdFileSystemIdExportedShareIdGet(storageServiceId, fileSystemId, sh.id, model)
+	return s.StorageServiceIdFileSystemIdExportedShareIdGet(ctx, storageServiceId, fileSystemId, sh.id, model)
 
 }
 
 // StorageServiceIdFileSystemIdExportedShareIdGet -
-func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdGet(storageServiceId, fileSystemId, exportedShareId string, model *sf.FileShareV120FileShare) error {
+func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdGet(ctx context.Context, storageServiceId, fileSystemId, exportedShareId string, model *sf.FileShareV120FileShare) error {
 	s, fs, sh := findFileShare(storageServiceId, fileSystemId, exportedShareId)
 	if sh == nil {
 		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(FileShareOdataType, exportedShareId))
@@ -1724,19 +2045,44 @@ func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdGet(storageSer
 		return ec.NewErrInternalServerError().WithCause(fmt.Sprintf("File share '%s' does not have associated storage group '%s'", exportedShareId, sh.storageGroupId))
 	}
 
+	setResourceState(ctx, sh)
+
 	model.Id = sh.id
 	model.OdataId = sh.OdataId()
 	model.FileSharePath = sh.mountRoot
 	model.Links.FileSystem = sf.OdataV4IdRef{OdataId: fs.OdataId()}
 	model.Links.Endpoint = sf.OdataV4IdRef{OdataId: sg.endpoint.OdataId()}
 
-	model.Status = *sh.getStatus() // TODO
+	model.Status = *sh.getStatus(ctx) // TODO
 
 	return nil
 }
 
+// fileShareDeleteFunc returns the function that unmounts and deletes the file share's file system on the server
+func (s *StorageService) fileShareDeleteFunc(ctx context.Context, fs *FileSystem, sh *FileShare) (func() error, error) {
+	sg := s.findStorageGroup(sh.storageGroupId)
+	if sg == nil {
+		return nil, ec.NewErrInternalServerError().WithResourceType(FileShareOdataType).WithCause(fmt.Sprintf("File share '%s' does not have associated storage group '%s'", sh.id, sh.storageGroupId))
+	}
+
+	mountRoot := sh.mountRoot
+	shareId := sh.id
+
+	return func() error {
+		if err := sg.serverStorage.UnmountFileSystem(ctx, fs.fsApi, mountRoot); err != nil {
+			return ec.NewErrInternalServerError().WithResourceType(FileShareOdataType).WithError(err).WithCause(fmt.Sprintf("File share '%s' failed unmount", shareId))
+		}
+
+		if err := sg.serverStorage.DeleteFileSystem(ctx, fs.fsApi); err != nil {
+			return ec.NewErrInternalServerError().WithResourceType(FileShareOdataType).WithError(err).WithCause(fmt.Sprintf("File share '%s' failed delete", shareId))
+		}
+
+		return nil
+	}, nil
+}
+
 // StorageServiceIdFileSystemIdExportedShareIdDelete -
-func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdDelete(storageServiceId, fileSystemId, exportedShareId string) (err error) {
+func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdDelete(ctx context.Context, storageServiceId, fileSystemId, exportedShareId string) (err error) {
 	s, fs, sh := findFileShare(storageServiceId, fileSystemId, exportedShareId)
 
 	if sh == nil {
@@ -1751,24 +2097,12 @@ func (*StorageService) StorageServiceIdFileSystemIdExportedShareIdDelete(storage
 		}
 	}()
 
-	sg := s.findStorageGroup(sh.storageGroupId)
-	if sg == nil {
-		return ec.NewErrInternalServerError().WithResourceType(FileShareOdataType).WithCause(fmt.Sprintf("File share '%s' does not have associated storage group '%s'", exportedShareId, sh.storageGroupId))
-	}
-
-	deleteFunc := func() error {
-		if err := sg.serverStorage.UnmountFileSystem(fs.fsApi, sh.mountRoot); err != nil {
-			return ec.NewErrInternalServerError().WithResourceType(FileShareOdataType).WithError(err).WithCause(fmt.Sprintf("File share '%s' failed unmount", exportedShareId))
-		}
-
-		if err := sg.serverStorage.DeleteFileSystem(fs.fsApi); err != nil {
-			return ec.NewErrInternalServerError().WithResourceType(FileShareOdataType).WithError(err).WithCause(fmt.Sprintf("File share '%s' failed delete", exportedShareId))
-		}
-
-		return nil
+	deleteFunc, err := s.fileShareDeleteFunc(ctx, fs, sh)
+	if err != nil {
+		return err
 	}
 
-	if err := s.persistentController.DeletePersistentObject(sh, deleteFunc, fileShareDeleteStartLogEntryType, fileShareDeleteCompleteLogEntryType); err != nil {
+	if err := s.persistentController.DeletePersistentObject(ctx, sh, deleteFunc, fileShareDeleteStartLogEntryType, fileShareDeleteCompleteLogEntryType); err != nil {
 		return ec.NewErrInternalServerError().WithError(err).WithResourceType(FileShareOdataType).WithCause("Failed to delete file share")
 	}
 
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package nnf
+
+import (
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+)
+
+// Metrics - The storage service reports the number of each resource it manages, from the service
+// summary, and the size of the persistent store, as last computed by the store itself.
+
+func (s *StorageService) metrics() []ec.MetricFamily {
+	summary := s.currentSummary()
+
+	gauge := func(name, help string, value float64) ec.MetricFamily {
+		return ec.MetricFamily{Name: name, Help: help, Type: ec.GaugeMetricType, Samples: []ec.MetricSample{{Value: value}}}
+	}
+
+	lsm, vlog := s.store.Size()
+
+	return []ec.MetricFamily{
+		gauge("nnf_ec_storage_pools", "Storage pools", float64(summary.pools)),
+		gauge("nnf_ec_storage_groups", "Storage groups", float64(summary.groups)),
+		gauge("nnf_ec_file_systems", "File systems", float64(summary.fileSystems)),
+		gauge("nnf_ec_file_shares", "File shares", float64(s.shares.Load())),
+		{
+			Name: "nnf_ec_kvstore_size_bytes",
+			Help: "Size of the persistent store on disk",
+			Type: ec.GaugeMetricType,
+			Samples: []ec.MetricSample{
+				{Labels: ec.MetricLabels{"type": "lsm"}, Value: float64(lsm)},
+				{Labels: ec.MetricLabels{"type": "vlog"}, Value: float64(vlog)},
+			},
+		},
+	}
+}
@@ -20,16 +20,46 @@
 package nnf
 
 import (
+	"context"
 	logr "github.com/sirupsen/logrus"
 
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
 	"github.com/NearNodeFlash/nnf-ec/pkg/persistent"
 )
 
 // Persistent Controller API provides an interface for creating, updating, and deleting persistent objects.
 type PersistentControllerApi interface {
-	CreatePersistentObject(obj PersistentObjectApi, updateFunc func() error, startingState, endingState uint32) error
-	UpdatePersistentObject(obj PersistentObjectApi, updateFunc func() error, startingState, endingState uint32) error
-	DeletePersistentObject(obj PersistentObjectApi, deleteFunc func() error, startingState, endingState uint32) error
+	CreatePersistentObject(ctx context.Context, obj PersistentObjectApi, updateFunc func() error, startingState, endingState uint32) error
+	UpdatePersistentObject(ctx context.Context, obj PersistentObjectApi, updateFunc func() error, startingState, endingState uint32) error
+	DeletePersistentObject(ctx context.Context, obj PersistentObjectApi, deleteFunc func() error, startingState, endingState uint32) error
+
+	// ExecutePersistentObjectOperations runs the operations, in order, as a single operation spanning several
+	// persistent objects. The starting states of every object are recorded in one atomic transaction before the
+	// operations run, and the ending states in another after they complete. Should an operation fail, the objects
+	// of the operations that completed are recorded in their ending states and the failed operation is rolled back;
+	// the objects of the operations that did not run are left in their starting states. An operation that depends
+	// on others must therefore follow them. The number of completed operations is returned.
+	ExecutePersistentObjectOperations(ctx context.Context, ops []PersistentObjectOperation) (int, error)
+}
+
+// Persistent Object Action is the action taken on a persistent object as part of a multi-object operation
+type PersistentObjectAction int
+
+const (
+	CreatePersistentObjectAction PersistentObjectAction = iota
+	UpdatePersistentObjectAction
+	DeletePersistentObjectAction
+)
+
+// Persistent Object Operation describes a persistent object participating in a multi-object operation
+type PersistentObjectOperation struct {
+	Object        PersistentObjectApi
+	Action        PersistentObjectAction
+	StartingState uint32
+	EndingState   uint32
+
+	// Func performs the operation on the object; it may be nil if the object has nothing to do
+	Func func() error
 }
 
 type DefaultPersistentController struct{}
@@ -59,10 +89,23 @@ type PersistentObjectApi interface {
 
 	// Rollback occurs when a call to CreatePersistentObject or UpdatePersistentObject fails. We rollback to the
 	// starting state.
-	Rollback(startingState uint32) error
+	Rollback(ctx context.Context, startingState uint32) error
 }
 
-func (*DefaultPersistentController) CreatePersistentObject(obj PersistentObjectApi, updateFunc func() error, startingState, endingState uint32) error {
+// setResourceState reports the persisted state of the object to the request reading it, so the object's ETag
+// changes only when its ledger does.
+func setResourceState(ctx context.Context, obj PersistentObjectApi) {
+	store := obj.GetProvider().GetStore()
+	if !store.IsOpen() {
+		return
+	}
+
+	if state, err := store.Value(obj.GetKey()); err == nil {
+		ec.SetResourceState(ctx, state)
+	}
+}
+
+func (*DefaultPersistentController) CreatePersistentObject(ctx context.Context, obj PersistentObjectApi, updateFunc func() error, startingState, endingState uint32) error {
 
 	metadata, err := obj.GenerateMetadata()
 	if err != nil {
@@ -74,7 +117,7 @@ func (*DefaultPersistentController) CreatePersistentObject(obj PersistentObjectA
 		return err
 	}
 
-	err = executePersistentObjectTransaction(ledger, obj, updateFunc, startingState, endingState)
+	err = executePersistentObjectTransaction(ctx, ledger, obj, updateFunc, startingState, endingState)
 
 	if closeErr := ledger.Close(err != nil); closeErr != nil {
 		// If the ledger fails to close we have lost the state of the resource and our only choice is to panic;
@@ -84,7 +127,7 @@ func (*DefaultPersistentController) CreatePersistentObject(obj PersistentObjectA
 	return err
 }
 
-func (*DefaultPersistentController) UpdatePersistentObject(obj PersistentObjectApi, updateFunc func() error, startingState, endingState uint32) error {
+func (*DefaultPersistentController) UpdatePersistentObject(ctx context.Context, obj PersistentObjectApi, updateFunc func() error, startingState, endingState uint32) error {
 
 	ledger, err := obj.GetProvider().GetStore().OpenKey(obj.GetKey())
 	if err != nil {
@@ -92,24 +135,24 @@ func (*DefaultPersistentController) UpdatePersistentObject(obj PersistentObjectA
 	}
 	defer ledger.Close(false)
 
-	return executePersistentObjectTransaction(ledger, obj, updateFunc, startingState, endingState)
+	return executePersistentObjectTransaction(ctx, ledger, obj, updateFunc, startingState, endingState)
 }
 
-func (*DefaultPersistentController) DeletePersistentObject(obj PersistentObjectApi, deleteFunc func() error, startingState, endingState uint32) error {
+func (*DefaultPersistentController) DeletePersistentObject(ctx context.Context, obj PersistentObjectApi, deleteFunc func() error, startingState, endingState uint32) error {
 
 	ledger, err := obj.GetProvider().GetStore().OpenKey(obj.GetKey())
 	if err != nil {
 		return err
 	}
 
-	if err := executePersistentObjectTransaction(ledger, obj, deleteFunc, startingState, endingState); err != nil {
+	if err := executePersistentObjectTransaction(ctx, ledger, obj, deleteFunc, startingState, endingState); err != nil {
 		return err
 	}
 
 	return ledger.Close(true)
 }
 
-func executePersistentObjectTransaction(ledger *persistent.Ledger, obj PersistentObjectApi, updateFunc func() error, startingState, endingState uint32) error {
+func executePersistentObjectTransaction(ctx context.Context, ledger *persistent.Ledger, obj PersistentObjectApi, updateFunc func() error, startingState, endingState uint32) error {
 
 	data, err := obj.GenerateStateData(startingState)
 	if err != nil {
@@ -125,7 +168,7 @@ func executePersistentObjectTransaction(ledger *persistent.Ledger, obj Persisten
 	if err := updateFunc(); err != nil {
 		logr.WithError(err).Warnf("Object %s failed update to state %d", obj.GetKey(), startingState)
 
-		if rollbackErr := obj.Rollback(startingState); rollbackErr != nil {
+		if rollbackErr := obj.Rollback(ctx, startingState); rollbackErr != nil {
 			logr.WithError(rollbackErr).Errorf("Object %s failed rollback to state %d", obj.GetKey(), startingState)
 		}
 
@@ -146,20 +189,146 @@ func executePersistentObjectTransaction(ledger *persistent.Ledger, obj Persisten
 	return nil
 }
 
+func (*DefaultPersistentController) ExecutePersistentObjectOperations(ctx context.Context, ops []PersistentObjectOperation) (int, error) {
+	if len(ops) == 0 {
+		return 0, nil
+	}
+
+	// All objects of an operation are expected to share the same store
+	store := ops[0].Object.GetProvider().GetStore()
+
+	txn := store.NewTransaction()
+	for _, op := range ops {
+		var ledger *persistent.Ledger
+		var err error
+
+		if op.Action == CreatePersistentObjectAction {
+			var metadata []byte
+			if metadata, err = op.Object.GenerateMetadata(); err == nil {
+				ledger, err = txn.NewKey(op.Object.GetKey(), metadata)
+			}
+		} else {
+			ledger, err = txn.OpenKey(op.Object.GetKey())
+		}
+
+		if err == nil {
+			err = logPersistentObjectState(ledger, op.Object, op.StartingState)
+		}
+
+		if err != nil {
+			txn.Discard()
+			return 0, err
+		}
+	}
+
+	if err := txn.Commit(); err != nil {
+		logr.WithError(err).Warnf("Failed to commit starting states of %d objects", len(ops))
+		return 0, err
+	}
+
+	completed := 0
+	var err error
+	for _, op := range ops {
+		if op.Func != nil {
+			if err = op.Func(); err != nil {
+				logr.WithError(err).Warnf("Object %s failed update to state %d", op.Object.GetKey(), op.EndingState)
+				break
+			}
+		}
+
+		completed++
+	}
+
+	// The completed operations are recorded in their ending states and the remaining are rolled back in a
+	// single transaction.
+	txn = store.NewTransaction()
+	for _, op := range ops[:completed] {
+		ledger, logErr := txn.OpenKey(op.Object.GetKey())
+		if logErr == nil {
+			logErr = logPersistentObjectState(ledger, op.Object, op.EndingState)
+		}
+
+		if logErr != nil {
+			txn.Discard()
+			return completed, logErr
+		}
+
+		if op.Action == DeletePersistentObjectAction {
+			ledger.Close(true)
+		}
+	}
+
+	for idx, op := range ops[completed:] {
+		// The failed operation is rolled back, as are the objects of operations that did not run and were
+		// to be created; the objects of the other operations that did not run are unchanged.
+		if idx == 0 || op.Action == CreatePersistentObjectAction {
+			if rollbackErr := op.Object.Rollback(ctx, op.StartingState); rollbackErr != nil {
+				logr.WithError(rollbackErr).Errorf("Object %s failed rollback to state %d", op.Object.GetKey(), op.StartingState)
+			}
+		}
+
+		// Newly created objects are removed the same as a failed CreatePersistentObject
+		if op.Action == CreatePersistentObjectAction {
+			if ledger, openErr := txn.OpenKey(op.Object.GetKey()); openErr == nil {
+				ledger.Close(true)
+			}
+		}
+	}
+
+	if commitErr := txn.Commit(); commitErr != nil {
+		if err == nil {
+			logr.WithError(commitErr).Warnf("Failed to commit ending states of %d objects", len(ops))
+			return completed, commitErr
+		}
+
+		// If the ledgers fail to close we have lost the state of the resources and our only choice is to panic;
+		panic(commitErr)
+	}
+
+	return completed, err
+}
+
+func logPersistentObjectState(ledger *persistent.Ledger, obj PersistentObjectApi, state uint32) error {
+	data, err := obj.GenerateStateData(state)
+	if err != nil {
+		logr.WithError(err).Warnf("Object %s failed to generate state %d data", obj.GetKey(), state)
+		return err
+	}
+
+	if err := ledger.Log(state, data); err != nil {
+		logr.WithError(err).Warnf("Object %s failed to log state %d", obj.GetKey(), state)
+		return err
+	}
+
+	return nil
+}
+
 type MockPersistentController struct{}
 
 func NewMockPersistentController() PersistentControllerApi {
 	return &MockPersistentController{}
 }
 
-func (*MockPersistentController) CreatePersistentObject(obj PersistentObjectApi, createFunc func() error, startingState, endingState uint32) error {
+func (*MockPersistentController) CreatePersistentObject(ctx context.Context, obj PersistentObjectApi, createFunc func() error, startingState, endingState uint32) error {
 	return createFunc()
 }
 
-func (*MockPersistentController) UpdatePersistentObject(obj PersistentObjectApi, updateFunc func() error, startingState, endingState uint32) error {
+func (*MockPersistentController) UpdatePersistentObject(ctx context.Context, obj PersistentObjectApi, updateFunc func() error, startingState, endingState uint32) error {
 	return updateFunc()
 }
 
-func (*MockPersistentController) DeletePersistentObject(obj PersistentObjectApi, deleteFunc func() error, startingState, endingState uint32) error {
+func (*MockPersistentController) DeletePersistentObject(ctx context.Context, obj PersistentObjectApi, deleteFunc func() error, startingState, endingState uint32) error {
 	return deleteFunc()
 }
+
+func (*MockPersistentController) ExecutePersistentObjectOperations(ctx context.Context, ops []PersistentObjectOperation) (int, error) {
+	for completed, op := range ops {
+		if op.Func != nil {
+			if err := op.Func(); err != nil {
+				return completed, err
+			}
+		}
+	}
+
+	return len(ops), nil
+}
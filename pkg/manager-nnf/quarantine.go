@@ -0,0 +1,321 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nnf
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NearNodeFlash/nnf-ec/pkg/common"
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	event "github.com/NearNodeFlash/nnf-ec/pkg/manager-event"
+	msgreg "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry/registries"
+	nvme "github.com/NearNodeFlash/nnf-ec/pkg/manager-nvme"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// QuarantinedVolume describes a volume that is not owned by any storage pool. Quarantined volumes are
+// detached from every controller and kept until an operator deletes or adopts them.
+type QuarantinedVolume struct {
+	OdataId   string `json:"@odata.id"`
+	OdataType string `json:"@odata.type"`
+	Id        string `json:"Id"`
+	Name      string `json:"Name"`
+
+	Volume        sf.OdataV4IdRef `json:"Volume"`
+	SerialNumber  string          `json:"SerialNumber"`
+	NamespaceId   string          `json:"NamespaceId"`
+	CapacityBytes uint64          `json:"CapacityBytes"`
+
+	// The RBBT metadata found on the namespace, if any.
+	Metadata *QuarantinedVolumeMetadata `json:"Metadata,omitempty"`
+}
+
+// QuarantinedVolumeMetadata is the storage pool metadata recorded on a quarantined namespace
+type QuarantinedVolumeMetadata struct {
+	StoragePoolId string `json:"StoragePoolId"`
+	Index         uint16 `json:"Index"`
+	Count         uint16 `json:"Count"`
+}
+
+// QuarantinedVolumeCollection is the collection of all quarantined volumes
+type QuarantinedVolumeCollection struct {
+	OdataId           string            `json:"@odata.id"`
+	OdataType         string            `json:"@odata.type"`
+	Name              string            `json:"Name"`
+	MembersOdataCount int64             `json:"Members@odata.count"`
+	Members           []sf.OdataV4IdRef `json:"Members"`
+}
+
+const (
+	QuarantinedVolumesId                 = "QuarantinedVolumes"
+	QuarantinedVolumeOdataType           = "#NnfQuarantinedVolume.v1_0_0.NnfQuarantinedVolume"
+	QuarantinedVolumeCollectionOdataType = "#NnfQuarantinedVolumeCollection.NnfQuarantinedVolumeCollection"
+)
+
+type quarantinedVolume struct {
+	id       string
+	storage  *nvme.Storage
+	volumeId string
+
+	// Namespace metadata read from the volume; nil if the volume has no valid metadata
+	metadata *common.NamespaceMetadata
+
+	storageService *StorageService
+}
+
+func (qv *quarantinedVolume) OdataId() string {
+	return qv.storageService.quarantinedVolumesOdataId() + "/" + qv.id
+}
+
+func (s *StorageService) quarantinedVolumesOdataId() string {
+	return s.OdataId() + "/Oem/" + QuarantinedVolumesId
+}
+
+// findQuarantinedVolume returns the quarantined volume with the provided id. The caller must hold the
+// service lock.
+func (s *StorageService) findQuarantinedVolume(id string) *quarantinedVolume {
+	for qvIdx := range s.quarantinedVolumes {
+		if s.quarantinedVolumes[qvIdx].id == id {
+			return &s.quarantinedVolumes[qvIdx]
+		}
+	}
+
+	return nil
+}
+
+func findQuarantinedVolume(storageServiceId, quarantinedVolumeId string) (*StorageService, *quarantinedVolume) {
+	s := findStorageService(storageServiceId)
+	if s == nil {
+		return nil, nil
+	}
+
+	return s, s.findQuarantinedVolume(quarantinedVolumeId)
+}
+
+// deleteQuarantinedVolume removes the volume from quarantine. The caller must hold the service lock.
+func (s *StorageService) deleteQuarantinedVolume(qv *quarantinedVolume) {
+	for qvIdx := range s.quarantinedVolumes {
+		if s.quarantinedVolumes[qvIdx].id == qv.id {
+			s.quarantinedVolumes = append(s.quarantinedVolumes[:qvIdx], s.quarantinedVolumes[qvIdx+1:]...)
+			return
+		}
+	}
+}
+
+// quarantinedVolumeMetadata returns the namespace metadata recorded when the volume was quarantined, or nil
+// if the volume is not quarantined or has no valid metadata.
+func (s *StorageService) quarantinedVolumeMetadata(pv nvme.ProvidingVolume) *common.NamespaceMetadata {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for qvIdx := range s.quarantinedVolumes {
+		qv := &s.quarantinedVolumes[qvIdx]
+		if qv.storage == pv.Storage && qv.volumeId == pv.VolumeId {
+			return qv.metadata
+		}
+	}
+
+	return nil
+}
+
+// pruneQuarantinedVolumes removes the quarantined volumes whose namespace no longer exists, such as one
+// deleted outside the element controller.
+func (s *StorageService) pruneQuarantinedVolumes() {
+	// The volumes are found with the service unlocked, as finding a volume waits on its storage
+	s.mutex.RLock()
+	quarantinedVolumes := append([]quarantinedVolume(nil), s.quarantinedVolumes...)
+	s.mutex.RUnlock()
+
+	for qvIdx := range quarantinedVolumes {
+		qv := &quarantinedVolumes[qvIdx]
+		if qv.storage.FindVolume(qv.volumeId) != nil {
+			continue
+		}
+
+		s.log.Info("Quarantined volume no longer present", "quarantinedVolumeId", qv.id)
+		s.mutex.Lock()
+		s.deleteQuarantinedVolume(qv)
+		s.mutex.Unlock()
+	}
+}
+
+// releaseQuarantinedVolume removes the volume from quarantine, if present. This is called when a quarantined
+// volume is adopted into a storage pool.
+func (s *StorageService) releaseQuarantinedVolume(pv nvme.ProvidingVolume) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for qvIdx := range s.quarantinedVolumes {
+		qv := &s.quarantinedVolumes[qvIdx]
+		if qv.storage == pv.Storage && qv.volumeId == pv.VolumeId {
+			s.log.Info("Quarantined volume released", "quarantinedVolumeId", qv.id)
+			s.deleteQuarantinedVolume(qv)
+			return
+		}
+	}
+}
+
+// quarantineVolumes detaches every namespace that is not part of a storage pool from all controllers and
+// records it as a quarantined volume. This is the non-destructive alternative to cleanupVolumes.
+func (s *StorageService) quarantineVolumes(ctx context.Context) {
+	log := s.log.WithName("quarantine")
+
+	var providingVolumes []nvme.ProvidingVolume
+	s.mutex.RLock()
+	for _, p := range s.pools {
+		providingVolumes = append(providingVolumes, p.providingVolumes...)
+	}
+	s.mutex.RUnlock()
+
+	for _, uv := range nvme.FindUnknownVolumes(providingVolumes) {
+		volume := uv.Storage.FindVolume(uv.VolumeId)
+		if volume == nil {
+			continue
+		}
+
+		serialNumber := uv.Storage.SerialNumber()
+		nsid := strconv.FormatUint(uint64(volume.GetNamespaceId()), 10)
+		id := fmt.Sprintf("%s-%s", strings.TrimSpace(serialNumber), nsid)
+
+		s.mutex.RLock()
+		quarantined := s.findQuarantinedVolume(id) != nil
+		s.mutex.RUnlock()
+
+		if quarantined {
+			continue
+		}
+
+		log := log.WithValues("serialNumber", serialNumber, "namespaceId", nsid)
+
+		qv := quarantinedVolume{
+			id:             id,
+			storage:        uv.Storage,
+			volumeId:       uv.VolumeId,
+			storageService: s,
+		}
+
+		// Read the metadata before detaching; reading the feature attaches and detaches the
+		// physical function controller.
+		if data, err := volume.GetFeature(ctx); err != nil {
+			log.Error(err, "Failed to read namespace metadata")
+		} else if len(data) != 0 {
+			if md, err := common.DecodeNamespaceMetadata(data); err == nil {
+				qv.metadata = md
+			} else {
+				log.V(2).Info("Namespace metadata not recognized", "error", err)
+			}
+		}
+
+		if err := volume.DetachAllControllers(ctx); err != nil {
+			log.Error(err, "Failed to detach quarantined volume")
+		}
+
+		log.Info("Volume quarantined")
+		s.mutex.Lock()
+		s.quarantinedVolumes = append(s.quarantinedVolumes, qv)
+		s.mutex.Unlock()
+
+		event.EventManager.Publish(msgreg.VolumeQuarantinedNnf(serialNumber, nsid))
+	}
+}
+
+// StorageServiceIdQuarantinedVolumesGet -
+func (*StorageService) StorageServiceIdQuarantinedVolumesGet(ctx context.Context, storageServiceId string, model *QuarantinedVolumeCollection) error {
+	s := findStorageService(storageServiceId)
+	if s == nil {
+		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(StorageServiceOdataType, storageServiceId))
+	}
+
+	model.MembersOdataCount = int64(len(s.quarantinedVolumes))
+	model.Members = make([]sf.OdataV4IdRef, model.MembersOdataCount)
+	for qvIdx := range s.quarantinedVolumes {
+		model.Members[qvIdx] = sf.OdataV4IdRef{OdataId: s.quarantinedVolumes[qvIdx].OdataId()}
+	}
+
+	return nil
+}
+
+// StorageServiceIdQuarantinedVolumeIdGet -
+func (*StorageService) StorageServiceIdQuarantinedVolumeIdGet(ctx context.Context, storageServiceId, quarantinedVolumeId string, model *QuarantinedVolume) error {
+	_, qv := findQuarantinedVolume(storageServiceId, quarantinedVolumeId)
+	if qv == nil {
+		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(QuarantinedVolumeOdataType, quarantinedVolumeId))
+	}
+
+	// A volume deleted outside the element controller is removed from quarantine by the next audit
+	volume := qv.storage.FindVolume(qv.volumeId)
+	if volume == nil {
+		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(QuarantinedVolumeOdataType, quarantinedVolumeId))
+	}
+
+	model.Id = qv.id
+	model.OdataId = qv.OdataId()
+	model.Volume = sf.OdataV4IdRef{OdataId: volume.GetOdataId()}
+	model.SerialNumber = qv.storage.SerialNumber()
+	model.NamespaceId = strconv.FormatUint(uint64(volume.GetNamespaceId()), 10)
+	model.CapacityBytes = volume.GetCapacityBytes()
+
+	if qv.metadata != nil {
+		model.Metadata = &QuarantinedVolumeMetadata{
+			StoragePoolId: qv.metadata.Id.String(),
+			Index:         qv.metadata.Index,
+			Count:         qv.metadata.Count,
+		}
+	}
+
+	return nil
+}
+
+// StorageServiceIdQuarantinedVolumeIdDelete destroys the quarantined namespace. The service is locked only
+// to find and remove the entry; the namespace is deleted with the service unlocked.
+func (*StorageService) StorageServiceIdQuarantinedVolumeIdDelete(ctx context.Context, storageServiceId, quarantinedVolumeId string) error {
+	s := findStorageService(storageServiceId)
+	if s == nil {
+		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(QuarantinedVolumeOdataType, quarantinedVolumeId))
+	}
+
+	s.mutex.RLock()
+	qv := s.findQuarantinedVolume(quarantinedVolumeId)
+	if qv != nil {
+		snapshot := *qv
+		qv = &snapshot
+	}
+	s.mutex.RUnlock()
+
+	if qv == nil {
+		return ec.NewErrNotFound().WithEvent(msgreg.ResourceNotFoundBase(QuarantinedVolumeOdataType, quarantinedVolumeId))
+	}
+
+	if volume := qv.storage.FindVolume(qv.volumeId); volume != nil {
+		if err := volume.Delete(ctx); err != nil {
+			return ec.NewErrInternalServerError().WithError(err).WithCause(fmt.Sprintf("Failed to delete quarantined volume '%s'", qv.id))
+		}
+	}
+
+	s.log.Info("Quarantined volume deleted", "quarantinedVolumeId", qv.id)
+	s.mutex.Lock()
+	s.deleteQuarantinedVolume(qv)
+	s.mutex.Unlock()
+
+	return nil
+}
@@ -21,6 +21,7 @@ package nnf
 
 import (
 	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
 )
 
 type DefaultApiRouter struct {
@@ -48,6 +49,18 @@ func (r *DefaultApiRouter) Close() error {
 	return r.servicer.Close()
 }
 
+func (r *DefaultApiRouter) Ready() ec.HealthStatus {
+	return storageService.ready()
+}
+
+func (r *DefaultApiRouter) Live() ec.HealthStatus {
+	return storageService.live()
+}
+
+func (r *DefaultApiRouter) Metrics() []ec.MetricFamily {
+	return storageService.metrics()
+}
+
 func (r *DefaultApiRouter) Routes() ec.Routes {
 	s := r.servicer
 	return ec.Routes{
@@ -56,12 +69,14 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices",
 			HandlerFunc: s.RedfishV1StorageServicesGet,
+			Response:    sf.StorageServiceCollectionStorageServiceCollection{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdGet,
+			Response:    sf.StorageServiceV150StorageService{},
 		},
 
 		/* -------------------- STORAGE SERVICE CAPACITY ------------------- */
@@ -71,6 +86,65 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/CapacitySource",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdCapacitySourceGet,
+			Response:    sf.CapacityCapacitySource{},
+		},
+
+		/* ---------------------- STORAGE SERVICE AUDIT -------------------- */
+
+		{
+			Name:        "RedfishV1StorageServicesStorageServiceIdOemAuditGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/Oem/Audit",
+			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdOemAuditGet,
+			Response:    AuditReport{},
+		},
+		{
+			Name:        "RedfishV1StorageServicesStorageServiceIdOemAuditPost",
+			Method:      ec.POST_METHOD,
+			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/Oem/Audit",
+			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdOemAuditPost,
+			Response:    AuditReport{},
+		},
+
+		/* ---------------------- QUARANTINED VOLUMES ---------------------- */
+
+		{
+			Name:        "RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/Oem/QuarantinedVolumes",
+			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesGet,
+			Response:    QuarantinedVolumeCollection{},
+		},
+		{
+			Name:        "RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesQuarantinedVolumeIdGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/Oem/QuarantinedVolumes/{QuarantinedVolumeId}",
+			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesQuarantinedVolumeIdGet,
+			Response:    QuarantinedVolume{},
+		},
+		{
+			Name:        "RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesQuarantinedVolumeIdDelete",
+			Method:      ec.DELETE_METHOD,
+			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/Oem/QuarantinedVolumes/{QuarantinedVolumeId}",
+			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesQuarantinedVolumeIdDelete,
+			Response:    QuarantinedVolume{},
+		},
+
+		/* -------------------- INTERRUPTED OPERATIONS --------------------- */
+
+		{
+			Name:        "RedfishV1StorageServicesStorageServiceIdOemInterruptedOperationsGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/Oem/InterruptedOperations",
+			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdOemInterruptedOperationsGet,
+			Response:    InterruptedOperationCollection{},
+		},
+		{
+			Name:        "RedfishV1StorageServicesStorageServiceIdOemInterruptedOperationsInterruptedOperationIdGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/Oem/InterruptedOperations/{InterruptedOperationId}",
+			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdOemInterruptedOperationsInterruptedOperationIdGet,
+			Response:    InterruptedOperation{},
 		},
 
 		/* ------------------------- STORAGE POOLS ------------------------- */
@@ -80,30 +154,38 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StoragePools",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStoragePoolsGet,
+			Response:    sf.StoragePoolCollectionStoragePoolCollection{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdStoragePoolsPost",
 			Method:      ec.POST_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StoragePools",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStoragePoolsPost,
+			Response:    sf.StoragePoolV150StoragePool{},
+			Model:       sf.StoragePoolV150StoragePool{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdStoragePoolsPatch",
 			Method:      ec.PATCH_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StoragePools",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStoragePoolsPatch,
+			Response:    sf.StoragePoolCollectionStoragePoolCollection{},
+			Model:       sf.StoragePoolCollectionStoragePoolCollection{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdPut",
 			Method:      ec.PUT_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StoragePools/{StoragePoolId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdPut,
+			Response:    sf.StoragePoolV150StoragePool{},
+			Model:       sf.StoragePoolV150StoragePool{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StoragePools/{StoragePoolId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdGet,
+			Response:    sf.StoragePoolV150StoragePool{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdDelete",
@@ -116,36 +198,42 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.PATCH_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StoragePools/{StoragePoolId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdPatch,
+			Response:    sf.StoragePoolV150StoragePool{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdCapacitySourcesGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StoragePools/{StoragePoolId}/CapacitySources",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdCapacitySourcesGet,
+			Response:    sf.CapacitySourceCollectionCapacitySourceCollection{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdCapacitySourcesCapacitySourceIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StoragePools/{StoragePoolId}/CapacitySources/{CapacitySourceId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdCapacitySourcesCapacitySourceIdGet,
+			Response:    sf.CapacityCapacitySource{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdCapacitySourcesCapacitySourceIdProvidingVolumesGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StoragePools/{StoragePoolId}/CapacitySources/{CapacitySourceId}/ProvidingVolumes",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdCapacitySourcesCapacitySourceIdProvidingVolumesGet,
+			Response:    sf.VolumeCollectionVolumeCollection{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdAllocatedVolumesGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StoragePools/{StoragePoolId}/AllocatedVolumes",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdAllocatedVolumesGet,
+			Response:    sf.VolumeCollectionVolumeCollection{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdAllocatedVolumesVolumeIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StoragePools/{StoragePoolId}/AllocatedVolumes/{VolumeId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdAllocatedVolumesVolumeIdGet,
+			Response:    sf.VolumeV161Volume{},
 		},
 
 		/* ------------------------- STORAGE GROUPS ------------------------ */
@@ -155,30 +243,38 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StorageGroups",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStorageGroupsGet,
+			Response:    sf.StorageGroupCollectionStorageGroupCollection{},
 		},
 		{
-			Name:        "RedfishV1StorageServicesStorageServiceIdStorageGroupsPost",
-			Method:      ec.POST_METHOD,
-			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StorageGroups",
-			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStorageGroupsPost,
+			Name:               "RedfishV1StorageServicesStorageServiceIdStorageGroupsPost",
+			Method:             ec.POST_METHOD,
+			Path:               "/redfish/v1/StorageServices/{StorageServiceId}/StorageGroups",
+			HandlerFunc:        s.RedfishV1StorageServicesStorageServiceIdStorageGroupsPost,
+			Response:           sf.StorageGroupV150StorageGroup{},
+			Model:              sf.StorageGroupV150StorageGroup{},
+			RequiredProperties: []string{"Links/StoragePool", "Links/ServerEndpoint"},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdStorageGroupsStorageGroupIdPut",
 			Method:      ec.PUT_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StorageGroups/{StorageGroupId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStorageGroupsStorageGroupIdPut,
+			Response:    sf.StorageGroupV150StorageGroup{},
+			Model:       sf.StorageGroupV150StorageGroup{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdStorageGroupsStorageGroupIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StorageGroups/{StorageGroupId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStorageGroupsStorageGroupIdGet,
+			Response:    sf.StorageGroupV150StorageGroup{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdStorageGroupsStorageGroupIdDelete",
 			Method:      ec.DELETE_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/StorageGroups/{StorageGroupId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdStorageGroupsStorageGroupIdDelete,
+			Response:    sf.StorageGroupV150StorageGroup{},
 		},
 
 		/* --------------------------- ENDPOINTS --------------------------- */
@@ -188,6 +284,7 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/Endpoints",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdEndpointsGet,
+			Response:    sf.EndpointCollectionEndpointCollection{},
 		},
 
 		{
@@ -195,6 +292,7 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/Endpoints/{EndpointId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdEndpointsEndpointIdGet,
+			Response:    sf.EndpointV150Endpoint{},
 		},
 
 		/* -------------------------- FILE SYSTEMS ------------------------- */
@@ -204,24 +302,31 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/FileSystems",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdFileSystemsGet,
+			Response:    sf.FileSystemCollectionFileSystemCollection{},
 		},
 		{
-			Name:        "RedfishV1StorageServicesStorageServiceIdFileSystemsPost",
-			Method:      ec.POST_METHOD,
-			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/FileSystems",
-			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdFileSystemsPost,
+			Name:               "RedfishV1StorageServicesStorageServiceIdFileSystemsPost",
+			Method:             ec.POST_METHOD,
+			Path:               "/redfish/v1/StorageServices/{StorageServiceId}/FileSystems",
+			HandlerFunc:        s.RedfishV1StorageServicesStorageServiceIdFileSystemsPost,
+			Response:           sf.FileSystemV122FileSystem{},
+			Model:              sf.FileSystemV122FileSystem{},
+			RequiredProperties: []string{"Links/StoragePool"},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemIdPut",
 			Method:      ec.PUT_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/FileSystems/{FileSystemId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemIdPut,
+			Response:    sf.FileSystemV122FileSystem{},
+			Model:       sf.FileSystemV122FileSystem{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/FileSystems/{FileSystemId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemIdGet,
+			Response:    sf.FileSystemV122FileSystem{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemIdDelete",
@@ -237,30 +342,38 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/FileSystems/{FileSystemsId}/ExportedFileShares",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemsIdExportedFileSharesGet,
+			Response:    sf.FileShareCollectionFileShareCollection{},
 		},
 		{
-			Name:        "RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemsIdExportedFileSharesPost",
-			Method:      ec.POST_METHOD,
-			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/FileSystems/{FileSystemsId}/ExportedFileShares",
-			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemsIdExportedFileSharesPost,
+			Name:               "RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemsIdExportedFileSharesPost",
+			Method:             ec.POST_METHOD,
+			Path:               "/redfish/v1/StorageServices/{StorageServiceId}/FileSystems/{FileSystemsId}/ExportedFileShares",
+			HandlerFunc:        s.RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemsIdExportedFileSharesPost,
+			Response:           sf.FileShareV120FileShare{},
+			Model:              sf.FileShareV120FileShare{},
+			RequiredProperties: []string{"Links/Endpoint"},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemsIdExportedFileSharesExportedFileSharesIdPut",
 			Method:      ec.PUT_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/FileSystems/{FileSystemsId}/ExportedFileShares/{ExportedFileSharesId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemsIdExportedFileSharesExportedFileSharesIdPut,
+			Response:    sf.FileShareV120FileShare{},
+			Model:       sf.FileShareV120FileShare{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemsIdExportedFileSharesExportedFileSharesIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/FileSystems/{FileSystemsId}/ExportedFileShares/{ExportedFileSharesId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemsIdExportedFileSharesExportedFileSharesIdGet,
+			Response:    sf.FileShareV120FileShare{},
 		},
 		{
 			Name:        "RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemsIdExportedFileSharesExportedFileSharesIdDelete",
 			Method:      ec.DELETE_METHOD,
 			Path:        "/redfish/v1/StorageServices/{StorageServiceId}/FileSystems/{FileSystemsId}/ExportedFileShares/{ExportedFileSharesId}",
 			HandlerFunc: s.RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemsIdExportedFileSharesExportedFileSharesIdDelete,
+			Response:    sf.FileShareV120FileShare{},
 		},
 	}
 }
@@ -20,6 +20,7 @@
 package nnf
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -71,7 +72,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesGet(w http.ResponseWriter, r
 		Name:      "Storage Service Collection",
 	}
 
-	err := s.ss.StorageServicesGet(&model)
+	err := s.ss.StorageServicesGet(r.Context(), &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -87,7 +88,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdGet(w http.R
 		Name:      "Storage Service",
 	}
 
-	err := s.ss.StorageServiceIdGet(storageServiceId, &model)
+	err := s.ss.StorageServiceIdGet(r.Context(), storageServiceId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -103,7 +104,122 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdCapacitySour
 		Name:      "Capacity Source",
 	}
 
-	err := s.ss.StorageServiceIdCapacitySourceGet(storageServiceId, &model)
+	err := s.ss.StorageServiceIdCapacitySourceGet(r.Context(), storageServiceId, &model)
+
+	EncodeResponse(model, err, w)
+}
+
+// RedfishV1StorageServicesStorageServiceIdOemAuditGet -
+func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdOemAuditGet(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	storageServiceId := params["StorageServiceId"]
+
+	model := AuditReport{
+		OdataId:   fmt.Sprintf("/redfish/v1/StorageServices/%s/Oem/%s", storageServiceId, AuditReportId),
+		OdataType: AuditReportOdataType,
+		Name:      "Storage Service Consistency Audit",
+	}
+
+	err := s.ss.StorageServiceIdAuditGet(r.Context(), storageServiceId, &model)
+
+	EncodeResponse(model, err, w)
+}
+
+// RedfishV1StorageServicesStorageServiceIdOemAuditPost -
+func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdOemAuditPost(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	storageServiceId := params["StorageServiceId"]
+
+	model := AuditReport{
+		OdataId:   fmt.Sprintf("/redfish/v1/StorageServices/%s/Oem/%s", storageServiceId, AuditReportId),
+		OdataType: AuditReportOdataType,
+		Name:      "Storage Service Consistency Audit",
+	}
+
+	err := s.ss.StorageServiceIdAuditPost(r.Context(), storageServiceId, &model)
+
+	EncodeResponse(model, err, w)
+}
+
+// RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesGet -
+func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesGet(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	storageServiceId := params["StorageServiceId"]
+
+	model := QuarantinedVolumeCollection{
+		OdataId:   fmt.Sprintf("/redfish/v1/StorageServices/%s/Oem/%s", storageServiceId, QuarantinedVolumesId),
+		OdataType: QuarantinedVolumeCollectionOdataType,
+		Name:      "Quarantined Volume Collection",
+	}
+
+	err := s.ss.StorageServiceIdQuarantinedVolumesGet(r.Context(), storageServiceId, &model)
+
+	EncodeResponse(model, err, w)
+}
+
+// RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesQuarantinedVolumeIdGet -
+func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesQuarantinedVolumeIdGet(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	storageServiceId := params["StorageServiceId"]
+	quarantinedVolumeId := params["QuarantinedVolumeId"]
+
+	model := QuarantinedVolume{
+		OdataId:   fmt.Sprintf("/redfish/v1/StorageServices/%s/Oem/%s/%s", storageServiceId, QuarantinedVolumesId, quarantinedVolumeId),
+		OdataType: QuarantinedVolumeOdataType,
+		Name:      "Quarantined Volume",
+	}
+
+	err := s.ss.StorageServiceIdQuarantinedVolumeIdGet(r.Context(), storageServiceId, quarantinedVolumeId, &model)
+
+	EncodeResponse(model, err, w)
+}
+
+// RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesQuarantinedVolumeIdDelete -
+func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdOemQuarantinedVolumesQuarantinedVolumeIdDelete(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	storageServiceId := params["StorageServiceId"]
+	quarantinedVolumeId := params["QuarantinedVolumeId"]
+
+	model := QuarantinedVolume{
+		OdataId:   fmt.Sprintf("/redfish/v1/StorageServices/%s/Oem/%s/%s", storageServiceId, QuarantinedVolumesId, quarantinedVolumeId),
+		OdataType: QuarantinedVolumeOdataType,
+		Name:      "Quarantined Volume",
+	}
+
+	err := s.ss.StorageServiceIdQuarantinedVolumeIdDelete(r.Context(), storageServiceId, quarantinedVolumeId)
+
+	EncodeResponse(model, err, w)
+}
+
+// RedfishV1StorageServicesStorageServiceIdOemInterruptedOperationsGet -
+func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdOemInterruptedOperationsGet(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	storageServiceId := params["StorageServiceId"]
+
+	model := InterruptedOperationCollection{
+		OdataId:   fmt.Sprintf("/redfish/v1/StorageServices/%s/Oem/%s", storageServiceId, InterruptedOperationsId),
+		OdataType: InterruptedOperationCollectionOdataType,
+		Name:      "Interrupted Operation Collection",
+	}
+
+	err := s.ss.StorageServiceIdInterruptedOperationsGet(r.Context(), storageServiceId, &model)
+
+	EncodeResponse(model, err, w)
+}
+
+// RedfishV1StorageServicesStorageServiceIdOemInterruptedOperationsInterruptedOperationIdGet -
+func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdOemInterruptedOperationsInterruptedOperationIdGet(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	storageServiceId := params["StorageServiceId"]
+	interruptedOperationId := params["InterruptedOperationId"]
+
+	model := InterruptedOperation{
+		OdataId:   fmt.Sprintf("/redfish/v1/StorageServices/%s/Oem/%s/%s", storageServiceId, InterruptedOperationsId, interruptedOperationId),
+		OdataType: InterruptedOperationOdataType,
+		Name:      "Interrupted Operation",
+	}
+
+	err := s.ss.StorageServiceIdInterruptedOperationIdGet(r.Context(), storageServiceId, interruptedOperationId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -119,7 +235,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStoragePools
 		Name:      "Storage Pool Collection",
 	}
 
-	err := s.ss.StorageServiceIdStoragePoolsGet(storageServiceId, &model)
+	err := s.ss.StorageServiceIdStoragePoolsGet(r.Context(), storageServiceId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -136,15 +252,16 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStoragePools
 		return
 	}
 
-	if err := s.ss.StorageServiceIdStoragePoolsPost(storageServiceId, &model); err != nil {
-		EncodeResponse(model, err, w)
-		return
-	}
+	RunTask(w, r, "Create Storage Pool", func(ctx context.Context) (interface{}, error) {
+		if err := s.ss.StorageServiceIdStoragePoolsPost(ctx, storageServiceId, &model); err != nil {
+			return model, err
+		}
 
-	model.OdataId = fmt.Sprintf("/redfish/v1/StorageServices/%s/StoragePools/%s", storageServiceId, model.Id)
-	model.OdataType = StoragePoolOdataType
+		model.OdataId = fmt.Sprintf("/redfish/v1/StorageServices/%s/StoragePools/%s", storageServiceId, model.Id)
+		model.OdataType = StoragePoolOdataType
 
-	EncodeResponse(model, nil, w)
+		return model, nil
+	})
 }
 
 // RedfishV1StorageServicesStorageServiceIdStoragePoolsPatch -
@@ -159,7 +276,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStoragePools
 		return
 	}
 
-	if err := s.ss.StorageServiceIdStoragePoolsPatch(storageServiceId, &model); err != nil {
+	if err := s.ss.StorageServiceIdStoragePoolsPatch(r.Context(), storageServiceId, &model); err != nil {
 		EncodeResponse(model, err, w)
 		return
 	}
@@ -182,7 +299,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStoragePools
 		Name:      "Storage Pool",
 	}
 
-	err := s.ss.StorageServiceIdStoragePoolIdGet(storageServiceId, storagePoolId, &model)
+	err := s.ss.StorageServiceIdStoragePoolIdGet(r.Context(), storageServiceId, storagePoolId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -199,7 +316,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStoragePools
 		return
 	}
 
-	err := s.ss.StorageServiceIdStoragePoolIdPut(storageServiceId, storagePoolId, &model)
+	err := s.ss.StorageServiceIdStoragePoolIdPut(r.Context(), storageServiceId, storagePoolId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -216,9 +333,9 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStoragePools
 		Name:      "Storage Pool",
 	}
 
-	err := s.ss.StorageServiceIdStoragePoolIdDelete(storageServiceId, storagePoolId)
-
-	EncodeResponse(model, err, w)
+	RunTask(w, r, "Delete Storage Pool", func(ctx context.Context) (interface{}, error) {
+		return model, s.ss.StorageServiceIdStoragePoolIdDelete(ctx, storageServiceId, storagePoolId)
+	})
 }
 
 // RedfishV1StorageServicesStorageServiceIdStoragePoolsStoragePoolIdPatch -
@@ -233,7 +350,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStoragePools
 		Name:      "Storage Pool",
 	}
 
-	err := s.ss.StorageServiceIdStoragePoolIdPatch(storageServiceId, storagePoolId, &model)
+	err := s.ss.StorageServiceIdStoragePoolIdPatch(r.Context(), storageServiceId, storagePoolId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -250,7 +367,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStoragePools
 		Name:      "Capacity Source Collection",
 	}
 
-	err := s.ss.StorageServiceIdStoragePoolIdCapacitySourcesGet(storageServiceId, storagePoolId, &model)
+	err := s.ss.StorageServiceIdStoragePoolIdCapacitySourcesGet(r.Context(), storageServiceId, storagePoolId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -268,7 +385,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStoragePools
 		Name:      "Capacity Source",
 	}
 
-	err := s.ss.StorageServiceIdStoragePoolIdCapacitySourceIdGet(storageServiceId, storagePoolId, capacitySourceId, &model)
+	err := s.ss.StorageServiceIdStoragePoolIdCapacitySourceIdGet(r.Context(), storageServiceId, storagePoolId, capacitySourceId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -286,7 +403,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStoragePools
 		Name:      "Providing Volume Collection",
 	}
 
-	err := s.ss.StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVolumesGet(storageServiceId, storagePoolId, capacitySourceId, &model)
+	err := s.ss.StorageServiceIdStoragePoolIdCapacitySourceIdProvidingVolumesGet(r.Context(), storageServiceId, storagePoolId, capacitySourceId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -303,7 +420,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStoragePools
 		Name:      "Allocated Volume Collection",
 	}
 
-	err := s.ss.StorageServiceIdStoragePoolIdAllocatedVolumesGet(storageServiceId, storagePoolId, &model)
+	err := s.ss.StorageServiceIdStoragePoolIdAllocatedVolumesGet(r.Context(), storageServiceId, storagePoolId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -321,7 +438,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStoragePools
 		Name:      "Volume",
 	}
 
-	err := s.ss.StorageServiceIdStoragePoolIdAllocatedVolumeIdGet(storageServiceId, storagePoolId, volumeId, &model)
+	err := s.ss.StorageServiceIdStoragePoolIdAllocatedVolumeIdGet(r.Context(), storageServiceId, storagePoolId, volumeId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -337,7 +454,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStorageGroup
 		Name:      "Storage Group Collection",
 	}
 
-	err := s.ss.StorageServiceIdStorageGroupsGet(storageServiceId, &model)
+	err := s.ss.StorageServiceIdStorageGroupsGet(r.Context(), storageServiceId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -354,7 +471,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStorageGroup
 		return
 	}
 
-	if err := s.ss.StorageServiceIdStorageGroupPost(storageServiceId, &model); err != nil {
+	if err := s.ss.StorageServiceIdStorageGroupPost(r.Context(), storageServiceId, &model); err != nil {
 		EncodeResponse(model, err, w)
 		return
 	}
@@ -378,7 +495,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStorageGroup
 		return
 	}
 
-	err := s.ss.StorageServiceIdStorageGroupIdPut(storageServiceId, storageGroupId, &model)
+	err := s.ss.StorageServiceIdStorageGroupIdPut(r.Context(), storageServiceId, storageGroupId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -395,7 +512,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStorageGroup
 		Name:      "Storage Group",
 	}
 
-	err := s.ss.StorageServiceIdStorageGroupIdGet(storageServiceId, storageGroupId, &model)
+	err := s.ss.StorageServiceIdStorageGroupIdGet(r.Context(), storageServiceId, storageGroupId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -412,7 +529,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdStorageGroup
 		Name:      "Storage Group",
 	}
 
-	err := s.ss.StorageServiceIdStorageGroupIdDelete(storageServiceId, storageGroupId)
+	err := s.ss.StorageServiceIdStorageGroupIdDelete(r.Context(), storageServiceId, storageGroupId)
 
 	EncodeResponse(model, err, w)
 }
@@ -428,7 +545,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdEndpointsGet
 		Name:      "Endpoint Collection",
 	}
 
-	err := s.ss.StorageServiceIdEndpointsGet(storageServiceId, &model)
+	err := s.ss.StorageServiceIdEndpointsGet(r.Context(), storageServiceId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -445,7 +562,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdEndpointsEnd
 		Name:      "Endpoint",
 	}
 
-	err := s.ss.StorageServiceIdEndpointIdGet(storageServiceId, endpointId, &model)
+	err := s.ss.StorageServiceIdEndpointIdGet(r.Context(), storageServiceId, endpointId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -461,7 +578,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdFileSystemsG
 		Name:      "File System Collection",
 	}
 
-	err := s.ss.StorageServiceIdFileSystemsGet(storageServiceId, &model)
+	err := s.ss.StorageServiceIdFileSystemsGet(r.Context(), storageServiceId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -478,16 +595,17 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdFileSystemsP
 		return
 	}
 
-	if err := s.ss.StorageServiceIdFileSystemsPost(storageServiceId, &model); err != nil {
-		EncodeResponse(nil, err, w)
-		return
-	}
+	RunTask(w, r, "Create File System", func(ctx context.Context) (interface{}, error) {
+		if err := s.ss.StorageServiceIdFileSystemsPost(ctx, storageServiceId, &model); err != nil {
+			return nil, err
+		}
 
-	model.OdataId = fmt.Sprintf("/redfish/v1/StorageServices/%s/FileSystems/%s", storageServiceId, model.Id)
-	model.OdataType = FileSystemOdataType
-	model.Name = "File System"
+		model.OdataId = fmt.Sprintf("/redfish/v1/StorageServices/%s/FileSystems/%s", storageServiceId, model.Id)
+		model.OdataType = FileSystemOdataType
+		model.Name = "File System"
 
-	EncodeResponse(model, nil, w)
+		return model, nil
+	})
 }
 
 // RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemIdPut
@@ -502,7 +620,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdFileSystemsF
 		return
 	}
 
-	err := s.ss.StorageServiceIdFileSystemIdPut(storageServiceId, fileSystemId, &model)
+	err := s.ss.StorageServiceIdFileSystemIdPut(r.Context(), storageServiceId, fileSystemId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -519,7 +637,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdFileSystemsF
 		Name:      "File System",
 	}
 
-	err := s.ss.StorageServiceIdFileSystemIdGet(storageServiceId, fileSystemId, &model)
+	err := s.ss.StorageServiceIdFileSystemIdGet(r.Context(), storageServiceId, fileSystemId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -536,9 +654,9 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdFileSystemsF
 		Name:      "File System",
 	}
 
-	err := s.ss.StorageServiceIdFileSystemIdDelete(storageServiceId, fileSystemId)
-
-	EncodeResponse(model, err, w)
+	RunTask(w, r, "Delete File System", func(ctx context.Context) (interface{}, error) {
+		return model, s.ss.StorageServiceIdFileSystemIdDelete(ctx, storageServiceId, fileSystemId)
+	})
 }
 
 // RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemsIdExportedFileSharesGet -
@@ -553,7 +671,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdFileSystemsF
 		Name:      "File Share Collection",
 	}
 
-	err := s.ss.StorageServiceIdFileSystemIdExportedSharesGet(storageServiceId, fileSystemId, &model)
+	err := s.ss.StorageServiceIdFileSystemIdExportedSharesGet(r.Context(), storageServiceId, fileSystemId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -571,13 +689,15 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdFileSystemsF
 		return
 	}
 
-	err := s.ss.StorageServiceIdFileSystemIdExportedSharesPost(storageServiceId, fileSystemId, &model)
+	RunTask(w, r, "Create Exported File Share", func(ctx context.Context) (interface{}, error) {
+		err := s.ss.StorageServiceIdFileSystemIdExportedSharesPost(ctx, storageServiceId, fileSystemId, &model)
 
-	model.OdataId = fmt.Sprintf("/redfish/v1/StorageServices/%s/FileSystems/%s/ExportedShares/%s", storageServiceId, fileSystemId, model.Id)
-	model.OdataType = FileShareOdataType
-	model.Name = "Exported File Share"
+		model.OdataId = fmt.Sprintf("/redfish/v1/StorageServices/%s/FileSystems/%s/ExportedShares/%s", storageServiceId, fileSystemId, model.Id)
+		model.OdataType = FileShareOdataType
+		model.Name = "Exported File Share"
 
-	EncodeResponse(model, err, w)
+		return model, err
+	})
 }
 
 // RedfishV1StorageServicesStorageServiceIdFileSystemsFileSystemsIdExportedFileSharesExportedFileSharesIdPut -
@@ -593,7 +713,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdFileSystemsF
 		return
 	}
 
-	err := s.ss.StorageServiceIdFileSystemIdExportedShareIdPut(storageServiceId, fileSystemId, exportedShareId, &model)
+	err := s.ss.StorageServiceIdFileSystemIdExportedShareIdPut(r.Context(), storageServiceId, fileSystemId, exportedShareId, &model)
 
 	model.OdataId = fmt.Sprintf("/redfish/v1/StorageServices/%s/FileSystems/%s/ExportedShares/%s", storageServiceId, fileSystemId, model.Id)
 	model.OdataType = FileShareOdataType
@@ -615,7 +735,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdFileSystemsF
 		Name:      "Exported File Share",
 	}
 
-	err := s.ss.StorageServiceIdFileSystemIdExportedShareIdGet(storageServiceId, fileSystemId, exportedShareId, &model)
+	err := s.ss.StorageServiceIdFileSystemIdExportedShareIdGet(r.Context(), storageServiceId, fileSystemId, exportedShareId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -633,7 +753,7 @@ func (s *DefaultApiService) RedfishV1StorageServicesStorageServiceIdFileSystemsF
 		Name:      "Exported File Share",
 	}
 
-	err := s.ss.StorageServiceIdFileSystemIdExportedShareIdDelete(storageServiceId, fileSystemId, exportedShareId)
+	err := s.ss.StorageServiceIdFileSystemIdExportedShareIdDelete(r.Context(), storageServiceId, fileSystemId, exportedShareId)
 
 	EncodeResponse(model, err, w)
 }
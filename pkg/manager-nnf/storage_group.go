@@ -20,6 +20,7 @@
 package nnf
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -65,8 +66,8 @@ func (sg *StorageGroup) OdataIdRef(ref string) sf.OdataV4IdRef {
 	return sf.OdataV4IdRef{OdataId: fmt.Sprintf("%s%s", sg.OdataId(), ref)}
 }
 
-func (sg *StorageGroup) status() sf.ResourceStatus {
-	status, _ := sg.serverStorage.GetStatus()
+func (sg *StorageGroup) status(ctx context.Context) sf.ResourceStatus {
+	status, _ := sg.serverStorage.GetStatus(ctx)
 
 	health := sf.OK_RH
 	if status.State() != sf.ENABLED_RST {
@@ -131,7 +132,7 @@ func (sg *StorageGroup) GenerateStateData(state uint32) ([]byte, error) {
 	return nil, nil
 }
 
-func (sg *StorageGroup) Rollback(state uint32) error {
+func (sg *StorageGroup) Rollback(ctx context.Context, state uint32) error {
 	switch state {
 	case storageGroupCreateStartLogEntryType:
 		// Rollback to a state where no controllers are attached to the storage pool
@@ -147,7 +148,7 @@ func (sg *StorageGroup) Rollback(state uint32) error {
 				return fmt.Errorf("Rollback Storage Group %s Create Start: Volume %s not found", sg.id, pv.VolumeId)
 			}
 
-			if err := volume.DetachController(sg.endpoint.controllerId); err != nil {
+			if err := volume.DetachController(ctx, sg.endpoint.controllerId); err != nil {
 				return err
 			}
 		}
@@ -166,7 +167,7 @@ func (sg *StorageGroup) Rollback(state uint32) error {
 				return fmt.Errorf("Rollback Storage Group %s Delete Start: Volume %s not found", sg.id, pv.VolumeId)
 			}
 
-			if err := volume.AttachController(sg.endpoint.controllerId); err != nil {
+			if err := volume.AttachController(ctx, sg.endpoint.controllerId); err != nil {
 				return err
 			}
 		}
@@ -178,7 +179,7 @@ func (sg *StorageGroup) Rollback(state uint32) error {
 // recoverPool iterates through each volume in the associated storage pool and
 // lists the controllers that volumes are attached to. It helps ensure that all
 // necessary controller attachments are properly recovered during system recovery.
-func (sg *StorageGroup) recoverPool() error {
+func (sg *StorageGroup) recoverPool(ctx context.Context) error {
 	log := sg.storageService.log.WithValues("storageGroup", sg.id, "storagePool", sg.storagePoolId, "endpoint", sg.endpoint.id)
 
 	sp := sg.storageService.findStoragePool(sg.storagePoolId)
@@ -200,7 +201,7 @@ func (sg *StorageGroup) recoverPool() error {
 		}
 
 		// Ensure volume is attached to the endpoint
-		if err := volume.AttachControllerIfUnattached(sg.endpoint.controllerId); err != nil {
+		if err := volume.AttachControllerIfUnattached(ctx, sg.endpoint.controllerId); err != nil {
 			return err
 		}
 	}
@@ -228,6 +229,7 @@ func (r *storageGroupRecoveryRegistry) NewReplay(id string) persistent.ReplayHan
 type storageGroupRecoveryReplyHandler struct {
 	id               string
 	lastLogEntryType uint32
+	rolledBack       bool
 	storageService   *StorageService
 }
 
@@ -258,6 +260,7 @@ func (rh *storageGroupRecoveryReplyHandler) Entry(typ uint32, data []byte) error
 }
 
 func (rh *storageGroupRecoveryReplyHandler) Done() (bool, error) {
+	ctx := context.Background()
 
 	sg := rh.storageService.findStorageGroup(rh.id)
 	if sg == nil {
@@ -280,12 +283,13 @@ func (rh *storageGroupRecoveryReplyHandler) Done() (bool, error) {
 				return false, fmt.Errorf("Storage Group %s Recover: Volume %s not found", sg.id, pv.VolumeId)
 			}
 
-			if err := volume.DetachController(sg.endpoint.controllerId); err != nil {
+			if err := volume.DetachController(ctx, sg.endpoint.controllerId); err != nil {
 				return false, err
 			}
 		}
 
 		sp.storageService.deleteStorageGroup(sg)
+		rh.rolledBack = true
 
 		return true, nil
 
@@ -296,7 +300,7 @@ func (rh *storageGroupRecoveryReplyHandler) Done() (bool, error) {
 		// for namespaces that formerly were attached, there is nothing to do.
 
 		// Verify all volumes are attached to the endpoint
-		if err := sg.recoverPool(); err != nil {
+		if err := sg.recoverPool(ctx); err != nil {
 			return false, err
 		}
 
@@ -315,3 +319,19 @@ func (rh *storageGroupRecoveryReplyHandler) Done() (bool, error) {
 		"lastLogEntryType", LogEntryTypeToString(rh.lastLogEntryType))
 	return false, nil
 }
+
+func (rh *storageGroupRecoveryReplyHandler) InterruptedOperation(op *persistent.InterruptedOperation) bool {
+	object := rh.storageService.OdataId() + "/StorageGroups/" + rh.id
+
+	switch rh.lastLogEntryType {
+	case storageGroupCreateStartLogEntryType:
+		if !rh.rolledBack {
+			return reportInterruptedOperation(op, object, createInterruptedOperation, rollbackFailedInterruptedAction)
+		}
+		return reportInterruptedOperation(op, object, createInterruptedOperation, rolledBackInterruptedAction)
+	case storageGroupDeleteStartLogEntryType:
+		return reportInterruptedOperation(op, object, deleteInterruptedOperation, retainedInterruptedAction)
+	}
+
+	return false
+}
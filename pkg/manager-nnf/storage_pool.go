@@ -20,12 +20,15 @@
 package nnf
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	"github.com/google/uuid"
 
 	nvme2 "github.com/NearNodeFlash/nnf-ec/internal/switchtec/pkg/nvme"
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
 	event "github.com/NearNodeFlash/nnf-ec/pkg/manager-event"
 	msgreg "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry/registries"
 	nvme "github.com/NearNodeFlash/nnf-ec/pkg/manager-nvme"
@@ -36,6 +39,10 @@ import (
 // StoragePool represents a logical grouping of storage capacity that can be allocated and managed
 // as a unit within the storage service.
 type StoragePool struct {
+	// The mutex serializes the operations on the storage pool and on the storage groups, file system and
+	// file shares built from it. It is held for the duration of any hardware work on those resources.
+	mutex sync.Mutex
+
 	id          string
 	name        string
 	description string
@@ -44,13 +51,17 @@ type StoragePool struct {
 	policy         AllocationPolicy
 	volumeCapacity uint64
 
+	// The allocated volume and the volumes owned by the storage pool are read by requests and by the storage
+	// service with only the service locked; they are changed with both the storage pool and the service locked.
 	allocatedVolume  AllocatedVolume
 	providingVolumes []nvme.ProvidingVolume
 	missingVolumes   []storagePoolPersistentVolumeInfo
 
-	// Original persistent volume information from KV store
+	// Original persistent volume information from KV store. This is changed when another storage pool
+	// replaces a missing volume, so it is guarded by the service lock alone.
 	persistedVolumes []storagePoolPersistentVolumeInfo
 
+	// The storage groups and file system built upon the storage pool, locked as for the volumes above
 	storageGroupIds []string
 	fileSystemId    string
 
@@ -112,6 +123,27 @@ func (p *StoragePool) capacitySourcesGet() []sf.CapacityCapacitySource {
 	}
 }
 
+// setProvidingVolumes replaces the volumes that provide the storage pool's capacity
+func (p *StoragePool) setProvidingVolumes(volumes []nvme.ProvidingVolume) {
+	p.storageService.mutex.Lock()
+	defer p.storageService.mutex.Unlock()
+
+	p.providingVolumes = volumes
+}
+
+// updateAllocatedVolume sizes the storage pool's allocated volume to the capacity of its providing volumes
+func (p *StoragePool) updateAllocatedVolume() {
+	capacityBytes := p.GetCapacityBytes()
+
+	p.storageService.mutex.Lock()
+	defer p.storageService.mutex.Unlock()
+
+	p.allocatedVolume = AllocatedVolume{
+		id:            DefaultAllocatedVolumeId,
+		capacityBytes: capacityBytes,
+	}
+}
+
 func (p *StoragePool) findStorageGroupByEndpoint(endpoint *Endpoint) *StorageGroup {
 	for _, sgid := range p.storageGroupIds {
 		sg := p.storageService.findStorageGroup(sgid)
@@ -136,9 +168,11 @@ func (p *StoragePool) recoverVolumes(volumes []storagePoolPersistentVolumeInfo)
 	log := p.storageService.log.WithValues(storagePoolIdKey, p.id)
 	log.Info("recover volumes")
 
-	// Store the persisted volumes information for later use
-	p.persistedVolumes = make([]storagePoolPersistentVolumeInfo, len(volumes))
-	copy(p.persistedVolumes, volumes)
+	persistedVolumes := make([]storagePoolPersistentVolumeInfo, len(volumes))
+	copy(persistedVolumes, volumes)
+
+	var missingVolumes []storagePoolPersistentVolumeInfo
+	var providingVolumes []nvme.ProvidingVolume
 
 	for _, volumeInfo := range volumes {
 		log := log.WithValues("serialNumber", volumeInfo.SerialNumber, "namespaceId", volumeInfo.NamespaceID)
@@ -147,7 +181,7 @@ func (p *StoragePool) recoverVolumes(volumes []storagePoolPersistentVolumeInfo)
 		// They need a replacement.
 		if volumeInfo.NamespaceID == invalidNamespaceID {
 			log.V(2).Info("skipping invalidated volume during recovery")
-			p.missingVolumes = append(p.missingVolumes, volumeInfo)
+			missingVolumes = append(missingVolumes, volumeInfo)
 			continue
 		}
 
@@ -155,7 +189,7 @@ func (p *StoragePool) recoverVolumes(volumes []storagePoolPersistentVolumeInfo)
 		storage := p.storageService.findStorage(volumeInfo.SerialNumber)
 		if storage == nil {
 			log.Info("storage device not found")
-			p.missingVolumes = append(p.missingVolumes, volumeInfo)
+			missingVolumes = append(missingVolumes, volumeInfo)
 			continue
 		}
 
@@ -164,20 +198,24 @@ func (p *StoragePool) recoverVolumes(volumes []storagePoolPersistentVolumeInfo)
 		_, err := storage.FindVolumeByNamespaceId(volumeInfo.NamespaceID)
 		if err != nil {
 			log.Error(err, "namespace not found", "slot", storage.Slot())
-			p.missingVolumes = append(p.missingVolumes, volumeInfo)
+			missingVolumes = append(missingVolumes, volumeInfo)
 			continue
 		}
 
-		p.providingVolumes = append(p.providingVolumes, nvme.ProvidingVolume{
+		providingVolumes = append(providingVolumes, nvme.ProvidingVolume{
 			Storage:  storage,
 			VolumeId: fmt.Sprintf("%d", volumeID),
 		})
 	}
 
-	p.allocatedVolume = AllocatedVolume{
-		id:            DefaultAllocatedVolumeId,
-		capacityBytes: p.GetCapacityBytes(),
-	}
+	// Store the persisted volumes information for later use
+	p.storageService.mutex.Lock()
+	p.persistedVolumes = persistedVolumes
+	p.missingVolumes = missingVolumes
+	p.providingVolumes = providingVolumes
+	p.storageService.mutex.Unlock()
+
+	p.updateAllocatedVolume()
 
 	return nil
 }
@@ -198,13 +236,15 @@ func (p *StoragePool) recoverVolumes(volumes []storagePoolPersistentVolumeInfo)
 // The method is typically called during pool initialization, recovery operations,
 // or when storage device states may have changed. It ensures the pool maintains
 // an accurate view of its available storage resources.
-func (p *StoragePool) checkVolumes() error {
+func (p *StoragePool) checkVolumes(ctx context.Context) error {
 	log := p.storageService.log.WithValues(storagePoolIdKey, p.id)
 	log.Info("check volumes")
 
 	// Rescan the storages to ensure our namespace information is up to date
+	p.storageService.mutex.RLock()
 	volumesInPool := make([]storagePoolPersistentVolumeInfo, len(p.persistedVolumes))
 	copy(volumesInPool, p.persistedVolumes)
+	p.storageService.mutex.RUnlock()
 
 	for _, pv := range volumesInPool {
 		log := log.WithValues("serialNumber", pv.SerialNumber, "namespaceId", pv.NamespaceID)
@@ -213,12 +253,10 @@ func (p *StoragePool) checkVolumes() error {
 			log.Info("storage device not found")
 			continue
 		}
-		storage.Rescan()
+		storage.Rescan(ctx)
 	}
 
-	p.missingVolumes = p.missingVolumes[:0]     // Clear the missing volumes list
-	p.providingVolumes = p.providingVolumes[:0] // Clear the providing volumes list
-
+	// Rebuild the missing and providing volumes lists
 	if err := p.recoverVolumes(volumesInPool); err != nil {
 		log.Error(err, "Failed to recover volumes")
 		return err
@@ -228,7 +266,7 @@ func (p *StoragePool) checkVolumes() error {
 }
 
 // Replace each missing volume with new volume on available Storage
-func (p *StoragePool) replaceMissingVolumes() error {
+func (p *StoragePool) replaceMissingVolumes(ctx context.Context) error {
 	log := p.storageService.log.WithValues(storagePoolIdKey, p.id)
 	log.Info("replace missing volumes")
 
@@ -280,7 +318,7 @@ func (p *StoragePool) replaceMissingVolumes() error {
 			return fmt.Errorf("Cannot create volume: storage unavailable for missing volume %v", missingVolume)
 		}
 
-		volume, err := nvme.CreateVolume(storage, p.volumeCapacity)
+		volume, err := nvme.CreateVolume(ctx, storage, p.volumeCapacity)
 		if err != nil {
 			log.Error(err, "Failed to create replacement volume")
 			return fmt.Errorf("Failed to create volume: %v", err)
@@ -291,7 +329,9 @@ func (p *StoragePool) replaceMissingVolumes() error {
 		}
 		log.Info("created replacement volume", "storage", storage.SerialNumber(), "volume", pv.VolumeId)
 
+		p.storageService.mutex.Lock()
 		p.providingVolumes = append(p.providingVolumes, pv)
+		p.storageService.mutex.Unlock()
 
 		event.EventManager.PublishResourceEvent(msgreg.StoragePoolPatchedNnf(
 			p.id,
@@ -308,7 +348,9 @@ func (p *StoragePool) replaceMissingVolumes() error {
 	}
 
 	// We've replaced all the missing volumes, so clear the list
+	p.storageService.mutex.Lock()
 	p.missingVolumes = nil
+	p.storageService.mutex.Unlock()
 
 	return nil
 }
@@ -322,9 +364,11 @@ func (p *StoragePool) invalidateVolumeInOtherPools(serialNumber string, namespac
 
 	invalidatedPools := 0
 
+	p.storageService.mutex.Lock()
+	defer p.storageService.mutex.Unlock()
+
 	// Check all other storage pools in the service
-	for i := range p.storageService.pools {
-		otherPool := &p.storageService.pools[i]
+	for _, otherPool := range p.storageService.pools {
 		if otherPool.id == p.id {
 			continue // Skip self
 		}
@@ -382,13 +426,19 @@ func (p *StoragePool) locateUnusedStorage() []*nvme.Storage {
 	return unusedStorages
 }
 
-func (p *StoragePool) deallocateVolumes() error {
+func (p *StoragePool) deallocateVolumes(ctx context.Context) error {
 	log := p.storageService.log.WithValues(storagePoolIdKey, p.id)
 	// In order to speed up deleting volumes, we format them first. Format runs asynchronously, so after
-	// each format call, wait for completion before deleting the volume.
+	// each format call, wait for completion before deleting the volume. Progress is reported over the
+	// three passes through the volumes.
+
+	task := ec.TaskFromContext(ctx)
+	steps, step := 3*len(p.providingVolumes), 0
 
 	runOnProvidingVolumes := func(volFn func(*nvme.Volume) error) error {
 		for _, pv := range p.providingVolumes {
+			step++
+
 			volume := pv.Storage.FindVolume(pv.VolumeId)
 			if volume == nil {
 				err := fmt.Errorf("Volume not found")
@@ -400,23 +450,27 @@ func (p *StoragePool) deallocateVolumes() error {
 				log.Error(err, "Volume function failed", "function", volFn, "volume", pv.VolumeId)
 				continue
 			}
+
+			task.SetPercentComplete(int64(100 * step / steps))
 		}
 
 		return nil
 	}
 
 	log.V(3).Info("Formatting volumes")
-	if err := runOnProvidingVolumes(func(v *nvme.Volume) error { return v.Format() }); err != nil {
+	task.AddMessage("Formatting volumes")
+	if err := runOnProvidingVolumes(func(v *nvme.Volume) error { return v.Format(ctx) }); err != nil {
 		return fmt.Errorf("Failed to format volumes: %v", err)
 	}
 
 	log.V(3).Info("Wait for format complete")
-	if err := runOnProvidingVolumes(func(v *nvme.Volume) error { return v.WaitFormatComplete() }); err != nil {
+	if err := runOnProvidingVolumes(func(v *nvme.Volume) error { return v.WaitFormatComplete(ctx) }); err != nil {
 		return fmt.Errorf("Failed to wait on format completions: %v", err)
 	}
 
 	log.V(3).Info("Deleting volumes")
-	if err := runOnProvidingVolumes(func(v *nvme.Volume) error { return v.Delete() }); err != nil {
+	task.AddMessage("Deleting volumes")
+	if err := runOnProvidingVolumes(func(v *nvme.Volume) error { return v.Delete(ctx) }); err != nil {
 		return fmt.Errorf("Failed to delete volumes: %v", err)
 	}
 
@@ -504,8 +558,10 @@ func (p *StoragePool) GenerateStateData(state uint32) ([]byte, error) {
 		}
 
 		// Store the persistent volumes information for later use
+		p.storageService.mutex.Lock()
 		p.persistedVolumes = make([]storagePoolPersistentVolumeInfo, len(entry.Volumes))
 		copy(p.persistedVolumes, entry.Volumes)
+		p.storageService.mutex.Unlock()
 
 		return json.Marshal(entry)
 	}
@@ -527,20 +583,14 @@ func (p *StoragePool) GenerateStateData(state uint32) ([]byte, error) {
 //   - error: any error encountered during the rollback, or nil on success
 //
 // If the state type is not recognized, it returns nil.
-func (p *StoragePool) Rollback(state uint32) error {
+func (p *StoragePool) Rollback(ctx context.Context, state uint32) error {
 	switch state {
 	case storagePoolStorageCreateStartLogEntryType:
-		if err := p.deallocateVolumes(); err != nil {
+		if err := p.deallocateVolumes(ctx); err != nil {
 			return err
 		}
 
-		s := p.storageService
-		for idx, pool := range s.pools {
-			if pool.id == p.id {
-				copy(s.pools[idx:], s.pools[idx+1:])
-				s.pools = s.pools[:len(s.pools)-1]
-			}
-		}
+		p.storageService.deleteStoragePool(p)
 	}
 
 	return nil
@@ -589,6 +639,7 @@ func (rh *storagePoolRecoveryReplayHandler) Metadata(data []byte) error {
 	}
 
 	rh.storagePool = rh.storageService.createStoragePool(rh.id, metadata.Name, metadata.Description, uuid.MustParse(metadata.Uid), nil)
+	rh.storagePool.mutex.Unlock() // Replay completes before any request is served
 
 	rh.storagePool.allocatedVolume = AllocatedVolume{id: DefaultAllocatedVolumeId, capacityBytes: 0}
 
@@ -652,6 +703,21 @@ func (rh *storagePoolRecoveryReplayHandler) Done() (bool, error) {
 	return false, nil
 }
 
+func (rh *storagePoolRecoveryReplayHandler) InterruptedOperation(op *persistent.InterruptedOperation) bool {
+	object := rh.storageService.OdataId() + "/StoragePools/" + rh.id
+
+	switch rh.lastLogEntryType {
+	case storagePoolStorageCreateStartLogEntryType:
+		return reportInterruptedOperation(op, object, createInterruptedOperation, retainedInterruptedAction)
+	case storagePoolStorageUpdateStartLogEntryType:
+		return reportInterruptedOperation(op, object, updateInterruptedOperation, retainedInterruptedAction)
+	case storagePoolStorageDeleteStartLogEntryType:
+		return reportInterruptedOperation(op, object, deleteInterruptedOperation, recoveredInterruptedAction)
+	}
+
+	return false
+}
+
 // Helper to check if storage device is nil
 func storageDeviceIsNil(s interface{}) bool {
 	if s == nil {
@@ -32,6 +32,7 @@
 package nvme
 
 import (
+	"context"
 	"net/http"
 
 	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
@@ -59,18 +60,18 @@ type Api interface {
 }
 
 type StorageApi interface {
-	Get(*sf.StorageCollectionStorageCollection) error
-	StorageIdGet(string, *sf.StorageV190Storage) error
+	Get(context.Context, *sf.StorageCollectionStorageCollection) error
+	StorageIdGet(context.Context, string, *sf.StorageV190Storage) error
 
-	StorageIdStoragePoolsGet(string, *sf.StoragePoolCollectionStoragePoolCollection) error
-	StorageIdStoragePoolsStoragePoolIdGet(string, string, *sf.StoragePoolV150StoragePool) error
+	StorageIdStoragePoolsGet(context.Context, string, *sf.StoragePoolCollectionStoragePoolCollection) error
+	StorageIdStoragePoolsStoragePoolIdGet(context.Context, string, string, *sf.StoragePoolV150StoragePool) error
 
-	StorageIdControllersGet(string, *sf.StorageControllerCollectionStorageControllerCollection) error
-	StorageIdControllersControllerIdGet(string, string, *sf.StorageControllerV100StorageController) error
+	StorageIdControllersGet(context.Context, string, *sf.StorageControllerCollectionStorageControllerCollection) error
+	StorageIdControllersControllerIdGet(context.Context, string, string, *sf.StorageControllerV100StorageController) error
 
-	StorageIdVolumesGet(string, *sf.VolumeCollectionVolumeCollection) error
-	StorageIdVolumesPost(string, *sf.VolumeV161Volume) error
+	StorageIdVolumesGet(context.Context, string, *sf.VolumeCollectionVolumeCollection) error
+	StorageIdVolumesPost(context.Context, string, *sf.VolumeV161Volume) error
 
-	StorageIdVolumeIdGet(string, string, *sf.VolumeV161Volume) error
-	StorageIdVolumeIdDelete(string, string) error
+	StorageIdVolumeIdGet(context.Context, string, string, *sf.VolumeV161Volume) error
+	StorageIdVolumeIdDelete(context.Context, string, string) error
 }
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package nvme
+
+import (
+	"fmt"
+	"time"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+)
+
+// Health - The NVMe manager is ready once initialized; the number of storage devices present and
+// enabled is reported for diagnosis. It is live provided no storage device lock has been held for
+// longer than any command should take, and the drive monitor, if running, has polled recently.
+
+const (
+	livenessLockTimeout   = 2 * time.Minute
+	monitorStallIntervals = 3
+)
+
+// lockHeldFor returns how long the storage device lock has been held, or zero if it is free
+func (s *Storage) lockHeldFor() time.Duration {
+	lockedAt := s.lockedAt.Load()
+	if lockedAt == 0 {
+		return 0
+	}
+
+	return time.Since(time.Unix(0, lockedAt))
+}
+
+func (m *Manager) ready() ec.HealthStatus {
+	if m.storage == nil {
+		return ec.HealthStatus{Healthy: false, Reason: "not initialized"}
+	}
+
+	enabled := 0
+	for _, s := range GetStorage() {
+		if s.IsEnabled() {
+			enabled++
+		}
+	}
+
+	return ec.HealthStatus{
+		Healthy: true,
+		Details: map[string]interface{}{
+			"drives":        len(m.storage),
+			"drivesEnabled": enabled,
+		},
+	}
+}
+
+func (m *Manager) live() ec.HealthStatus {
+	status := ec.HealthStatus{Healthy: true, Details: map[string]interface{}{}}
+
+	for idx := range m.storage {
+		s := &m.storage[idx]
+		if held := s.lockHeldFor(); held > livenessLockTimeout {
+			status.Healthy = false
+			status.Reason = fmt.Sprintf("storage %s lock held for %s", s.id, held.Round(time.Second))
+			return status
+		}
+	}
+
+	if monitor := m.monitor.Load(); monitor != nil {
+		sincePoll := time.Since(time.Unix(0, monitor.lastPoll.Load()))
+		status.Details["monitorLastPoll"] = sincePoll.Round(time.Second).String()
+
+		if sincePoll > monitorStallIntervals*monitor.Interval {
+			status.Healthy = false
+			status.Reason = fmt.Sprintf("drive monitor has not polled for %s", sincePoll.Round(time.Second))
+		}
+	}
+
+	return status
+}
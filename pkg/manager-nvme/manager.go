@@ -20,6 +20,7 @@
 package nvme
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
@@ -27,6 +28,8 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	. "github.com/NearNodeFlash/nnf-ec/pkg/api"
@@ -81,6 +84,8 @@ type Manager struct {
 	storage []Storage
 	ctrl    NvmeDeviceController
 
+	monitor atomic.Pointer[Monitor]
+
 	// Command-Line Options
 	purge       bool // Purge existing namespaces on storage controllers
 	purgeMockDb bool // Purge the persistent mock database
@@ -132,15 +137,38 @@ type Storage struct {
 
 	manager *Manager
 
+	// The mutex serializes commands to the storage device and protects the device state, its
+	// controllers and volumes. Events raised while the mutex is held are queued and published
+	// when it is released.
+	mutex    sync.Mutex
+	events   []event.Event
+	lockedAt atomic.Int64 // Time the lock was taken, in Unix nanoseconds, or zero if free
+
 	controllers []StorageController // List of Storage Controllers on the Storage device
-	volumes     []Volume            // List of Volumes on the Storage device
+	volumes     []*Volume           // List of Volumes on the Storage device
 	config      *ControllerConfig   // Link to the storage configuration
 
 	device NvmeDeviceApi // Device interface for interaction with the underlying NVMe device
 
+	smartLog *nvme.SmartLog // SMART log last read by the drive monitor, or nil if never read
+
+	// Snapshot of the values exported as metrics, refreshed whenever the lock is released so a
+	// scrape never waits on device commands in progress
+	metrics atomic.Pointer[storageMetrics]
+
 	log ec.Logger
 }
 
+// storageMetrics is a snapshot of the storage device values reported as metrics
+type storageMetrics struct {
+	serialNumber     string
+	slot             int64
+	state            sf.ResourceState
+	capacityBytes    uint64
+	unallocatedBytes uint64
+	smartLog         *nvme.SmartLog
+}
+
 // StorageController -
 type StorageController struct {
 	id string
@@ -211,30 +239,12 @@ func findStorage(storageId string) *Storage {
 	return &mgr.storage[id]
 }
 
-func findStorageController(storageId, controllerId string) (*Storage, *StorageController) {
-	s := findStorage(storageId)
-	if s == nil {
-		return nil, nil
-	}
-
-	return s, s.findController(controllerId)
-}
-
-func findStorageVolume(storageId, volumeId string) (*Storage, *Volume) {
-	s := findStorage(storageId)
-	if s == nil {
-		return nil, nil
-	}
-
-	return s, s.findVolume(volumeId)
-}
-
 func findStoragePool(storageId, storagePoolId string) (*Storage, *interface{}) {
 	return nil, nil
 }
 
 // CleanupVolumes - remove all volumes other than the list of providingVolumes
-func CleanupVolumes(providingVolumes []ProvidingVolume) {
+func CleanupVolumes(ctx context.Context, providingVolumes []ProvidingVolume) {
 	for _, storage := range GetStorage() {
 		if !storage.IsEnabled() {
 			continue
@@ -242,15 +252,48 @@ func CleanupVolumes(providingVolumes []ProvidingVolume) {
 
 		var volIdsToKeep []string
 		for _, vol := range providingVolumes {
-			if vol.Storage.serialNumber == storage.serialNumber {
+			if vol.Storage.SerialNumber() == storage.SerialNumber() {
 				volIdsToKeep = append(volIdsToKeep, vol.VolumeId)
 			}
 		}
 
-		if err := storage.purgeVolumes(volIdsToKeep); err != nil {
-			mgr.log.Error(err, "Failed to remove abandoned volumes", "storage", storage)
+		storage.lock()
+		err := storage.purgeVolumes(ctx, volIdsToKeep)
+		storage.unlock()
+
+		if err != nil {
+			mgr.log.Error(err, "Failed to remove abandoned volumes", "storage", storage.id)
+		}
+	}
+}
+
+// FindUnknownVolumes - return all volumes on enabled storage that are not in the list of providingVolumes
+func FindUnknownVolumes(providingVolumes []ProvidingVolume) []ProvidingVolume {
+	var unknownVolumes []ProvidingVolume
+	for _, storage := range GetStorage() {
+		if !storage.IsEnabled() {
+			continue
+		}
+
+		serialNumber := storage.SerialNumber()
+
+		var volIdsToKeep []string
+		for _, pv := range providingVolumes {
+			if pv.Storage.SerialNumber() == serialNumber {
+				volIdsToKeep = append(volIdsToKeep, pv.VolumeId)
+			}
+		}
+
+		storage.lock()
+		for _, vol := range storage.volumes {
+			if !slices.Contains(volIdsToKeep, vol.id) {
+				unknownVolumes = append(unknownVolumes, ProvidingVolume{Storage: storage, VolumeId: vol.id})
+			}
 		}
+		storage.unlock()
 	}
+
+	return unknownVolumes
 }
 
 func (m *Manager) fmt(format string, a ...interface{}) string {
@@ -258,27 +301,43 @@ func (m *Manager) fmt(format string, a ...interface{}) string {
 }
 
 // GetVolumes -
-func (m *Manager) GetVolumes(controllerId string) ([]string, error) {
+func (m *Manager) GetVolumes(ctx context.Context, controllerId string) ([]string, error) {
 	volumes := []string{}
-	for _, s := range m.storage {
-		c := s.findController(controllerId)
-		if c == nil {
-			return volumes, ec.NewErrNotFound()
-		}
+	for idx := range m.storage {
+		s := &m.storage[idx]
+
+		s.lock()
+		found, err := s.getVolumes(ctx, controllerId)
+		s.unlock()
 
-		nsids, err := s.device.ListNamespaces(c.functionNumber)
 		if err != nil {
 			return volumes, err
 		}
 
-		for _, nsid := range nsids {
-			for _, v := range s.volumes {
-				if v.namespaceId == nsid {
-					volumes = append(volumes, fmt.Sprintf("/redfish/v1/Storage/%s/Volumes/%s", s.id, v.id))
-				}
+		volumes = append(volumes, found...)
+	}
+
+	return volumes, nil
+}
+
+func (s *Storage) getVolumes(ctx context.Context, controllerId string) ([]string, error) {
+	c := s.findController(controllerId)
+	if c == nil {
+		return nil, ec.NewErrNotFound()
+	}
+
+	nsids, err := s.device.ListNamespaces(ctx, c.functionNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := []string{}
+	for _, nsid := range nsids {
+		for _, v := range s.volumes {
+			if v.namespaceId == nsid {
+				volumes = append(volumes, fmt.Sprintf("/redfish/v1/Storage/%s/Volumes/%s", s.id, v.id))
 			}
 		}
-
 	}
 
 	return volumes, nil
@@ -308,22 +367,71 @@ func GetStorage() []*Storage {
 }
 
 func EnumerateStorage(storageHandlerFunc func(odataId string, capacityBytes uint64, unallocatedBytes uint64)) error {
-	for _, s := range mgr.storage {
-		storageHandlerFunc(s.OdataId()+"/StoragePools", s.capacityBytes, s.unallocatedBytes)
+	for idx := range mgr.storage {
+		s := &mgr.storage[idx]
+
+		s.lock()
+		capacityBytes, unallocatedBytes := s.capacityBytes, s.unallocatedBytes
+		s.unlock()
+
+		storageHandlerFunc(s.OdataId()+"/StoragePools", capacityBytes, unallocatedBytes)
 	}
 
 	return nil
 }
 
-func CreateVolume(s *Storage, capacityBytes uint64) (*Volume, error) {
-	return s.createVolume(capacityBytes)
+func CreateVolume(ctx context.Context, s *Storage, capacityBytes uint64) (*Volume, error) {
+	s.lock()
+	defer s.unlock()
+
+	return s.createVolume(ctx, capacityBytes)
 }
 
-func (s *Storage) UnallocatedBytes() uint64 { return s.unallocatedBytes }
-func (s *Storage) IsEnabled() bool          { return s.state == sf.ENABLED_RST }
-func (s *Storage) SerialNumber() string     { return s.serialNumber }
-func (s *Storage) Slot() int64              { return s.slot }
-func (s *Storage) Rescan() error            { return s.recoverStorageVolumes() }
+func (s *Storage) UnallocatedBytes() uint64 { s.lock(); defer s.unlock(); return s.unallocatedBytes }
+func (s *Storage) IsEnabled() bool          { s.lock(); defer s.unlock(); return s.state == sf.ENABLED_RST }
+func (s *Storage) SerialNumber() string     { s.lock(); defer s.unlock(); return s.serialNumber }
+func (s *Storage) Slot() int64              { s.lock(); defer s.unlock(); return s.slot }
+
+func (s *Storage) Rescan(ctx context.Context) error {
+	s.lock()
+	defer s.unlock()
+	return s.recoverStorageVolumes(ctx)
+}
+
+// lock takes the storage device lock. Unexported storage and volume methods expect the
+// caller to hold the lock; exported methods take it themselves.
+func (s *Storage) lock() {
+	s.mutex.Lock()
+	s.lockedAt.Store(time.Now().UnixNano())
+}
+
+// unlock releases the storage device lock and publishes any events raised while it was held.
+// Events are never published with the lock held, so subscribers may call back into the manager.
+func (s *Storage) unlock() {
+	events := s.events
+	s.events = nil
+
+	s.metrics.Store(&storageMetrics{
+		serialNumber:     s.serialNumber,
+		slot:             s.slot,
+		state:            s.state,
+		capacityBytes:    s.capacityBytes,
+		unallocatedBytes: s.unallocatedBytes,
+		smartLog:         s.smartLog,
+	})
+
+	s.lockedAt.Store(0)
+	s.mutex.Unlock()
+
+	for _, e := range events {
+		event.EventManager.Publish(e)
+	}
+}
+
+// publish queues the event for publishing when the storage device lock is released.
+func (s *Storage) publish(e event.Event) {
+	s.events = append(s.events, e)
+}
 
 func (s *Storage) IsKioxiaDualPortConfiguration() bool {
 	return false ||
@@ -333,13 +441,19 @@ func (s *Storage) IsKioxiaDualPortConfiguration() bool {
 }
 
 func (s *Storage) FindVolume(id string) *Volume {
+	s.lock()
+	defer s.unlock()
+
 	return s.findVolume(id)
 }
 
 func (s *Storage) FindVolumeByNamespaceId(namespaceId nvme.NamespaceIdentifier) (*Volume, error) {
-	for idx, volume := range s.volumes {
+	s.lock()
+	defer s.unlock()
+
+	for _, volume := range s.volumes {
 		if volume.namespaceId == namespaceId {
-			return &s.volumes[idx], nil
+			return volume, nil
 		}
 	}
 
@@ -354,7 +468,7 @@ func (s *Storage) OdataIdRef(ref string) sf.OdataV4IdRef {
 	return sf.OdataV4IdRef{OdataId: fmt.Sprintf("%s%s", s.OdataId(), ref)}
 }
 
-func (s *Storage) initialize() error {
+func (s *Storage) initialize(ctx context.Context) error {
 
 	log := s.manager.log.WithName(s.id).WithValues(storageIdKey, s.id, slotKey, s.slot)
 	log.Info("Initialize storage device")
@@ -362,7 +476,7 @@ func (s *Storage) initialize() error {
 	s.log = log
 	s.state = sf.STARTING_RST
 
-	ctrl, err := s.device.IdentifyController(0)
+	ctrl, err := s.device.IdentifyController(ctx, 0)
 	if err != nil {
 		s.notify(sf.UNAVAILABLE_OFFLINE_RST)
 		return fmt.Errorf("Initialize Storage %s: Failed to identify common controller: Error: %w", s.id, err)
@@ -418,7 +532,7 @@ func (s *Storage) initialize() error {
 	identifySuccess := false
 	for retryCount := 0; !identifySuccess; retryCount++ {
 		var err error
-		ns, err = s.device.IdentifyNamespace(CommonNamespaceIdentifier)
+		ns, err = s.device.IdentifyNamespace(ctx, CommonNamespaceIdentifier)
 		if err != nil {
 			if retryCount >= 2 {
 				// After retries, this is likely a system-level failure
@@ -460,12 +574,12 @@ func (s *Storage) initialize() error {
 	return nil
 }
 
-func (s *Storage) purge() error {
+func (s *Storage) purge(ctx context.Context) error {
 	if s.device == nil {
 		return fmt.Errorf("Storage %s has no device", s.id)
 	}
 
-	namespaces, err := s.device.ListNamespaces(0)
+	namespaces, err := s.device.ListNamespaces(ctx, 0)
 	if err != nil {
 		// System-level failure when listing namespaces
 		if isSystemLevelError(err) {
@@ -475,7 +589,7 @@ func (s *Storage) purge() error {
 	}
 
 	for _, nsid := range namespaces {
-		if err := s.device.DeleteNamespace(nsid); err != nil {
+		if err := s.device.DeleteNamespace(ctx, nsid); err != nil {
 			// System-level failure when deleting namespace during purge
 			if isSystemLevelError(err) {
 				s.notify(sf.UNAVAILABLE_OFFLINE_RST)
@@ -488,7 +602,7 @@ func (s *Storage) purge() error {
 }
 
 // Delete all the volumes on this storage device other than the ones specified
-func (s *Storage) purgeVolumes(volIdsToKeep []string) error {
+func (s *Storage) purgeVolumes(ctx context.Context, volIdsToKeep []string) error {
 	if s.device == nil {
 		return fmt.Errorf("Storage %s has no device", s.id)
 	}
@@ -507,7 +621,7 @@ vol_loop:
 
 	var errs []error
 	for _, volId := range volIdsToDelete {
-		if err := s.deleteVolume(volId); err != nil {
+		if err := s.deleteVolume(ctx, volId); err != nil {
 			s.log.Error(err, "Failed to delete volume during purge", "volumeId", volId)
 			errs = append(errs, fmt.Errorf("volume %s: %w", volId, err))
 		}
@@ -542,7 +656,7 @@ func (s *Storage) getStatus() (stat sf.ResourceStatus) {
 	return stat
 }
 
-func (s *Storage) createVolume(desiredCapacityInBytes uint64) (*Volume, error) {
+func (s *Storage) createVolume(ctx context.Context, desiredCapacityInBytes uint64) (*Volume, error) {
 
 	roundUpToMultiple := func(n, m uint64) uint64 { // Round 'n' up to a multiple of 'm'
 		return ((n + m - 1) / m) * m
@@ -551,13 +665,13 @@ func (s *Storage) createVolume(desiredCapacityInBytes uint64) (*Volume, error) {
 	actualCapacityBytes := roundUpToMultiple(desiredCapacityInBytes, s.blockSizeBytes)
 
 	s.log.V(2).Info("Creating namespace", "capacityInBytes", actualCapacityBytes, "formatIndex", s.lbaFormatIndex)
-	namespaceID, guid, err := s.device.CreateNamespace(actualCapacityBytes/s.blockSizeBytes, s.lbaFormatIndex)
+	namespaceID, guid, err := s.device.CreateNamespace(ctx, actualCapacityBytes/s.blockSizeBytes, s.lbaFormatIndex)
 	if err != nil {
 		// Only publish error events for system-level failures
 		if isSystemLevelError(err) {
 			s.notify(sf.UNAVAILABLE_OFFLINE_RST)
 		}
-		return nil, err
+		return nil, s.busyError(err)
 	}
 
 	id := strconv.Itoa(int(namespaceID))
@@ -565,7 +679,7 @@ func (s *Storage) createVolume(desiredCapacityInBytes uint64) (*Volume, error) {
 	log := s.log.WithName(id).WithValues(namespaceIdKey, namespaceID)
 	log.V(1).Info("Created namespace")
 
-	volume := Volume{
+	volume := &Volume{
 		id:            id,
 		namespaceId:   namespaceID,
 		guid:          guid,
@@ -578,17 +692,17 @@ func (s *Storage) createVolume(desiredCapacityInBytes uint64) (*Volume, error) {
 
 	s.unallocatedBytes -= actualCapacityBytes
 
-	return &s.volumes[len(s.volumes)-1], nil
+	return volume, nil
 }
 
-func (s *Storage) deleteVolume(volumeId string) error {
+func (s *Storage) deleteVolume(ctx context.Context, volumeId string) error {
 
 	for idx, volume := range s.volumes {
 		if volume.id == volumeId {
 			log := volume.log
 
 			log.V(2).Info("Deleting namespace")
-			err := s.device.DeleteNamespace(volume.namespaceId)
+			err := s.device.DeleteNamespace(ctx, volume.namespaceId)
 			if err != nil {
 				// Only publish error events for system-level failures
 				if isSystemLevelError(err) {
@@ -611,13 +725,13 @@ func (s *Storage) deleteVolume(volumeId string) error {
 	return ec.NewErrNotFound()
 }
 
-func (s *Storage) formatVolume(volumeID string) error {
+func (s *Storage) formatVolume(ctx context.Context, volumeID string) error {
 	for _, volume := range s.volumes {
 		if volume.id == volumeID {
 			log := volume.log
 
 			log.V(2).Info("Format namespace")
-			if err := s.device.FormatNamespace(volume.namespaceId); err != nil {
+			if err := s.device.FormatNamespace(ctx, volume.namespaceId); err != nil {
 				// Only publish error events for system-level failures
 				if isSystemLevelError(err) {
 					s.notify(sf.UNAVAILABLE_OFFLINE_RST)
@@ -634,8 +748,8 @@ func (s *Storage) formatVolume(volumeID string) error {
 	return ec.NewErrNotFound()
 }
 
-func (s *Storage) recoverStorageVolumes() error {
-	namespaces, err := s.device.ListNamespaces(0)
+func (s *Storage) recoverStorageVolumes(ctx context.Context) error {
+	namespaces, err := s.device.ListNamespaces(ctx, 0)
 	if err != nil {
 		// Only publish error events for system-level failures
 		if isSystemLevelError(err) {
@@ -644,11 +758,11 @@ func (s *Storage) recoverStorageVolumes() error {
 		s.log.Error(err, "Failed to list device namespaces")
 	}
 
-	s.volumes = make([]Volume, 0)
+	s.volumes = make([]*Volume, 0)
 	for _, nsid := range namespaces {
 		log := s.log.WithValues(namespaceIdKey, nsid)
 
-		ns, err := s.device.IdentifyNamespace(nsid)
+		ns, err := s.device.IdentifyNamespace(ctx, nsid)
 		if err != nil {
 			// Only publish error events for system-level failures
 			if isSystemLevelError(err) {
@@ -665,7 +779,7 @@ func (s *Storage) recoverStorageVolumes() error {
 		blockSizeBytes := uint64(1 << ns.LBAFormats[ns.FormattedLBASize.Format].LBADataSize)
 
 		id := strconv.Itoa(int(nsid))
-		volume := Volume{
+		volume := &Volume{
 			id:            id,
 			namespaceId:   nsid,
 			guid:          ns.GloballyUniqueIdentifier,
@@ -694,6 +808,8 @@ func isSystemLevelError(err error) bool {
 	if errors.As(err, &cmdErr) {
 		switch cmdErr.StatusCode {
 		// Expected operational failures - don't publish events
+		case nvme.NamespaceNotReady, nvme.FormatInProgress:
+			return false
 		case nvme.NamespaceAlreadyAttached:
 			return false
 		case nvme.NamespaceNotAttached:
@@ -740,20 +856,39 @@ func isSystemLevelError(err error) bool {
 	return true
 }
 
+// Delay before retrying a command the drive could not service while busy formatting
+const deviceBusyRetryDelay = 10 * time.Second
+
+// busyError returns a retryable error if the drive reported it is busy formatting or its namespaces are
+// not yet ready, and the error unchanged otherwise
+func (s *Storage) busyError(err error) error {
+	var cmdErr *nvme.CommandError
+	if errors.As(err, &cmdErr) {
+		switch cmdErr.StatusCode {
+		case nvme.NamespaceNotReady, nvme.FormatInProgress:
+			return ec.NewErrServiceUnavailable().WithRetryDelay(deviceBusyRetryDelay).WithError(err).WithCause(fmt.Sprintf("Storage %s busy: %s", s.id, cmdErr.StatusCode))
+		}
+	}
+
+	return err
+}
+
 func (s *Storage) findVolume(volumeId string) *Volume {
-	for idx, v := range s.volumes {
+	for _, v := range s.volumes {
 		if v.id == volumeId {
-			return &s.volumes[idx]
+			return v
 		}
 	}
 
 	return nil
 }
 
+// notify records a change of the storage device state; the caller must hold the lock. The state
+// change event is published once the lock is released.
 func (s *Storage) notify(newState sf.ResourceState) {
 	if newState != s.state {
 		s.state = newState
-		event.EventManager.Publish(msgreg.NvmeStateChangeNnf(strconv.FormatInt(s.slot, 10), s.modelNumber, s.serialNumber))
+		s.publish(msgreg.NvmeStateChangeNnf(strconv.FormatInt(s.slot, 10), s.modelNumber, s.serialNumber))
 	}
 }
 
@@ -767,12 +902,30 @@ func (v *Volume) GetGloballyUniqueIdentifier() nvme.NamespaceGloballyUniqueIdent
 	return v.guid
 }
 
-func (v *Volume) Delete() error { return v.storage.deleteVolume(v.id) }
+func (v *Volume) Delete(ctx context.Context) error {
+	v.storage.lock()
+	defer v.storage.unlock()
+
+	return v.storage.deleteVolume(ctx, v.id)
+}
+
+func (v *Volume) AttachController(ctx context.Context, controllerId uint16) error {
+	v.storage.lock()
+	defer v.storage.unlock()
+
+	return v.attach(ctx, controllerId)
+}
+
+func (v *Volume) DetachController(ctx context.Context, controllerId uint16) error {
+	v.storage.lock()
+	defer v.storage.unlock()
 
-func (v *Volume) AttachController(controllerId uint16) error { return v.attach(controllerId) }
-func (v *Volume) DetachController(controllerId uint16) error { return v.detach(controllerId) }
+	return v.detach(ctx, controllerId)
+}
 
-func (v *Volume) Format() error {
+func (v *Volume) Format(ctx context.Context) error {
+	v.storage.lock()
+	defer v.storage.unlock()
 
 	// According to the NVM Express Base Specification 2.0b section 5.14 Format NVM Command
 	//   The scope of the format operation and the scope of the format with secure erase depend
@@ -782,25 +935,76 @@ func (v *Volume) Format() error {
 	// So the namespace must be attached to a controller. We attach to the controller associated with
 	// the physical function to avoid any noise generated by attaching the the volume to a working host.
 
-	return v.runInAttachDetachBlock(func() error { return v.storage.formatVolume(v.id) })
+	return v.runInAttachDetachBlock(ctx, func() error { return v.storage.formatVolume(ctx, v.id) })
 }
 
-func (v *Volume) SetFeature(data []byte) error {
+func (v *Volume) SetFeature(ctx context.Context, data []byte) error {
+	v.storage.lock()
+	defer v.storage.unlock()
 
 	// Set feature requires the volume is attached to a controller to receive the feature data. We
 	// attach to the controller associated with the the physical function to avoid any noise generated by
 	// attaching the volume to a working host.
 
-	return v.runInAttachDetachBlock(func() error { return v.storage.device.SetNamespaceFeature(v.namespaceId, data) })
+	return v.runInAttachDetachBlock(ctx, func() error { return v.storage.device.SetNamespaceFeature(ctx, v.namespaceId, data) })
+}
+
+func (v *Volume) GetFeature(ctx context.Context) ([]byte, error) {
+	v.storage.lock()
+	defer v.storage.unlock()
+
+	// Get feature has the same attachment requirement as set feature.
+
+	var data []byte
+	err := v.runInAttachDetachBlock(ctx, func() (err error) {
+		data, err = v.storage.device.GetNamespaceFeature(ctx, v.namespaceId)
+		return err
+	})
+
+	return data, err
 }
 
-func (v *Volume) listAttachedControllers() ([]uint16, error) {
-	return v.storage.device.ListAttachedControllers(v.namespaceId)
+// DetachAllControllers detaches the volume from every controller it is currently attached to.
+func (v *Volume) DetachAllControllers(ctx context.Context) error {
+	v.storage.lock()
+	defer v.storage.unlock()
+
+	controllerIds, err := v.listAttachedControllers(ctx)
+	if err != nil {
+		// System-level failure when listing attached controllers
+		if isSystemLevelError(err) {
+			v.storage.notify(sf.UNAVAILABLE_OFFLINE_RST)
+		}
+		return err
+	}
+
+	v.log.V(2).Info("Detach namespace from all controllers", "controllerIds", controllerIds)
+
+	// Controllers are detached one at a time as not every device driver supports a list of controllers
+	for _, controllerId := range controllerIds {
+		if err := v.storage.device.DetachNamespace(ctx, v.namespaceId, []uint16{controllerId}); err != nil {
+			var cmdErr *nvme.CommandError
+			if errors.As(err, &cmdErr) && cmdErr.StatusCode == nvme.NamespaceNotAttached {
+				continue
+			}
+
+			if isSystemLevelError(err) {
+				v.storage.notify(sf.UNAVAILABLE_OFFLINE_RST)
+			}
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (v *Volume) runInAttachDetachBlock(fn func() error) error {
+func (v *Volume) listAttachedControllers(ctx context.Context) ([]uint16, error) {
+	return v.storage.device.ListAttachedControllers(ctx, v.namespaceId)
+}
+
+func (v *Volume) runInAttachDetachBlock(ctx context.Context, fn func() error) error {
 	const controllerIndex uint16 = PhysicalFunctionControllerIndex
-	if err := v.attach(controllerIndex); err != nil {
+	if err := v.attach(ctx, controllerIndex); err != nil {
 		return err
 	}
 
@@ -808,20 +1012,31 @@ func (v *Volume) runInAttachDetachBlock(fn func() error) error {
 		return err
 	}
 
-	return v.detach(controllerIndex)
+	return v.detach(ctx, controllerIndex)
 }
 
 // WaitFormatComplete waits for Format Completion by polling until the namespace Utilization reaches zero.
-func (v *Volume) WaitFormatComplete() error {
+func (v *Volume) WaitFormatComplete(ctx context.Context) error {
 	log := v.log
 
+	// The storage lock is only held while identifying the namespace so other requests to the
+	// storage device may proceed while the format completes.
+	identify := func() (*nvme.IdNs, error) {
+		v.storage.lock()
+		defer v.storage.unlock()
+
+		ns, err := v.storage.device.IdentifyNamespace(ctx, v.namespaceId)
+		if err != nil && isSystemLevelError(err) {
+			v.storage.notify(sf.UNAVAILABLE_OFFLINE_RST)
+		}
+
+		return ns, err
+	}
+
 	log.V(2).Info("Wait for format completion")
-	ns, err := v.storage.device.IdentifyNamespace(v.namespaceId)
+	ns, err := identify()
 	if err != nil {
 		// System-level failure when identifying namespace during format wait
-		if isSystemLevelError(err) {
-			v.storage.notify(sf.UNAVAILABLE_OFFLINE_RST)
-		}
 		return err
 	}
 	if ns == nil {
@@ -839,12 +1054,9 @@ func (v *Volume) WaitFormatComplete() error {
 
 		lastUtilization := ns.Utilization
 
-		ns, err = v.storage.device.IdentifyNamespace(v.namespaceId)
+		ns, err = identify()
 		if err != nil {
 			// System-level failure when re-identifying namespace during format wait
-			if isSystemLevelError(err) {
-				v.storage.notify(sf.UNAVAILABLE_OFFLINE_RST)
-			}
 			return err
 		}
 		if ns == nil {
@@ -894,13 +1106,13 @@ func (v *Volume) controllerIDFromIndex(controllerIndex uint16) uint16 {
 	return controllerID
 }
 
-func (v *Volume) attach(controllerIndex uint16) error {
+func (v *Volume) attach(ctx context.Context, controllerIndex uint16) error {
 	controllerID := v.controllerIDFromIndex(controllerIndex)
 
 	log := v.log.WithValues(controllerIdKey, controllerID)
 	log.V(2).Info("Attach namespace", "controllerIndex", controllerIndex)
 
-	err := v.storage.device.AttachNamespace(v.namespaceId, []uint16{controllerID})
+	err := v.storage.device.AttachNamespace(ctx, v.namespaceId, []uint16{controllerID})
 	if err != nil {
 		log.Error(err, "Attach namespace failed")
 
@@ -911,7 +1123,7 @@ func (v *Volume) attach(controllerIndex uint16) error {
 				if isSystemLevelError(err) {
 					v.storage.notify(sf.UNAVAILABLE_OFFLINE_RST)
 				}
-				return err
+				return v.storage.busyError(err)
 			}
 		} else {
 			// Non-CommandError types - check if system-level
@@ -927,13 +1139,13 @@ func (v *Volume) attach(controllerIndex uint16) error {
 	return nil
 }
 
-func (v *Volume) detach(controllerIndex uint16) error {
+func (v *Volume) detach(ctx context.Context, controllerIndex uint16) error {
 	controllerID := v.controllerIDFromIndex(controllerIndex)
 
 	log := v.log.WithValues(controllerIdKey, controllerID)
 	log.V(2).Info("Detach namespace", "controllerIndex", controllerIndex)
 
-	err := v.storage.device.DetachNamespace(v.namespaceId, []uint16{controllerID})
+	err := v.storage.device.DetachNamespace(ctx, v.namespaceId, []uint16{controllerID})
 
 	if err != nil {
 		log.Error(err, "Detach namespace failed")
@@ -973,12 +1185,15 @@ func (v *Volume) detach(controllerIndex uint16) error {
 // This function logs detailed information about the operation, including the storage ID,
 // volume ID, controller index, and controller ID. It also logs the list of currently
 // attached controllers and whether a reattachment is performed.
-func (v *Volume) AttachControllerIfUnattached(controllerIndex uint16) error {
+func (v *Volume) AttachControllerIfUnattached(ctx context.Context, controllerIndex uint16) error {
+	v.storage.lock()
+	defer v.storage.unlock()
+
 	controllerID := v.controllerIDFromIndex(controllerIndex)
 
 	log := v.log.WithValues("storage", v.storage.id, "volume", v.id, "controllerIndex", controllerIndex, "controllerID", controllerID)
 
-	attachedControllers, err := v.listAttachedControllers()
+	attachedControllers, err := v.listAttachedControllers(ctx)
 	if err != nil {
 		log.Error(err, "failed to list attached controllers")
 		// System-level failure when listing attached controllers
@@ -993,7 +1208,7 @@ func (v *Volume) AttachControllerIfUnattached(controllerIndex uint16) error {
 	if !controllerAttached {
 		log.Info("attaching controller to volume")
 
-		if err := v.AttachController(controllerIndex); err != nil {
+		if err := v.attach(ctx, controllerIndex); err != nil {
 			log.Error(err, "failed to reattach controller to volume")
 			return err
 		}
@@ -1075,6 +1290,10 @@ func (m *Manager) EventHandler(e event.Event) error {
 		}
 
 		storage := &m.storage[idx]
+
+		storage.lock()
+		defer storage.unlock()
+
 		storage.fabricId = fabric.FabricId
 		storage.switchId = switchId
 		storage.portId = portId
@@ -1099,7 +1318,10 @@ func (m *Manager) EventHandler(e event.Event) error {
 	return nil
 }
 
+// LinkEstablishedEventHandler initializes the storage device once its link is established. The
+// caller must hold the storage lock.
 func (s *Storage) LinkEstablishedEventHandler(switchId, portId string) error {
+	ctx := context.Background()
 	log := s.log.WithValues(switchIdKey, switchId, portIdKey, portId)
 
 	// Connect
@@ -1111,7 +1333,7 @@ func (s *Storage) LinkEstablishedEventHandler(switchId, portId string) error {
 
 	s.device = device
 
-	if err := s.initialize(); err != nil {
+	if err := s.initialize(ctx); err != nil {
 		log.Error(err, "Failed to initialize storage device")
 		return err
 	}
@@ -1120,7 +1342,7 @@ func (s *Storage) LinkEstablishedEventHandler(switchId, portId string) error {
 
 	if s.manager.purge {
 		log.Info("Purging existing volumes")
-		if err := s.purge(); err != nil {
+		if err := s.purge(ctx); err != nil {
 			log.Error(err, "Failed to purge storage volumes")
 		}
 	}
@@ -1154,7 +1376,7 @@ func (s *Storage) LinkEstablishedEventHandler(switchId, portId string) error {
 	} else {
 		log.V(2).Info("List Secondary Controllers")
 
-		ls, err := device.ListSecondary()
+		ls, err := device.ListSecondary(ctx)
 		if err != nil {
 			log.Error(err, "List Secondary failed")
 			return err
@@ -1194,7 +1416,7 @@ func (s *Storage) LinkEstablishedEventHandler(switchId, portId string) error {
 			if !s.IsKioxiaDualPortConfiguration() {
 				log.V(2).Info("Initialize VQ resources", "resources", s.config.Resources)
 				if sc.VQFlexibleResourcesAssigned != uint16(s.config.Resources) {
-					if err := s.device.AssignControllerResources(sc.SecondaryControllerID, VQResourceType, s.config.Resources); err != nil {
+					if err := s.device.AssignControllerResources(ctx, sc.SecondaryControllerID, VQResourceType, s.config.Resources); err != nil {
 						log.Error(err, "Failed to assign VQ Resources")
 						break
 					}
@@ -1204,7 +1426,7 @@ func (s *Storage) LinkEstablishedEventHandler(switchId, portId string) error {
 
 				log.V(2).Info("Initialize VI resources", "resources", s.config.Resources)
 				if sc.VIFlexibleResourcesAssigned != uint16(s.config.Resources) {
-					if err := s.device.AssignControllerResources(sc.SecondaryControllerID, VIResourceType, s.config.Resources); err != nil {
+					if err := s.device.AssignControllerResources(ctx, sc.SecondaryControllerID, VIResourceType, s.config.Resources); err != nil {
 						log.Error(err, "Failed to assign VI resources")
 						break
 					}
@@ -1214,7 +1436,7 @@ func (s *Storage) LinkEstablishedEventHandler(switchId, portId string) error {
 
 				if sc.SecondaryControllerState&0x01 == 0 {
 					log.V(2).Info("Reset controller")
-					if err := fabric.FabricController.ResetEndpoint(switchId, portId, idx); err != nil {
+					if err := fabric.FabricController.ResetEndpoint(ctx, switchId, portId, idx); err != nil {
 						log.Error(err, "Failed to reset controller")
 					}
 				}
@@ -1222,7 +1444,7 @@ func (s *Storage) LinkEstablishedEventHandler(switchId, portId string) error {
 
 			if sc.SecondaryControllerState&0x01 == 0 {
 				log.V(2).Info("Online controller")
-				if err := s.device.OnlineController(sc.SecondaryControllerID); err != nil {
+				if err := s.device.OnlineController(ctx, sc.SecondaryControllerID); err != nil {
 					log.Error(err, "Failed to online controller")
 					break
 				}
@@ -1247,7 +1469,7 @@ func (s *Storage) LinkEstablishedEventHandler(switchId, portId string) error {
 
 	// Recover existing volumes
 	log.V(2).Info("Recovering volumes")
-	if err := s.recoverStorageVolumes(); err != nil {
+	if err := s.recoverStorageVolumes(ctx); err != nil {
 		log.Error(err, "Failed to recover existing storage volumes")
 		return err
 	}
@@ -1255,11 +1477,12 @@ func (s *Storage) LinkEstablishedEventHandler(switchId, portId string) error {
 	log.Info("Storage Ready")
 	s.state = sf.ENABLED_RST
 
-	event.EventManager.Publish(msgreg.PortAutomaticallyEnabledFabric(switchId, portId))
+	s.publish(msgreg.PortAutomaticallyEnabledFabric(switchId, portId))
 
 	return nil
 }
 
+// LinkDroppedEventHandler marks the storage device offline. The caller must hold the storage lock.
 func (s *Storage) LinkDroppedEventHandler() error {
 	s.state = sf.UNAVAILABLE_OFFLINE_RST
 	s.controllers = nil
@@ -1269,22 +1492,25 @@ func (s *Storage) LinkDroppedEventHandler() error {
 }
 
 // Get -
-func (mgr *Manager) Get(model *sf.StorageCollectionStorageCollection) error {
+func (mgr *Manager) Get(ctx context.Context, model *sf.StorageCollectionStorageCollection) error {
 	model.MembersodataCount = int64(len(mgr.storage))
 	model.Members = make([]sf.OdataV4IdRef, int(model.MembersodataCount))
-	for idx, s := range mgr.storage {
-		model.Members[idx].OdataId = s.OdataId()
+	for idx := range mgr.storage {
+		model.Members[idx].OdataId = mgr.storage[idx].OdataId()
 	}
 	return nil
 }
 
 // StorageIdGet -
-func (mgr *Manager) StorageIdGet(storageId string, model *sf.StorageV190Storage) error {
+func (mgr *Manager) StorageIdGet(ctx context.Context, storageId string, model *sf.StorageV190Storage) error {
 	s := findStorage(storageId)
 	if s == nil {
 		return ec.NewErrNotFound()
 	}
 
+	s.lock()
+	defer s.unlock()
+
 	model.Id = s.id
 	model.Status = s.getStatus()
 	model.Identifiers = []sf.ResourceIdentifier{
@@ -1305,7 +1531,7 @@ func (mgr *Manager) StorageIdGet(storageId string, model *sf.StorageV190Storage)
 }
 
 // StorageIdStoragePoolsGet -
-func (mgr *Manager) StorageIdStoragePoolsGet(storageId string, model *sf.StoragePoolCollectionStoragePoolCollection) error {
+func (mgr *Manager) StorageIdStoragePoolsGet(ctx context.Context, storageId string, model *sf.StoragePoolCollectionStoragePoolCollection) error {
 	s := findStorage(storageId)
 	if s == nil {
 		return ec.NewErrNotFound()
@@ -1319,7 +1545,7 @@ func (mgr *Manager) StorageIdStoragePoolsGet(storageId string, model *sf.Storage
 }
 
 // StorageIdStoragePoolsStoragePoolIdGet -
-func (mgr *Manager) StorageIdStoragePoolsStoragePoolIdGet(storageId, storagePoolId string, model *sf.StoragePoolV150StoragePool) error {
+func (mgr *Manager) StorageIdStoragePoolsStoragePoolIdGet(ctx context.Context, storageId, storagePoolId string, model *sf.StoragePoolV150StoragePool) error {
 	if storagePoolId != DefaultStoragePoolId {
 		return ec.NewErrNotFound().WithCause(fmt.Sprintf("storage pool %s not found", storagePoolId))
 	}
@@ -1329,6 +1555,9 @@ func (mgr *Manager) StorageIdStoragePoolsStoragePoolIdGet(storageId, storagePool
 		return ec.NewErrNotFound().WithCause(fmt.Sprintf("storage %s not found", storageId))
 	}
 
+	s.lock()
+	defer s.unlock()
+
 	model.CapacityBytes = int64(s.capacityBytes)
 
 	// TODO: This should reflect the total namespaces allocated over the drive
@@ -1352,12 +1581,15 @@ func (mgr *Manager) StorageIdStoragePoolsStoragePoolIdGet(storageId, storagePool
 }
 
 // StorageIdControllersGet -
-func (mgr *Manager) StorageIdControllersGet(storageId string, model *sf.StorageControllerCollectionStorageControllerCollection) error {
+func (mgr *Manager) StorageIdControllersGet(ctx context.Context, storageId string, model *sf.StorageControllerCollectionStorageControllerCollection) error {
 	s := findStorage(storageId)
 	if s == nil {
 		return ec.NewErrNotFound()
 	}
 
+	s.lock()
+	defer s.unlock()
+
 	model.MembersodataCount = int64(len(s.controllers))
 	model.Members = make([]sf.OdataV4IdRef, model.MembersodataCount)
 	for idx, c := range s.controllers {
@@ -1368,8 +1600,16 @@ func (mgr *Manager) StorageIdControllersGet(storageId string, model *sf.StorageC
 }
 
 // StorageIdControllersControllerIdGet -
-func (mgr *Manager) StorageIdControllersControllerIdGet(storageId, controllerId string, model *sf.StorageControllerV100StorageController) error {
-	s, c := findStorageController(storageId, controllerId)
+func (mgr *Manager) StorageIdControllersControllerIdGet(ctx context.Context, storageId, controllerId string, model *sf.StorageControllerV100StorageController) error {
+	s := findStorage(storageId)
+	if s == nil {
+		return ec.NewErrNotFound().WithCause(fmt.Sprintf("Storage not found: Storage: %s", storageId))
+	}
+
+	s.lock()
+	defer s.unlock()
+
+	c := s.findController(controllerId)
 	if c == nil {
 		return ec.NewErrNotFound().WithCause(fmt.Sprintf("Storage Controller not found: Storage: %s Controller: %s", storageId, controllerId))
 	}
@@ -1380,7 +1620,7 @@ func (mgr *Manager) StorageIdControllersControllerIdGet(storageId, controllerId
 		return ec.NewErrNotFound().WithError(err).WithCause(fmt.Sprintf("Storage Controller fabric endpoint not found: Storage: %s Controller: %s", storageId, controllerId))
 	}
 
-	percentageUsage, err := GetWearLevelAsPercentageUsed(s.device)
+	percentageUsage, err := GetWearLevelAsPercentageUsed(ctx, s.device)
 	if err != nil {
 		return ec.NewErrInternalServerError().WithError(err).WithCause(fmt.Sprintf("Storage Controller failed to retrieve SMART data: Storage: %s", storageId))
 	}
@@ -1422,12 +1662,15 @@ func (mgr *Manager) StorageIdControllersControllerIdGet(storageId, controllerId
 }
 
 // StorageIdVolumesGet -
-func (mgr *Manager) StorageIdVolumesGet(storageId string, model *sf.VolumeCollectionVolumeCollection) error {
+func (mgr *Manager) StorageIdVolumesGet(ctx context.Context, storageId string, model *sf.VolumeCollectionVolumeCollection) error {
 	s := findStorage(storageId)
 	if s == nil {
 		return ec.NewErrNotFound()
 	}
 
+	s.lock()
+	defer s.unlock()
+
 	// TODO: If s.ctrl is down - fail
 
 	model.MembersodataCount = int64(len(s.volumes))
@@ -1440,15 +1683,23 @@ func (mgr *Manager) StorageIdVolumesGet(storageId string, model *sf.VolumeCollec
 }
 
 // StorageIdVolumeIdGet -
-func (mgr *Manager) StorageIdVolumeIdGet(storageId, volumeId string, model *sf.VolumeV161Volume) error {
-	s, v := findStorageVolume(storageId, volumeId)
+func (mgr *Manager) StorageIdVolumeIdGet(ctx context.Context, storageId, volumeId string, model *sf.VolumeV161Volume) error {
+	s := findStorage(storageId)
+	if s == nil {
+		return ec.NewErrNotFound()
+	}
+
+	s.lock()
+	defer s.unlock()
+
+	v := s.findVolume(volumeId)
 	if v == nil {
 		return ec.NewErrNotFound()
 	}
 
 	// TODO: If s.ctrl is down - fail
 
-	ns, err := s.device.IdentifyNamespace(nvme.NamespaceIdentifier(v.namespaceId))
+	ns, err := s.device.IdentifyNamespace(ctx, nvme.NamespaceIdentifier(v.namespaceId))
 	if err != nil {
 		v.log.Error(err, "Identify Namespace Failed")
 		// System-level failure when identifying namespace for volume retrieval
@@ -1512,28 +1763,35 @@ func (mgr *Manager) StorageIdVolumeIdGet(storageId, volumeId string, model *sf.V
 }
 
 // StorageIdVolumesPost -
-func (mgr *Manager) StorageIdVolumesPost(storageId string, model *sf.VolumeV161Volume) error {
+func (mgr *Manager) StorageIdVolumesPost(ctx context.Context, storageId string, model *sf.VolumeV161Volume) error {
 	s := findStorage(storageId)
 	if s == nil {
 		return ec.NewErrNotFound()
 	}
 
-	volume, err := s.createVolume(uint64(model.CapacityBytes))
+	volume, err := CreateVolume(ctx, s, uint64(model.CapacityBytes))
 
 	// TODO: We should parse the error and make it more obvious (404, 405, etc)
 	if err != nil {
 		return err
 	}
 
-	return mgr.StorageIdVolumeIdGet(storageId, volume.id, model)
+	return mgr.StorageIdVolumeIdGet(ctx, storageId, volume.id, model)
 }
 
 // StorageIdVolumeIdDelete -
-func (mgr *Manager) StorageIdVolumeIdDelete(storageId, volumeId string) error {
-	s, v := findStorageVolume(storageId, volumeId)
-	if v == nil {
+func (mgr *Manager) StorageIdVolumeIdDelete(ctx context.Context, storageId, volumeId string) error {
+	s := findStorage(storageId)
+	if s == nil {
+		return ec.NewErrBadRequest().WithCause(fmt.Sprintf("storage volume id %s not found", volumeId))
+	}
+
+	s.lock()
+	defer s.unlock()
+
+	if s.findVolume(volumeId) == nil {
 		return ec.NewErrBadRequest().WithCause(fmt.Sprintf("storage volume id %s not found", volumeId))
 	}
 
-	return s.deleteVolume(volumeId)
+	return s.deleteVolume(ctx, volumeId)
 }
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package nvme
+
+import (
+	"strconv"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// Metrics - Drive metrics report the capacity of each storage device and the SMART values last read
+// by the drive monitor. Drives the monitor has not yet polled report capacity only; a scrape never
+// reads the SMART log itself. Values are read from the snapshot taken when the drive was last unlocked,
+// so a scrape is not held up by device commands, such as volume creation, that hold the drive lock.
+
+const kelvinOffset = 273
+
+func (m *Manager) metrics() []ec.MetricFamily {
+	gauge := func(name, help string) ec.MetricFamily {
+		return ec.MetricFamily{Name: name, Help: help, Type: ec.GaugeMetricType}
+	}
+	counter := func(name, help string) ec.MetricFamily {
+		return ec.MetricFamily{Name: name, Help: help, Type: ec.CounterMetricType}
+	}
+
+	enabled := gauge("nnf_ec_drive_enabled", "Whether the drive is enabled")
+	capacity := gauge("nnf_ec_drive_capacity_bytes", "Capacity of the drive")
+	unallocated := gauge("nnf_ec_drive_unallocated_bytes", "Capacity of the drive not allocated to volumes")
+	temperature := gauge("nnf_ec_drive_temperature_celsius", "Composite temperature of the drive")
+	availableSpare := gauge("nnf_ec_drive_available_spare_percent", "Remaining spare capacity of the drive")
+	percentageUsed := gauge("nnf_ec_drive_percentage_used", "Estimate of the drive life used")
+	criticalWarning := gauge("nnf_ec_drive_critical_warning", "Critical warning bits of the SMART log")
+	mediaErrors := counter("nnf_ec_drive_media_errors_total", "Unrecovered data integrity errors detected by the drive")
+	powerOnHours := counter("nnf_ec_drive_power_on_hours_total", "Hours the drive has been powered on")
+	unsafeShutdowns := counter("nnf_ec_drive_unsafe_shutdowns_total", "Unsafe shutdowns of the drive")
+
+	for _, storage := range GetStorage() {
+		s := storage.metrics.Load()
+		if s == nil {
+			continue
+		}
+
+		labels := ec.MetricLabels{"drive": storage.id, "slot": strconv.FormatInt(s.slot, 10), "serial": s.serialNumber}
+		sample := func(f *ec.MetricFamily, value float64) {
+			f.Samples = append(f.Samples, ec.MetricSample{Labels: labels, Value: value})
+		}
+
+		isEnabled := 0.0
+		if s.state == sf.ENABLED_RST {
+			isEnabled = 1.0
+		}
+
+		sample(&enabled, isEnabled)
+		sample(&capacity, float64(s.capacityBytes))
+		sample(&unallocated, float64(s.unallocatedBytes))
+
+		if log := s.smartLog; log != nil {
+			w := log.CriticalWarning
+			warning := w.SpareCapacity | w.Temperature<<1 | w.Degraded<<2 | w.ReadOnly<<3 | w.BackupFailed<<4 | w.PersistentMemoryRegionReadOnly<<5
+
+			sample(&temperature, float64(int(log.CompositeTemperature)-kelvinOffset))
+			sample(&availableSpare, float64(log.AvailableSpare))
+			sample(&percentageUsed, float64(log.PercentageUsed))
+			sample(&criticalWarning, float64(warning))
+			sample(&mediaErrors, float64(log.MediaErrorsLo))
+			sample(&powerOnHours, float64(log.PowerOnHoursLo))
+			sample(&unsafeShutdowns, float64(log.UnsafeShutdownsLo))
+		}
+	}
+
+	return []ec.MetricFamily{enabled, capacity, unallocated, temperature, availableSpare, percentageUsed, criticalWarning, mediaErrors, powerOnHours, unsafeShutdowns}
+}
@@ -20,7 +20,9 @@
 package nvme
 
 import (
+	"context"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/NearNodeFlash/nnf-ec/internal/switchtec/pkg/nvme"
@@ -48,6 +50,7 @@ func StartNVMeMonitor(log ec.Logger) {
 	}
 
 	monitor := NewMonitor(log, driveMonitorPeriod)
+	mgr.monitor.Store(monitor)
 	go monitor.Run()
 	log.Info("Started NVMe monitor", "monitorPeriod", driveMonitorPeriod)
 }
@@ -58,14 +61,20 @@ type Monitor struct {
 	Log        ec.Logger
 	Interval   time.Duration
 	GetDevices func() []*nvme.Device // Function to return all NVMe devices
+
+	lastPoll atomic.Int64 // Time the devices were last polled, in Unix nanoseconds
 }
 
 // NewMonitor creates a new NVMe monitor with the given polling interval and device getter.
 func NewMonitor(log ec.Logger, interval time.Duration) *Monitor {
-	return &Monitor{
+	m := &Monitor{
 		Log:      log,
 		Interval: interval,
 	}
+
+	m.lastPoll.Store(time.Now().UnixNano())
+
+	return m
 }
 
 // Run starts the monitor loop. It periodically calls GetSmartLog on all devices.
@@ -81,23 +90,30 @@ func (m *Monitor) Run() {
 
 			m.checkSmartLog(storage)
 		}
+
+		m.lastPoll.Store(time.Now().UnixNano())
 	}
 }
 
 func (m *Monitor) checkSmartLog(storage *Storage) {
 	log := m.Log
 
-	smartLog, err := storage.device.GetSmartLog()
+	storage.lock()
+	defer storage.unlock()
+
+	smartLog, err := storage.device.GetSmartLog(context.Background())
 	if err != nil {
-		log.Error(err, "smartlog request failed", "serial", storage.SerialNumber(), "slot", storage.Slot())
+		log.Error(err, "smartlog request failed", "serial", storage.serialNumber, "slot", storage.slot)
 		storage.notify(sf.UNAVAILABLE_OFFLINE_RST)
 	} else {
 
 		// nvme.MangleSmartLog(smartLog)
 
+		storage.smartLog = smartLog
+
 		state := nvme.InterpretSmartLog(smartLog)
 		if state != storage.state {
-			log.Info("smartlog state change", "old state", storage.state, "new state", state, "serial", storage.SerialNumber(), "slot", storage.Slot())
+			log.Info("smartlog state change", "old state", storage.state, "new state", state, "serial", storage.serialNumber, "slot", storage.slot)
 			storage.notify(state)
 
 			nvme.LogSmartLog(log, smartLog)
@@ -20,6 +20,7 @@
 package nvme
 
 import (
+	"context"
 	"github.com/NearNodeFlash/nnf-ec/internal/switchtec/pkg/nvme"
 )
 
@@ -38,34 +39,35 @@ type NvmeDeviceController interface {
 type NvmeDeviceApi interface {
 	IsDirectDevice() bool
 
-	IdentifyController(controllerId uint16) (*nvme.IdCtrl, error)
-	IdentifyNamespace(namespaceId nvme.NamespaceIdentifier) (*nvme.IdNs, error)
+	IdentifyController(ctx context.Context, controllerId uint16) (*nvme.IdCtrl, error)
+	IdentifyNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier) (*nvme.IdNs, error)
 
-	ListSecondary() (*nvme.SecondaryControllerList, error)
+	ListSecondary(ctx context.Context) (*nvme.SecondaryControllerList, error)
 
 	AssignControllerResources(
+		ctx context.Context,
 		controllerId uint16,
 		resourceType SecondaryControllerResourceType,
 		numResources uint32) error
 
-	OnlineController(controllerId uint16) error
+	OnlineController(ctx context.Context, controllerId uint16) error
 
-	ListNamespaces(controllerId uint16) ([]nvme.NamespaceIdentifier, error)
-	ListAttachedControllers(namespaceId nvme.NamespaceIdentifier) ([]uint16, error)
+	ListNamespaces(ctx context.Context, controllerId uint16) ([]nvme.NamespaceIdentifier, error)
+	ListAttachedControllers(ctx context.Context, namespaceId nvme.NamespaceIdentifier) ([]uint16, error)
 
-	CreateNamespace(sizeInSectors uint64, sectorSizeIndex uint8) (nvme.NamespaceIdentifier, nvme.NamespaceGloballyUniqueIdentifier, error)
-	DeleteNamespace(namespaceId nvme.NamespaceIdentifier) error
+	CreateNamespace(ctx context.Context, sizeInSectors uint64, sectorSizeIndex uint8) (nvme.NamespaceIdentifier, nvme.NamespaceGloballyUniqueIdentifier, error)
+	DeleteNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier) error
 
-	FormatNamespace(namespaceID nvme.NamespaceIdentifier) error
+	FormatNamespace(ctx context.Context, namespaceID nvme.NamespaceIdentifier) error
 
-	AttachNamespace(namespaceId nvme.NamespaceIdentifier, controllers []uint16) error
-	DetachNamespace(namespaceId nvme.NamespaceIdentifier, controllers []uint16) error
+	AttachNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier, controllers []uint16) error
+	DetachNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier, controllers []uint16) error
 
-	SetNamespaceFeature(namespaceId nvme.NamespaceIdentifier, data []byte) error
-	GetNamespaceFeature(namespaceId nvme.NamespaceIdentifier) ([]byte, error)
+	SetNamespaceFeature(ctx context.Context, namespaceId nvme.NamespaceIdentifier, data []byte) error
+	GetNamespaceFeature(ctx context.Context, namespaceId nvme.NamespaceIdentifier) ([]byte, error)
 
 	// GetSmartLog returns the raw SMART log page data
-	GetSmartLog() (*nvme.SmartLog, error)
+	GetSmartLog(ctx context.Context) (*nvme.SmartLog, error)
 }
 
 // SecondaryControllersInitFunc -
@@ -83,8 +85,8 @@ const (
 )
 
 // GetWearLevelAsPercentageUsed returns the PercentageUsed field from the SMART log page.
-func GetWearLevelAsPercentageUsed(dev NvmeDeviceApi) (uint8, error) {
-	smartLog, err := dev.GetSmartLog()
+func GetWearLevelAsPercentageUsed(ctx context.Context, dev NvmeDeviceApi) (uint8, error) {
+	smartLog, err := dev.GetSmartLog(ctx)
 	if err != nil {
 		return 0, err
 	}
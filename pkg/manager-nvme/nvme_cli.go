@@ -22,6 +22,7 @@ package nvme
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"os/exec"
 	"regexp"
@@ -82,12 +83,12 @@ func (d *cliDevice) dev() string {
 func (d *cliDevice) IsDirectDevice() bool { return false }
 
 // IdentifyController -
-func (d *cliDevice) IdentifyController(controllerId uint16) (*nvme.IdCtrl, error) {
+func (d *cliDevice) IdentifyController(ctx context.Context, controllerId uint16) (*nvme.IdCtrl, error) {
 	if controllerId != 0 {
 		panic("Identify Controller: non-zero controller ID not yet supported")
 	}
 
-	rsp, err := d.run(fmt.Sprintf("id-ctrl %s --output-format=binary", d.dev()))
+	rsp, err := d.run(ctx, fmt.Sprintf("id-ctrl %s --output-format=binary", d.dev()))
 	if err != nil {
 		return nil, err
 	}
@@ -100,13 +101,13 @@ func (d *cliDevice) IdentifyController(controllerId uint16) (*nvme.IdCtrl, error
 }
 
 // IdentifyNamespace -
-func (d *cliDevice) IdentifyNamespace(namespaceId nvme.NamespaceIdentifier) (*nvme.IdNs, error) {
+func (d *cliDevice) IdentifyNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier) (*nvme.IdNs, error) {
 	opts := ""
 	if namespaceId != CommonNamespaceIdentifier {
 		opts = "--force"
 	}
 
-	rsp, err := d.run(fmt.Sprintf("id-ns %s --namespace-id=%d %s --output-format=binary", d.dev(), namespaceId, opts))
+	rsp, err := d.run(ctx, fmt.Sprintf("id-ns %s --namespace-id=%d %s --output-format=binary", d.dev(), namespaceId, opts))
 	if err != nil {
 		return nil, err
 	}
@@ -118,8 +119,8 @@ func (d *cliDevice) IdentifyNamespace(namespaceId nvme.NamespaceIdentifier) (*nv
 	return ns, err
 }
 
-func (d *cliDevice) ListSecondary() (*nvme.SecondaryControllerList, error) {
-	rsp, err := d.run(fmt.Sprintf("list-secondary %s --output-format=binary", d.dev()))
+func (d *cliDevice) ListSecondary(ctx context.Context) (*nvme.SecondaryControllerList, error) {
+	rsp, err := d.run(ctx, fmt.Sprintf("list-secondary %s --output-format=binary", d.dev()))
 	if err != nil {
 		return nil, err
 	}
@@ -131,9 +132,9 @@ func (d *cliDevice) ListSecondary() (*nvme.SecondaryControllerList, error) {
 	return ls, err
 }
 
-func (d *cliDevice) AssignControllerResources(controllerId uint16, resourceType SecondaryControllerResourceType, numResources uint32) error {
+func (d *cliDevice) AssignControllerResources(ctx context.Context, controllerId uint16, resourceType SecondaryControllerResourceType, numResources uint32) error {
 	rt := map[SecondaryControllerResourceType]int{VQResourceType: 0, VIResourceType: 1}[resourceType]
-	rsp, err := d.run(fmt.Sprintf("virt-mgmt %s --cntlid=%d --rt=%d --nr=%d --act=8", d.dev(), controllerId, rt, numResources))
+	rsp, err := d.run(ctx, fmt.Sprintf("virt-mgmt %s --cntlid=%d --rt=%d --nr=%d --act=8", d.dev(), controllerId, rt, numResources))
 	if err != nil {
 		return err
 	}
@@ -145,8 +146,8 @@ func (d *cliDevice) AssignControllerResources(controllerId uint16, resourceType
 	return nil
 }
 
-func (d *cliDevice) OnlineController(controllerId uint16) error {
-	rsp, err := d.run(fmt.Sprintf("virt-mgmt %s --cntlid=%d --act=9", d.dev(), controllerId))
+func (d *cliDevice) OnlineController(ctx context.Context, controllerId uint16) error {
+	rsp, err := d.run(ctx, fmt.Sprintf("virt-mgmt %s --cntlid=%d --act=9", d.dev(), controllerId))
 	if err != nil {
 		return err
 	}
@@ -157,12 +158,12 @@ func (d *cliDevice) OnlineController(controllerId uint16) error {
 	return nil
 }
 
-func (d *cliDevice) ListNamespaces(controllerId uint16) ([]nvme.NamespaceIdentifier, error) {
+func (d *cliDevice) ListNamespaces(ctx context.Context, controllerId uint16) ([]nvme.NamespaceIdentifier, error) {
 	if controllerId != 0 {
 		panic("List Namespaces: non-zero controller ID not yet supported")
 	}
 
-	rsp, err := d.run(fmt.Sprintf("list-ns %s --all", d.dev()))
+	rsp, err := d.run(ctx, fmt.Sprintf("list-ns %s --all", d.dev()))
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +188,7 @@ func (d *cliDevice) ListNamespaces(controllerId uint16) ([]nvme.NamespaceIdentif
 	return nsids, scanner.Err()
 }
 
-func (d *cliDevice) ListAttachedControllers(namespaceId nvme.NamespaceIdentifier) ([]uint16, error) {
+func (d *cliDevice) ListAttachedControllers(ctx context.Context, namespaceId nvme.NamespaceIdentifier) ([]uint16, error) {
 	// Example Output:
 	// 	  # nvme list-ctrl --namespace-id=1 /dev/nvme1
 	//    num of ctrls present: 1
@@ -196,7 +197,7 @@ func (d *cliDevice) ListAttachedControllers(namespaceId nvme.NamespaceIdentifier
 	// NOTE: Binary format would be create here as it returns an nvme.CtrlList; but this format is missing
 	//       from the latest nvme-cli
 
-	rsp, err := d.run(fmt.Sprintf("list-ctrl %s --namespace-id=%d", d.dev(), namespaceId))
+	rsp, err := d.run(ctx, fmt.Sprintf("list-ctrl %s --namespace-id=%d", d.dev(), namespaceId))
 	if err != nil {
 		return nil, err
 	}
@@ -220,12 +221,12 @@ func (d *cliDevice) ListAttachedControllers(namespaceId nvme.NamespaceIdentifier
 	return controllerIds, nil
 }
 
-func (d *cliDevice) CreateNamespace(sizeInSectors uint64, sectorSizeIndex uint8) (nvme.NamespaceIdentifier, nvme.NamespaceGloballyUniqueIdentifier, error) {
+func (d *cliDevice) CreateNamespace(ctx context.Context, sizeInSectors uint64, sectorSizeIndex uint8) (nvme.NamespaceIdentifier, nvme.NamespaceGloballyUniqueIdentifier, error) {
 	// Example Command
 	//    # nvme create-ns /dev/nvme2 --nsze=468843606 --ncap=468843606 --flbas=3 --nmic=1
 	//    create-ns: Success, created nsid:1
 
-	rsp, err := d.run(fmt.Sprintf("create-ns %s --nsze=%d --ncap=%d --flbas=%d --nmic=1", d.dev(), sizeInSectors, sizeInSectors, sectorSizeIndex))
+	rsp, err := d.run(ctx, fmt.Sprintf("create-ns %s --nsze=%d --ncap=%d --flbas=%d --nmic=1", d.dev(), sizeInSectors, sizeInSectors, sectorSizeIndex))
 	if err != nil {
 		return 0, nvme.NamespaceGloballyUniqueIdentifier{}, err
 	}
@@ -241,7 +242,7 @@ func (d *cliDevice) CreateNamespace(sizeInSectors uint64, sectorSizeIndex uint8)
 		return 0, nvme.NamespaceGloballyUniqueIdentifier{}, err
 	}
 
-	ns, err := d.IdentifyNamespace(nvme.NamespaceIdentifier(nsid))
+	ns, err := d.IdentifyNamespace(ctx, nvme.NamespaceIdentifier(nsid))
 	if err != nil {
 		return 0, nvme.NamespaceGloballyUniqueIdentifier{}, err
 	}
@@ -249,12 +250,12 @@ func (d *cliDevice) CreateNamespace(sizeInSectors uint64, sectorSizeIndex uint8)
 	return nvme.NamespaceIdentifier(nsid), ns.GloballyUniqueIdentifier, nil
 }
 
-func (d *cliDevice) DeleteNamespace(namespaceId nvme.NamespaceIdentifier) error {
+func (d *cliDevice) DeleteNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier) error {
 	// Example Command
 	//    # nvme delete-ns --namespace-id=1 /dev/nvme2
 	//    delete-ns: Success, deleted nsid:1
 
-	rsp, err := d.run(fmt.Sprintf("delete-ns %s --namespace-id=%d", d.dev(), namespaceId))
+	rsp, err := d.run(ctx, fmt.Sprintf("delete-ns %s --namespace-id=%d", d.dev(), namespaceId))
 	if err != nil {
 		return err
 	}
@@ -277,12 +278,12 @@ func (d *cliDevice) DeleteNamespace(namespaceId nvme.NamespaceIdentifier) error
 	return nil
 }
 
-func (d *cliDevice) FormatNamespace(namespaceID nvme.NamespaceIdentifier) error {
+func (d *cliDevice) FormatNamespace(ctx context.Context, namespaceID nvme.NamespaceIdentifier) error {
 	// Example Command
 	//    # nvme format --force --namespace-id=1 /dev/nvme2
 	//    Success formatting namespace:1
 
-	rsp, err := d.run(fmt.Sprintf("format %s --force --namespace-id=%d", d.dev(), namespaceID))
+	rsp, err := d.run(ctx, fmt.Sprintf("format %s --force --namespace-id=%d", d.dev(), namespaceID))
 	if err != nil {
 		return err
 	}
@@ -305,7 +306,7 @@ func (d *cliDevice) FormatNamespace(namespaceID nvme.NamespaceIdentifier) error
 	return nil
 }
 
-func (d *cliDevice) AttachNamespace(namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
+func (d *cliDevice) AttachNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
 	// Example Command
 	//    # nvme attach-ns /dev/nvme2 --namespace-id=1 --controllers=0x41
 	//    attach-ns: Success, nsid:1
@@ -313,7 +314,7 @@ func (d *cliDevice) AttachNamespace(namespaceId nvme.NamespaceIdentifier, contro
 		panic("Only a single controller can be attached at a time by the CLI Device Driver")
 	}
 
-	rsp, err := d.run(fmt.Sprintf("attach-ns %s --namespace-id=%d --controllers=%d", d.dev(), namespaceId, controllers[0]))
+	rsp, err := d.run(ctx, fmt.Sprintf("attach-ns %s --namespace-id=%d --controllers=%d", d.dev(), namespaceId, controllers[0]))
 	if err != nil {
 		return err
 	}
@@ -336,7 +337,7 @@ func (d *cliDevice) AttachNamespace(namespaceId nvme.NamespaceIdentifier, contro
 	return nil
 }
 
-func (d *cliDevice) DetachNamespace(namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
+func (d *cliDevice) DetachNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
 	// Example Command
 	//    # nvme attach-ns /dev/nvme2 --namespace-id=1 --controllers=0x41
 	//    attach-ns: Success, nsid:1
@@ -344,7 +345,7 @@ func (d *cliDevice) DetachNamespace(namespaceId nvme.NamespaceIdentifier, contro
 		panic("Only a single controller can be detached at a time by the CLI Device Driver")
 	}
 
-	rsp, err := d.run(fmt.Sprintf("detach-ns %s --namespace-id=%d --controllers=%d", d.dev(), namespaceId, controllers[0]))
+	rsp, err := d.run(ctx, fmt.Sprintf("detach-ns %s --namespace-id=%d --controllers=%d", d.dev(), namespaceId, controllers[0]))
 	if err != nil {
 		return err
 	}
@@ -367,17 +368,17 @@ func (d *cliDevice) DetachNamespace(namespaceId nvme.NamespaceIdentifier, contro
 	return nil
 }
 
-func (*cliDevice) SetNamespaceFeature(namespaceId nvme.NamespaceIdentifier, data []byte) error {
+func (*cliDevice) SetNamespaceFeature(ctx context.Context, namespaceId nvme.NamespaceIdentifier, data []byte) error {
 	return nil
 }
 
-func (*cliDevice) GetNamespaceFeature(namespaceId nvme.NamespaceIdentifier) ([]byte, error) {
+func (*cliDevice) GetNamespaceFeature(ctx context.Context, namespaceId nvme.NamespaceIdentifier) ([]byte, error) {
 	return nil, nil
 }
 
 // GetSmartLog returns the raw SMART log page data
-func (d *cliDevice) GetSmartLog() (*nvme.SmartLog, error) {
-	rsp, err := d.run(fmt.Sprintf("smart-log %s --output-format=binary", d.dev()))
+func (d *cliDevice) GetSmartLog(ctx context.Context) (*nvme.SmartLog, error) {
+	rsp, err := d.run(ctx, fmt.Sprintf("smart-log %s --output-format=binary", d.dev()))
 	if err != nil {
 		return nil, err
 	}
@@ -391,9 +392,9 @@ func (d *cliDevice) GetSmartLog() (*nvme.SmartLog, error) {
 	return log, nil
 }
 
-func (d *cliDevice) run(cmd string) (string, error) {
+func (d *cliDevice) run(ctx context.Context, cmd string) (string, error) {
 
-	rsp, err := logging.Cli.Trace(cmd, func(cmd string) ([]byte, error) {
+	rsp, err := logging.Cli.Trace(ctx, cmd, func(cmd string) ([]byte, error) {
 		return exec.Command("bash", "-c", fmt.Sprintf("/usr/sbin/%s %s", d.command, cmd)).Output()
 	})
 
@@ -20,6 +20,7 @@
 package nvme
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 	"unsafe"
@@ -76,12 +77,12 @@ func newNvmeDevice(fabricId, switchId, portId string) (NvmeDeviceApi, error) {
 func (d *nvmeDevice) IsDirectDevice() bool { return false }
 
 // IdentifyController -
-func (d *nvmeDevice) IdentifyController(controllerId uint16) (*nvme.IdCtrl, error) {
+func (d *nvmeDevice) IdentifyController(ctx context.Context, controllerId uint16) (*nvme.IdCtrl, error) {
 	return d.dev.IdentifyController()
 }
 
 // IdentifyNamespace -
-func (d *nvmeDevice) IdentifyNamespace(namespaceId nvme.NamespaceIdentifier) (*nvme.IdNs, error) {
+func (d *nvmeDevice) IdentifyNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier) (*nvme.IdNs, error) {
 	// NVMe specification defines two ways to identify namespaces.
 	// 1) Through Active Namespace Management, returns Identify Namespace data structure for the specific
 	//    NSID active on the controller, or the common namespace capabilities structure.
@@ -101,12 +102,12 @@ func (d *nvmeDevice) IdentifyNamespace(namespaceId nvme.NamespaceIdentifier) (*n
 }
 
 // ListSecondary -
-func (d *nvmeDevice) ListSecondary() (*nvme.SecondaryControllerList, error) {
+func (d *nvmeDevice) ListSecondary(ctx context.Context) (*nvme.SecondaryControllerList, error) {
 	return d.dev.ListSecondary(0, 0)
 }
 
 // AssignControllerResources -
-func (d *nvmeDevice) AssignControllerResources(controllerId uint16, resourceType SecondaryControllerResourceType, numResources uint32) error {
+func (d *nvmeDevice) AssignControllerResources(ctx context.Context, controllerId uint16, resourceType SecondaryControllerResourceType, numResources uint32) error {
 	resourceTypeMap := map[SecondaryControllerResourceType]nvme.VirtualManagementResourceType{
 		VQResourceType: nvme.VQResourceType,
 		VIResourceType: nvme.VIResourceType,
@@ -116,12 +117,12 @@ func (d *nvmeDevice) AssignControllerResources(controllerId uint16, resourceType
 }
 
 // OnlineController -
-func (d *nvmeDevice) OnlineController(controllerId uint16) error {
+func (d *nvmeDevice) OnlineController(ctx context.Context, controllerId uint16) error {
 	return d.dev.VirtualMgmt(controllerId, nvme.SecondaryOnlineAction, nvme.VQResourceType /*Ignored for OnlineAction*/, 0 /*Ignored for OnlineAction*/)
 }
 
 // ListNamespaces -
-func (d *nvmeDevice) ListNamespaces(controllerId uint16) ([]nvme.NamespaceIdentifier, error) {
+func (d *nvmeDevice) ListNamespaces(ctx context.Context, controllerId uint16) ([]nvme.NamespaceIdentifier, error) {
 	list, err := d.dev.IdentifyNamespaceList(0, true)
 	if err != nil {
 		return nil, err
@@ -141,7 +142,7 @@ func (d *nvmeDevice) ListNamespaces(controllerId uint16) ([]nvme.NamespaceIdenti
 }
 
 // ListAttachedControllers -
-func (d *nvmeDevice) ListAttachedControllers(namespaceId nvme.NamespaceIdentifier) ([]uint16, error) {
+func (d *nvmeDevice) ListAttachedControllers(ctx context.Context, namespaceId nvme.NamespaceIdentifier) ([]uint16, error) {
 	list, err := d.dev.IdentifyNamespaceControllerList(uint32(namespaceId))
 	if err != nil {
 		return nil, err
@@ -156,7 +157,7 @@ func (d *nvmeDevice) GetNamespace(namespaceId nvme.NamespaceIdentifier) (*nvme.I
 }
 
 // CreateNamespace -
-func (d *nvmeDevice) CreateNamespace(sizeInSectors uint64, sectorSizeIndex uint8) (nvme.NamespaceIdentifier, nvme.NamespaceGloballyUniqueIdentifier, error) {
+func (d *nvmeDevice) CreateNamespace(ctx context.Context, sizeInSectors uint64, sectorSizeIndex uint8) (nvme.NamespaceIdentifier, nvme.NamespaceGloballyUniqueIdentifier, error) {
 
 	id, err := d.dev.CreateNamespace(
 		sizeInSectors,   // Size in Data Size Units (usually 4096)
@@ -183,22 +184,22 @@ func (d *nvmeDevice) CreateNamespace(sizeInSectors uint64, sectorSizeIndex uint8
 }
 
 // DeleteNamespace -
-func (d *nvmeDevice) DeleteNamespace(namespaceId nvme.NamespaceIdentifier) error {
+func (d *nvmeDevice) DeleteNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier) error {
 	return d.dev.DeleteNamespace(uint32(namespaceId))
 }
 
 // FormatNamespace -
-func (d *nvmeDevice) FormatNamespace(namespaceID nvme.NamespaceIdentifier) error {
+func (d *nvmeDevice) FormatNamespace(ctx context.Context, namespaceID nvme.NamespaceIdentifier) error {
 	return d.dev.FormatNamespace(uint32(namespaceID))
 }
 
 // AttachNamespace -
-func (d *nvmeDevice) AttachNamespace(namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
+func (d *nvmeDevice) AttachNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
 	return d.dev.AttachNamespace(uint32(namespaceId), controllers)
 }
 
 // DetachNamespace -
-func (d *nvmeDevice) DetachNamespace(namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
+func (d *nvmeDevice) DetachNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
 	return d.dev.DetachNamespace(uint32(namespaceId), controllers)
 }
 
@@ -206,7 +207,7 @@ const (
 	DefaultFeatureType = 1
 )
 
-func (d *nvmeDevice) SetNamespaceFeature(namespaceId nvme.NamespaceIdentifier, data []byte) error {
+func (d *nvmeDevice) SetNamespaceFeature(ctx context.Context, namespaceId nvme.NamespaceIdentifier, data []byte) error {
 	builder := nvme.NewMiFeatureBuilder()
 	builder.AddElement(DefaultFeatureType, 0, data)
 
@@ -216,7 +217,7 @@ func (d *nvmeDevice) SetNamespaceFeature(namespaceId nvme.NamespaceIdentifier, d
 	return d.dev.SetFeature(uint32(namespaceId), nvme.MiNamespaceMetadata, 0, false, uint32(len(buf)), buf)
 }
 
-func (d *nvmeDevice) GetNamespaceFeature(namespaceId nvme.NamespaceIdentifier) ([]byte, error) {
+func (d *nvmeDevice) GetNamespaceFeature(ctx context.Context, namespaceId nvme.NamespaceIdentifier) ([]byte, error) {
 	buf := make([]byte, nvme.FeatureBufferLength[nvme.MiNamespaceMetadata])
 
 	if err := d.dev.GetFeature(uint32(namespaceId), nvme.MiNamespaceMetadata, 0, 0, uint32(len(buf)), buf); err != nil {
@@ -248,6 +249,6 @@ func (d *nvmeDevice) GetNamespaceFeature(namespaceId nvme.NamespaceIdentifier) (
 }
 
 // GetSmartLog returns the raw SMART log page data
-func (d *nvmeDevice) GetSmartLog() (*nvme.SmartLog, error) {
+func (d *nvmeDevice) GetSmartLog(ctx context.Context) (*nvme.SmartLog, error) {
 	return d.dev.GetSmartLog()
 }
@@ -20,6 +20,7 @@
 package nvme
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/NearNodeFlash/nnf-ec/internal/switchtec/pkg/nvme"
@@ -86,63 +87,63 @@ type nvmeDirectDevice struct {
 
 func (d *nvmeDirectDevice) IsDirectDevice() bool { return true }
 
-func (d *nvmeDirectDevice) IdentifyController(controllerId uint16) (*nvme.IdCtrl, error) {
-	return d.cliDevice.IdentifyController(controllerId)
+func (d *nvmeDirectDevice) IdentifyController(ctx context.Context, controllerId uint16) (*nvme.IdCtrl, error) {
+	return d.cliDevice.IdentifyController(ctx, controllerId)
 }
 
-func (d *nvmeDirectDevice) IdentifyNamespace(namespaceId nvme.NamespaceIdentifier) (*nvme.IdNs, error) {
-	return d.cliDevice.IdentifyNamespace(namespaceId)
+func (d *nvmeDirectDevice) IdentifyNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier) (*nvme.IdNs, error) {
+	return d.cliDevice.IdentifyNamespace(ctx, namespaceId)
 }
 
-func (d *nvmeDirectDevice) ListSecondary() (*nvme.SecondaryControllerList, error) {
-	return d.cliDevice.ListSecondary()
+func (d *nvmeDirectDevice) ListSecondary(ctx context.Context) (*nvme.SecondaryControllerList, error) {
+	return d.cliDevice.ListSecondary(ctx)
 }
 
-func (d *nvmeDirectDevice) AssignControllerResources(controllerId uint16, resourceType SecondaryControllerResourceType, numResources uint32) error {
-	return d.cliDevice.AssignControllerResources(controllerId, resourceType, numResources)
+func (d *nvmeDirectDevice) AssignControllerResources(ctx context.Context, controllerId uint16, resourceType SecondaryControllerResourceType, numResources uint32) error {
+	return d.cliDevice.AssignControllerResources(ctx, controllerId, resourceType, numResources)
 }
 
-func (d *nvmeDirectDevice) OnlineController(controllerId uint16) error {
-	return d.cliDevice.OnlineController(controllerId)
+func (d *nvmeDirectDevice) OnlineController(ctx context.Context, controllerId uint16) error {
+	return d.cliDevice.OnlineController(ctx, controllerId)
 }
 
-func (d *nvmeDirectDevice) ListNamespaces(controllerId uint16) ([]nvme.NamespaceIdentifier, error) {
-	return d.cliDevice.ListNamespaces(controllerId)
+func (d *nvmeDirectDevice) ListNamespaces(ctx context.Context, controllerId uint16) ([]nvme.NamespaceIdentifier, error) {
+	return d.cliDevice.ListNamespaces(ctx, controllerId)
 }
 
-func (d *nvmeDirectDevice) ListAttachedControllers(namespaceId nvme.NamespaceIdentifier) ([]uint16, error) {
-	return d.cliDevice.ListAttachedControllers(namespaceId)
+func (d *nvmeDirectDevice) ListAttachedControllers(ctx context.Context, namespaceId nvme.NamespaceIdentifier) ([]uint16, error) {
+	return d.cliDevice.ListAttachedControllers(ctx, namespaceId)
 }
 
-func (d *nvmeDirectDevice) CreateNamespace(sizeInSectors uint64, sectorSizeIndex uint8) (nvme.NamespaceIdentifier, nvme.NamespaceGloballyUniqueIdentifier, error) {
-	return d.cliDevice.CreateNamespace(sizeInSectors, sectorSizeIndex)
+func (d *nvmeDirectDevice) CreateNamespace(ctx context.Context, sizeInSectors uint64, sectorSizeIndex uint8) (nvme.NamespaceIdentifier, nvme.NamespaceGloballyUniqueIdentifier, error) {
+	return d.cliDevice.CreateNamespace(ctx, sizeInSectors, sectorSizeIndex)
 }
 
-func (d *nvmeDirectDevice) DeleteNamespace(namespaceId nvme.NamespaceIdentifier) error {
-	return d.cliDevice.DeleteNamespace(namespaceId)
+func (d *nvmeDirectDevice) DeleteNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier) error {
+	return d.cliDevice.DeleteNamespace(ctx, namespaceId)
 }
 
-func (d *nvmeDirectDevice) FormatNamespace(namespaceId nvme.NamespaceIdentifier) error {
-	return d.cliDevice.FormatNamespace(namespaceId)
+func (d *nvmeDirectDevice) FormatNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier) error {
+	return d.cliDevice.FormatNamespace(ctx, namespaceId)
 }
 
-func (d *nvmeDirectDevice) AttachNamespace(namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
-	return d.cliDevice.AttachNamespace(namespaceId, controllers)
+func (d *nvmeDirectDevice) AttachNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
+	return d.cliDevice.AttachNamespace(ctx, namespaceId, controllers)
 }
 
-func (d *nvmeDirectDevice) DetachNamespace(namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
-	return d.cliDevice.DetachNamespace(namespaceId, controllers)
+func (d *nvmeDirectDevice) DetachNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
+	return d.cliDevice.DetachNamespace(ctx, namespaceId, controllers)
 }
 
-func (d *nvmeDirectDevice) GetNamespaceFeature(namespaceId nvme.NamespaceIdentifier) ([]byte, error) {
+func (d *nvmeDirectDevice) GetNamespaceFeature(ctx context.Context, namespaceId nvme.NamespaceIdentifier) ([]byte, error) {
 	panic("unimplemented")
 }
 
-func (*nvmeDirectDevice) SetNamespaceFeature(namespaceId nvme.NamespaceIdentifier, data []byte) error {
+func (*nvmeDirectDevice) SetNamespaceFeature(ctx context.Context, namespaceId nvme.NamespaceIdentifier, data []byte) error {
 	panic("unimplemented")
 }
 
 // GetSmartLog returns the raw SMART log page data
-func (d *nvmeDirectDevice) GetSmartLog() (*nvme.SmartLog, error) {
-	return d.cliDevice.GetSmartLog()
+func (d *nvmeDirectDevice) GetSmartLog(ctx context.Context) (*nvme.SmartLog, error) {
+	return d.cliDevice.GetSmartLog(ctx)
 }
@@ -20,6 +20,7 @@
 package nvme
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 	"math"
@@ -191,7 +192,7 @@ func (d *mockDevice) generateControllerAttributes(ctrl *nvme.IdCtrl) error {
 func (d *mockDevice) IsDirectDevice() bool { return false }
 
 // IdentifyController -
-func (d *mockDevice) IdentifyController(controllerId uint16) (*nvme.IdCtrl, error) {
+func (d *mockDevice) IdentifyController(ctx context.Context, controllerId uint16) (*nvme.IdCtrl, error) {
 	ctrl := new(nvme.IdCtrl)
 
 	if err := d.generateControllerAttributes(ctrl); err != nil {
@@ -207,7 +208,7 @@ func (d *mockDevice) IdentifyController(controllerId uint16) (*nvme.IdCtrl, erro
 }
 
 // IdentifyNamespace -
-func (d *mockDevice) IdentifyNamespace(namespaceId nvme.NamespaceIdentifier) (*nvme.IdNs, error) {
+func (d *mockDevice) IdentifyNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier) (*nvme.IdNs, error) {
 	ns := d.findNamespace(namespaceId)
 	if ns == nil {
 		return nil, fmt.Errorf("Namespace %d not found", namespaceId)
@@ -230,7 +231,7 @@ func (d *mockDevice) IdentifyNamespace(namespaceId nvme.NamespaceIdentifier) (*n
 }
 
 // ListSecondary -
-func (d *mockDevice) ListSecondary() (*nvme.SecondaryControllerList, error) {
+func (d *mockDevice) ListSecondary(ctx context.Context) (*nvme.SecondaryControllerList, error) {
 	ls := new(nvme.SecondaryControllerList)
 
 	ls.Count = uint8(len(d.controllers)) - 1
@@ -252,7 +253,7 @@ func (d *mockDevice) ListSecondary() (*nvme.SecondaryControllerList, error) {
 }
 
 // AssignControllerResources -
-func (d *mockDevice) AssignControllerResources(controllerId uint16, resourceType SecondaryControllerResourceType, numResources uint32) error {
+func (d *mockDevice) AssignControllerResources(ctx context.Context, controllerId uint16, resourceType SecondaryControllerResourceType, numResources uint32) error {
 	ctrl := &d.controllers[int(controllerId)]
 	switch resourceType {
 	case VQResourceType:
@@ -264,7 +265,7 @@ func (d *mockDevice) AssignControllerResources(controllerId uint16, resourceType
 }
 
 // OnlineController -
-func (d *mockDevice) OnlineController(controllerId uint16) error {
+func (d *mockDevice) OnlineController(ctx context.Context, controllerId uint16) error {
 	ctrl := &d.controllers[int(controllerId)]
 	ctrl.online = true
 
@@ -272,7 +273,7 @@ func (d *mockDevice) OnlineController(controllerId uint16) error {
 }
 
 // ListNamespaces -
-func (d *mockDevice) ListNamespaces(controllerId uint16) ([]nvme.NamespaceIdentifier, error) {
+func (d *mockDevice) ListNamespaces(ctx context.Context, controllerId uint16) ([]nvme.NamespaceIdentifier, error) {
 
 	list := make([]nvme.NamespaceIdentifier, 0)
 	for _, ns := range d.namespaces {
@@ -285,7 +286,7 @@ func (d *mockDevice) ListNamespaces(controllerId uint16) ([]nvme.NamespaceIdenti
 }
 
 // ListAttachedControllers
-func (d *mockDevice) ListAttachedControllers(namespaceId nvme.NamespaceIdentifier) ([]uint16, error) {
+func (d *mockDevice) ListAttachedControllers(ctx context.Context, namespaceId nvme.NamespaceIdentifier) ([]uint16, error) {
 
 	ns := d.findNamespace(namespaceId)
 
@@ -300,7 +301,7 @@ func (d *mockDevice) ListAttachedControllers(namespaceId nvme.NamespaceIdentifie
 }
 
 // CreateNamespace -
-func (d *mockDevice) CreateNamespace(sizeInSectors uint64, sectorSizeIndex uint8) (nvme.NamespaceIdentifier, nvme.NamespaceGloballyUniqueIdentifier, error) {
+func (d *mockDevice) CreateNamespace(ctx context.Context, sizeInSectors uint64, sectorSizeIndex uint8) (nvme.NamespaceIdentifier, nvme.NamespaceGloballyUniqueIdentifier, error) {
 
 	capacityInBytes := sizeInSectors * mockSectorSizeInBytes
 	unallocatedCapacityInBytes := d.capacityInBytes - d.allocatedCapacityInBytes
@@ -339,7 +340,7 @@ func (d *mockDevice) CreateNamespace(sizeInSectors uint64, sectorSizeIndex uint8
 }
 
 // DeleteNamespace -
-func (d *mockDevice) DeleteNamespace(namespaceId nvme.NamespaceIdentifier) error {
+func (d *mockDevice) DeleteNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier) error {
 	ns := d.findNamespace(namespaceId)
 	if ns == nil {
 		return fmt.Errorf("Delete Namespace: Namespace %d not found", namespaceId)
@@ -354,7 +355,7 @@ func (d *mockDevice) DeleteNamespace(namespaceId nvme.NamespaceIdentifier) error
 	}
 
 	if len(ctrls) != 0 {
-		if err := d.DetachNamespace(namespaceId, ctrls); err != nil {
+		if err := d.DetachNamespace(ctx, namespaceId, ctrls); err != nil {
 			return err
 		}
 	}
@@ -372,12 +373,12 @@ func (d *mockDevice) DeleteNamespace(namespaceId nvme.NamespaceIdentifier) error
 }
 
 // FormatNamespace -
-func (d *mockDevice) FormatNamespace(namespaceID nvme.NamespaceIdentifier) error {
+func (d *mockDevice) FormatNamespace(ctx context.Context, namespaceID nvme.NamespaceIdentifier) error {
 	return nil
 }
 
 // AttachNamespace -
-func (d *mockDevice) AttachNamespace(namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
+func (d *mockDevice) AttachNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
 	ns := d.findNamespace(namespaceId)
 	if ns == nil {
 		return fmt.Errorf("Attach Namespace: Namespace %d not found", namespaceId)
@@ -402,7 +403,7 @@ func (d *mockDevice) AttachNamespace(namespaceId nvme.NamespaceIdentifier, contr
 }
 
 // DetachNamespace -
-func (d *mockDevice) DetachNamespace(namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
+func (d *mockDevice) DetachNamespace(ctx context.Context, namespaceId nvme.NamespaceIdentifier, controllers []uint16) error {
 	ns := d.findNamespace(namespaceId)
 	if ns == nil {
 		return fmt.Errorf("Detach Namespace: Namespace %d not found", namespaceId)
@@ -426,7 +427,7 @@ func (d *mockDevice) DetachNamespace(namespaceId nvme.NamespaceIdentifier, contr
 	return nil
 }
 
-func (d *mockDevice) SetNamespaceFeature(namespaceId nvme.NamespaceIdentifier, data []byte) error {
+func (d *mockDevice) SetNamespaceFeature(ctx context.Context, namespaceId nvme.NamespaceIdentifier, data []byte) error {
 	ns := d.findNamespace(namespaceId)
 	if ns == nil {
 		return fmt.Errorf("Set Namespace Feature: Namespace %d not found", namespaceId)
@@ -435,7 +436,7 @@ func (d *mockDevice) SetNamespaceFeature(namespaceId nvme.NamespaceIdentifier, d
 	return nil
 }
 
-func (d *mockDevice) GetNamespaceFeature(namespaceId nvme.NamespaceIdentifier) ([]byte, error) {
+func (d *mockDevice) GetNamespaceFeature(ctx context.Context, namespaceId nvme.NamespaceIdentifier) ([]byte, error) {
 	ns := d.findNamespace(namespaceId)
 	if ns == nil {
 		return nil, fmt.Errorf("Get Namespace Feature: Namespace %d not found", namespaceId)
@@ -456,7 +457,7 @@ func (d *mockDevice) findNamespace(namespaceId nvme.NamespaceIdentifier) *mockNa
 }
 
 // GetSmartLog returns mock SMART log page data
-func (d *mockDevice) GetSmartLog() (*nvme.SmartLog, error) {
+func (d *mockDevice) GetSmartLog(ctx context.Context) (*nvme.SmartLog, error) {
 	// Create a mock SMART log with typical healthy values
 	log := &nvme.SmartLog{
 		CriticalWarning: struct {
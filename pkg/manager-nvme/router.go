@@ -21,6 +21,7 @@ package nvme
 
 import (
 	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
 )
 
 // Router contains all the Redfish / Swordfish API calls that are hosted by
@@ -62,6 +63,21 @@ func (r *DefaultApiRouter) Close() error {
 	return Close()
 }
 
+// Ready -
+func (r *DefaultApiRouter) Ready() ec.HealthStatus {
+	return mgr.ready()
+}
+
+// Live -
+func (r *DefaultApiRouter) Live() ec.HealthStatus {
+	return mgr.live()
+}
+
+// Metrics -
+func (r *DefaultApiRouter) Metrics() []ec.MetricFamily {
+	return mgr.metrics()
+}
+
 // Routes -
 func (r *DefaultApiRouter) Routes() ec.Routes {
 	s := r.servicer
@@ -71,54 +87,65 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Storage",
 			HandlerFunc: s.RedfishV1StorageGet,
+			Response:    sf.StorageCollectionStorageCollection{},
 		},
 		{
 			Name:        "RedfishV1StorageStorageIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Storage/{StorageId}",
 			HandlerFunc: s.RedfishV1StorageStorageIdGet,
+			Response:    sf.StorageV190Storage{},
 		},
 		{
 			Name:        "RedfishV1StorageStorageIdStoragePoolsGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Storage/{StorageId}/StoragePools",
 			HandlerFunc: s.RedfishV1StorageStorageIdStoragePoolsGet,
+			Response:    sf.StoragePoolCollectionStoragePoolCollection{},
 		},
 		{
 			Name:        "RedfishV1StorageStorageIdStoragePoolsStoragePoolIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Storage/{StorageId}/StoragePools/{StoragePoolId}",
 			HandlerFunc: s.RedfishV1StorageStorageIdStoragePoolsStoragePoolIdGet,
+			Response:    sf.StoragePoolV150StoragePool{},
 		},
 		{
 			Name:        "RedfishV1StorageStorageIdControllersGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Storage/{StorageId}/Controllers",
 			HandlerFunc: s.RedfishV1StorageStorageIdControllersGet,
+			Response:    sf.StorageControllerCollectionStorageControllerCollection{},
 		},
 		{
 			Name:        "RedfishV1StorageStorageIdControllersControllerIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Storage/{StorageId}/Controllers/{ControllerId}",
 			HandlerFunc: s.RedfishV1StorageStorageIdControllersControllerIdGet,
+			Response:    sf.StorageControllerV100StorageController{},
 		},
 		{
 			Name:        "RedfishV1StorageStorageIdVolumesGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Storage/{StorageId}/Volumes",
 			HandlerFunc: s.RedfishV1StorageStorageIdVolumesGet,
+			Response:    sf.VolumeCollectionVolumeCollection{},
 		},
 		{
 			Name:        "RedfishV1StorageStorageIdVolumesVolumeIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/Storage/{StorageId}/Volumes/{VolumeId}",
 			HandlerFunc: s.RedfishV1StorageStorageIdVolumesVolumeIdGet,
+			Response:    sf.VolumeV161Volume{},
 		},
 		{
-			Name:        "RedfishV1StorageStorageIdVolumesPost",
-			Method:      ec.POST_METHOD,
-			Path:        "/redfish/v1/Storage/{StorageId}/Volumes",
-			HandlerFunc: s.RedfishV1StorageStorageIdVolumesPost,
+			Name:               "RedfishV1StorageStorageIdVolumesPost",
+			Method:             ec.POST_METHOD,
+			Path:               "/redfish/v1/Storage/{StorageId}/Volumes",
+			HandlerFunc:        s.RedfishV1StorageStorageIdVolumesPost,
+			Response:           sf.VolumeV161Volume{},
+			Model:              sf.VolumeV161Volume{},
+			RequiredProperties: []string{"CapacityBytes"},
 		},
 		{
 			Name:        "RedfishV1StorageStorageIdVolumesVolumeIdDelete",
@@ -47,7 +47,7 @@ func (s *DefaultApiService) RedfishV1StorageGet(w http.ResponseWriter, r *http.R
 		Name:      "Storage Collection",
 	}
 
-	err := s.api.Get(&model)
+	err := s.api.Get(r.Context(), &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -63,7 +63,7 @@ func (s *DefaultApiService) RedfishV1StorageStorageIdGet(w http.ResponseWriter,
 		Name:      "Storage",
 	}
 
-	err := s.api.StorageIdGet(storageId, &model)
+	err := s.api.StorageIdGet(r.Context(), storageId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -79,7 +79,7 @@ func (s *DefaultApiService) RedfishV1StorageStorageIdStoragePoolsGet(w http.Resp
 		Name:      "Storage Pool Collection",
 	}
 
-	err := s.api.StorageIdStoragePoolsGet(storageId, &model)
+	err := s.api.StorageIdStoragePoolsGet(r.Context(), storageId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -96,7 +96,7 @@ func (s *DefaultApiService) RedfishV1StorageStorageIdStoragePoolsStoragePoolIdGe
 		Name:      "Storage Pool",
 	}
 
-	err := s.api.StorageIdStoragePoolsStoragePoolIdGet(storageId, storagePoolId, &model)
+	err := s.api.StorageIdStoragePoolsStoragePoolIdGet(r.Context(), storageId, storagePoolId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -112,7 +112,7 @@ func (s *DefaultApiService) RedfishV1StorageStorageIdControllersGet(w http.Respo
 		Name:      "Storage Controller Collection",
 	}
 
-	err := s.api.StorageIdControllersGet(storageId, &model)
+	err := s.api.StorageIdControllersGet(r.Context(), storageId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -129,7 +129,7 @@ func (s *DefaultApiService) RedfishV1StorageStorageIdControllersControllerIdGet(
 		Name:      "Storage Controller",
 	}
 
-	err := s.api.StorageIdControllersControllerIdGet(storageId, controllerId, &model)
+	err := s.api.StorageIdControllersControllerIdGet(r.Context(), storageId, controllerId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -145,7 +145,7 @@ func (s *DefaultApiService) RedfishV1StorageStorageIdVolumesGet(w http.ResponseW
 		Name:      "Volume Collection",
 	}
 
-	err := s.api.StorageIdVolumesGet(storageId, &model)
+	err := s.api.StorageIdVolumesGet(r.Context(), storageId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -162,7 +162,7 @@ func (s *DefaultApiService) RedfishV1StorageStorageIdVolumesPost(w http.Response
 		return
 	}
 
-	if err := s.api.StorageIdVolumesPost(storageId, &model); err != nil {
+	if err := s.api.StorageIdVolumesPost(r.Context(), storageId, &model); err != nil {
 		EncodeResponse(model, err, w)
 		return
 	}
@@ -186,7 +186,7 @@ func (s *DefaultApiService) RedfishV1StorageStorageIdVolumesVolumeIdGet(w http.R
 		Name:      "Volume",
 	}
 
-	err := s.api.StorageIdVolumeIdGet(storageId, volumeId, &model)
+	err := s.api.StorageIdVolumeIdGet(r.Context(), storageId, volumeId, &model)
 
 	EncodeResponse(model, err, w)
 }
@@ -197,7 +197,7 @@ func (s *DefaultApiService) RedfishV1StorageStorageIdVolumesVolumeIdDelete(w htt
 	storageId := params["StorageId"]
 	volumeId := params["VolumeId"]
 
-	err := s.api.StorageIdVolumeIdDelete(storageId, volumeId)
+	err := s.api.StorageIdVolumeIdDelete(r.Context(), storageId, volumeId)
 
 	EncodeResponse(nil, err, w)
 }
@@ -89,14 +89,14 @@ type FileSystemApi interface {
 	Type() string
 	Name() string
 
-	Create(devices []string, opts FileSystemOptions) error
-	Delete() error
+	Create(ctx context.Context, devices []string, opts FileSystemOptions) error
+	Delete(ctx context.Context) error
 
 	VgChangeActivateDefault() string
 	MkfsDefault() string
 
-	Mount(mountpoint string) error
-	Unmount(mountpoint string) error
+	Mount(ctx context.Context, mountpoint string) error
+	Unmount(ctx context.Context, mountpoint string) error
 
 	GenerateRecoveryData() map[string]string
 	LoadRecoveryData(data map[string]string)
@@ -136,7 +136,7 @@ func (f *FileSystem) mount(source string, target string, fstype string, options
 	return nil
 }
 
-func (f *FileSystem) Unmount(mountpoint string) error {
+func (f *FileSystem) Unmount(ctx context.Context, mountpoint string) error {
 	if mountpoint == "" {
 		return nil
 	}
@@ -188,8 +188,10 @@ func (e *FileSystemError) Unwrap() error {
 	return e.err
 }
 
-func (*FileSystem) run(cmd string) ([]byte, error) {
-	return logging.Cli.Trace2(logging.LogToStdout, cmd, func(cmd string) ([]byte, error) {
+// run executes the shell command. The context identifies the request for logging and tracing; the
+// command itself is bounded only by the configured timeout so a disconnecting client cannot interrupt it.
+func (*FileSystem) run(ctx context.Context, cmd string) ([]byte, error) {
+	return logging.Cli.Trace2(ctx, logging.LogToStdout, cmd, func(cmd string) ([]byte, error) {
 
 		ctx := context.Background()
 		timeoutString, found := os.LookupEnv("NNF_EC_COMMAND_TIMEOUT_SECONDS")
@@ -369,7 +371,7 @@ func (r *fileSystemRegistry) NewFileSystem(oem *FileSystemOem) (FileSystemApi, e
 	return nil, fmt.Errorf("file systems '%s' not supported", oem.Type)
 }
 
-func setFileSystemPermissions(f FileSystemApi, opts FileSystemOptions) (err error) {
+func setFileSystemPermissions(ctx context.Context, f FileSystemApi, opts FileSystemOptions) (err error) {
 	userID := 0
 	if _, exists := opts[UserID]; exists {
 		userID = opts[UserID].(int)
@@ -384,12 +386,12 @@ func setFileSystemPermissions(f FileSystemApi, opts FileSystemOptions) (err erro
 	// We mount the file system here at a temporary location and then immediately unmount
 	// it after the Chown() call.
 	mountpath := "/mnt/nnf/client/" + f.Name()
-	if err := f.Mount(mountpath); err != nil {
+	if err := f.Mount(ctx, mountpath); err != nil {
 		return err
 	}
 
 	defer func() {
-		unmountErr := f.Unmount(mountpath)
+		unmountErr := f.Unmount(ctx, mountpath)
 		if err == nil {
 			err = unmountErr
 		}
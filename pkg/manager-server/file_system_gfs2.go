@@ -20,6 +20,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -94,9 +95,9 @@ func (*FileSystemGfs2) MkfsDefault() string {
 	return "-j2 -p $PROTOCOL -t $CLUSTER_NAME:$LOCK_SPACE $DEVICE"
 }
 
-func (f *FileSystemGfs2) Create(devices []string, opts FileSystemOptions) (err error) {
+func (f *FileSystemGfs2) Create(ctx context.Context, devices []string, opts FileSystemOptions) (err error) {
 
-	if err := f.FileSystemLvm.Create(devices, opts); err != nil {
+	if err := f.FileSystemLvm.Create(ctx, devices, opts); err != nil {
 		return err
 	}
 
@@ -108,13 +109,13 @@ func (f *FileSystemGfs2) Create(devices []string, opts FileSystemOptions) (err e
 	})
 	mkfsArgs := varHandler.ReplaceAll(f.MkfsMount.Mkfs)
 
-	if _, err := f.run(fmt.Sprintf("mkfs.gfs2 -O %s", mkfsArgs)); err != nil {
+	if _, err := f.run(ctx, fmt.Sprintf("mkfs.gfs2 -O %s", mkfsArgs)); err != nil {
 		return err
 	}
 
-	return setFileSystemPermissions(f, opts)
+	return setFileSystemPermissions(ctx, f, opts)
 }
 
-func (f *FileSystemGfs2) Mount(mountpoint string) error {
+func (f *FileSystemGfs2) Mount(ctx context.Context, mountpoint string) error {
 	return f.mount(f.devPath(), mountpoint, "", f.MkfsMount.Mount)
 }
@@ -20,6 +20,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -99,7 +100,7 @@ func (f *FileSystemLustre) MkfsDefault() string {
 	}[f.TargetType]
 }
 
-func (f *FileSystemLustre) Create(devices []string, options FileSystemOptions) error {
+func (f *FileSystemLustre) Create(ctx context.Context, devices []string, options FileSystemOptions) error {
 
 	var err error
 	f.devices = devices
@@ -128,33 +129,33 @@ func (f *FileSystemLustre) Create(devices []string, options FileSystemOptions) e
 
 	zpoolArgs := varHandler.ReplaceAll(f.ZfsArgs.ZpoolCreate)
 	zpoolCreate := fmt.Sprintf("zpool create %s", zpoolArgs)
-	_, err = f.run(zpoolCreate)
+	_, err = f.run(ctx, zpoolCreate)
 	if err != nil {
 		return err
 	}
 
 	mkfsArgs := varHandler.ReplaceAll(f.MkfsMount.Mkfs)
 	mkfsCmd := fmt.Sprintf("mkfs.lustre --backfstype=%s %s", f.Oem.BackFs, mkfsArgs)
-	_, err = f.run(mkfsCmd)
+	_, err = f.run(ctx, mkfsCmd)
 	if err != nil {
 		// Destroy the zpool we created above.
-		_, _ = f.run(fmt.Sprintf("zpool destroy %s", f.zfsPoolName()))
+		_, _ = f.run(ctx, fmt.Sprintf("zpool destroy %s", f.zfsPoolName()))
 	}
 
 	return err
 }
 
-func (f *FileSystemLustre) Delete() error {
+func (f *FileSystemLustre) Delete(ctx context.Context) error {
 	var err error
 	if f.Oem.BackFs == BackFsZfs {
 		zpool := f.zfsPoolName()
 
 		// Query the existence of the pool.
-		_, err = f.run(fmt.Sprintf("zpool list %s", zpool))
+		_, err = f.run(ctx, fmt.Sprintf("zpool list %s", zpool))
 
 		// If the pool exists, delete it.
 		if err == nil || (err != nil && !strings.Contains(err.Error(), "no such pool")) {
-			_, err = f.run(fmt.Sprintf("zpool destroy %s", zpool))
+			_, err = f.run(ctx, fmt.Sprintf("zpool destroy %s", zpool))
 			if err != nil {
 				return err
 			}
@@ -164,13 +165,13 @@ func (f *FileSystemLustre) Delete() error {
 	if _, ok := os.LookupEnv("NNF_SUPPLIED_DEVICES"); ok {
 		// On non-NVMe, wipe so devices can be reused.
 		var devName string = f.devices[0]
-		_, err = f.run(fmt.Sprintf("wipefs --all %s", devName))
+		_, err = f.run(ctx, fmt.Sprintf("wipefs --all %s", devName))
 		if err != nil {
 			return err
 		}
 	}
 	// Inform the OS of partition table changes.
-	_, err = f.run("partprobe")
+	_, err = f.run(ctx, "partprobe")
 	if err != nil {
 		return err
 	}
@@ -178,7 +179,7 @@ func (f *FileSystemLustre) Delete() error {
 	return nil
 }
 
-func (f *FileSystemLustre) Mount(mountpoint string) error {
+func (f *FileSystemLustre) Mount(ctx context.Context, mountpoint string) error {
 
 	var devName string
 	if f.Oem.BackFs == BackFsZfs {
@@ -20,6 +20,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path"
@@ -64,7 +65,7 @@ func (f *FileSystemLvm) Name() string { return f.name }
 func (f *FileSystemLvm) VgChangeActivateDefault() string { return "--activate y $VG_NAME" }
 func (f *FileSystemLvm) MkfsDefault() string             { return "" }
 
-func (f *FileSystemLvm) Create(devices []string, opts FileSystemOptions) error {
+func (f *FileSystemLvm) Create(ctx context.Context, devices []string, opts FileSystemOptions) error {
 
 	if _, exists := opts[VolumeGroupName]; exists {
 		f.vgName = opts[VolumeGroupName].(string)
@@ -83,7 +84,7 @@ func (f *FileSystemLvm) Create(devices []string, opts FileSystemOptions) error {
 	// Ensure the existing volume groups are scanned. Use this information to determine
 	// if initialization is required for the Volume Group, or if it exists and can be
 	// activated.
-	if _, err := f.run("vgscan"); err != nil {
+	if _, err := f.run(ctx, "vgscan"); err != nil {
 		return err
 	}
 
@@ -101,20 +102,20 @@ func (f *FileSystemLvm) Create(devices []string, opts FileSystemOptions) error {
 		if f.shared {
 			// Activate the shared lock
 			vgArgs := varHandler.ReplaceAll(f.CmdArgs.VgChange.LockStart)
-			if _, err := f.run(fmt.Sprintf("vgchange %s", vgArgs)); err != nil {
+			if _, err := f.run(ctx, fmt.Sprintf("vgchange %s", vgArgs)); err != nil {
 				return err
 			}
 		}
 
 		vgArgs := varHandler.ReplaceAll(f.CmdArgs.VgChange.Activate)
-		if _, err := f.run(fmt.Sprintf("vgchange %s", vgArgs)); err != nil {
+		if _, err := f.run(ctx, fmt.Sprintf("vgchange %s", vgArgs)); err != nil {
 			return err
 		}
 
 		return nil
 	}
 
-	rsp, _ := f.run(fmt.Sprintf("lvdisplay %s || echo 'not found'", f.vgName))
+	rsp, _ := f.run(ctx, fmt.Sprintf("lvdisplay %s || echo 'not found'", f.vgName))
 	if len(rsp) != 0 && !strings.Contains(string(rsp), "not found") {
 		// Volume Group is present, activate the volume. This is for the case where another
 		// node created the volume group and we just want to share it.
@@ -125,7 +126,7 @@ func (f *FileSystemLvm) Create(devices []string, opts FileSystemOptions) error {
 	for _, device := range devices {
 		varHandler.VarMap["$DEVICE"] = device
 		pvArgs := varHandler.ReplaceAll(f.CmdArgs.PvCreate)
-		if _, err := f.run(fmt.Sprintf("pvcreate %s", pvArgs)); err != nil {
+		if _, err := f.run(ctx, fmt.Sprintf("pvcreate %s", pvArgs)); err != nil {
 			return err
 		}
 	}
@@ -138,7 +139,7 @@ func (f *FileSystemLvm) Create(devices []string, opts FileSystemOptions) error {
 	}
 
 	vgArgs := varHandler.ReplaceAll(f.CmdArgs.VgCreate)
-	if _, err := f.run(fmt.Sprintf("vgcreate %s %s", shared, vgArgs)); err != nil {
+	if _, err := f.run(ctx, fmt.Sprintf("vgcreate %s %s", shared, vgArgs)); err != nil {
 		return err
 	}
 
@@ -168,7 +169,7 @@ func (f *FileSystemLvm) Create(devices []string, opts FileSystemOptions) error {
 	}
 
 	lvArgs := varHandler.ReplaceAll(f.CmdArgs.LvCreate)
-	if _, err := f.run(fmt.Sprintf("lvcreate --yes %s %s", zeroOpt, lvArgs)); err != nil {
+	if _, err := f.run(ctx, fmt.Sprintf("lvcreate --yes %s %s", zeroOpt, lvArgs)); err != nil {
 		return err
 	}
 
@@ -190,7 +191,7 @@ func (f *FileSystemLvm) Create(devices []string, opts FileSystemOptions) error {
 	return fmt.Errorf("logical volume path '%s' does not exist after 10s", f.devPath())
 }
 
-func (f *FileSystemLvm) Delete() error {
+func (f *FileSystemLvm) Delete(ctx context.Context) error {
 
 	varHandler := var_handler.NewVarHandler(map[string]string{
 		"$LV_NAME": f.lvName,
@@ -198,17 +199,17 @@ func (f *FileSystemLvm) Delete() error {
 	})
 
 	vgArgs := varHandler.ReplaceAll(f.CmdArgs.VgChange.Deactivate)
-	if _, err := f.run(fmt.Sprintf("vgchange %s", vgArgs)); err != nil {
+	if _, err := f.run(ctx, fmt.Sprintf("vgchange %s", vgArgs)); err != nil {
 		return err
 	}
 
 	vgArgs = varHandler.ReplaceAll(f.CmdArgs.VgRemove)
-	if _, err := f.run(fmt.Sprintf("vgremove --yes %s", vgArgs)); err != nil {
+	if _, err := f.run(ctx, fmt.Sprintf("vgremove --yes %s", vgArgs)); err != nil {
 		return err
 	}
 
 	for _, device := range f.devices {
-		if _, err := f.run(fmt.Sprintf("pvremove --yes %s", device)); err != nil {
+		if _, err := f.run(ctx, fmt.Sprintf("pvremove --yes %s", device)); err != nil {
 			return err
 		}
 	}
@@ -216,7 +217,7 @@ func (f *FileSystemLvm) Delete() error {
 	return nil
 }
 
-func (f *FileSystemLvm) Mount(mountpoint string) error {
+func (f *FileSystemLvm) Mount(ctx context.Context, mountpoint string) error {
 	return nil
 }
 
@@ -20,6 +20,7 @@
 package server
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 
@@ -30,8 +31,8 @@ import (
 type FileSystemRaw struct {
 	FileSystemLvm
 
-	uid     int
-	gid     int
+	uid int
+	gid int
 }
 
 func init() {
@@ -51,9 +52,8 @@ func (*FileSystemRaw) New(oem FileSystemOem) (FileSystemApi, error) {
 func (*FileSystemRaw) IsType(oem *FileSystemOem) bool { return oem.Type == "raw" }
 func (*FileSystemRaw) Type() string                   { return "raw" }
 
-
-func (f *FileSystemRaw) Create(devices []string, opts FileSystemOptions) error {
-	if err := f.FileSystemLvm.Create(devices, opts); err != nil {
+func (f *FileSystemRaw) Create(ctx context.Context, devices []string, opts FileSystemOptions) error {
+	if err := f.FileSystemLvm.Create(ctx, devices, opts); err != nil {
 		return err
 	}
 
@@ -72,7 +72,7 @@ func (f *FileSystemRaw) Create(devices []string, opts FileSystemOptions) error {
 	return nil
 }
 
-func (f *FileSystemRaw) Mount(mountpoint string) error {
+func (f *FileSystemRaw) Mount(ctx context.Context, mountpoint string) error {
 	// Make the parent directory
 	if err := os.MkdirAll(filepath.Dir(mountpoint), 0755); err != nil {
 		return err
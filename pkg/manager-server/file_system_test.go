@@ -20,6 +20,7 @@
 package server
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -37,7 +38,7 @@ func prepare(t *testing.T, pid uuid.UUID) (*Storage, FileSystemControllerApi) {
 		t.Fatalf("Could not allocate storage pool %s", pid)
 	}
 
-	pool.GetStatus()
+	pool.GetStatus(context.Background())
 
 	return pool, fsCtrl
 }
@@ -56,7 +57,7 @@ func _TestFileSystemZfs(t *testing.T) {
 		t.Fatalf("Could not allocate ZFS file system")
 	}
 
-	if err := pool.CreateFileSystem(fs, nil); err != nil {
+	if err := pool.CreateFileSystem(context.Background(), fs, nil); err != nil {
 		t.Errorf("Create ZFS file system failed %s", err)
 	}
 }
@@ -78,13 +79,13 @@ func _TestFileSystemLvm(t *testing.T) {
 		t.Fatal("Could not create LVM file system")
 	}
 
-	if err := pool.CreateFileSystem(fs, nil); err != nil {
+	if err := pool.CreateFileSystem(context.Background(), fs, nil); err != nil {
 		t.Fatalf("Create LVM file system failed %s", err)
 	} else {
 		t.Log("Create LVM file system success")
 	}
 
-	if err := pool.DeleteFileSystem(fs); err != nil {
+	if err := pool.DeleteFileSystem(context.Background(), fs); err != nil {
 		t.Fatalf("Delete LVM file system failed %s", err)
 	} else {
 		t.Log("Delete LVM file system success")
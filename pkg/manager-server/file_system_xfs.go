@@ -20,6 +20,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/NearNodeFlash/nnf-ec/pkg/var_handler"
@@ -54,8 +55,8 @@ func (f *FileSystemXfs) Name() string { return f.name }
 
 func (f *FileSystemXfs) MkfsDefault() string { return "$DEVICE" }
 
-func (f *FileSystemXfs) Create(devices []string, opts FileSystemOptions) (err error) {
-	if err := f.FileSystemLvm.Create(devices, opts); err != nil {
+func (f *FileSystemXfs) Create(ctx context.Context, devices []string, opts FileSystemOptions) (err error) {
+	if err := f.FileSystemLvm.Create(ctx, devices, opts); err != nil {
 		return err
 	}
 
@@ -64,13 +65,13 @@ func (f *FileSystemXfs) Create(devices []string, opts FileSystemOptions) (err er
 	})
 	mkfsArgs := varHandler.ReplaceAll(f.MkfsMount.Mkfs)
 
-	if _, err := f.run(fmt.Sprintf("mkfs.xfs %s", mkfsArgs)); err != nil {
+	if _, err := f.run(ctx, fmt.Sprintf("mkfs.xfs %s", mkfsArgs)); err != nil {
 		return err
 	}
 
-	return setFileSystemPermissions(f, opts)
+	return setFileSystemPermissions(ctx, f, opts)
 }
 
-func (f *FileSystemXfs) Mount(mountpoint string) error {
+func (f *FileSystemXfs) Mount(ctx context.Context, mountpoint string) error {
 	return f.mount(f.devPath(), mountpoint, "", f.MkfsMount.Mount)
 }
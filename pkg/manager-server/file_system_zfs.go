@@ -20,6 +20,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"strings"
 )
@@ -44,9 +45,9 @@ func (*FileSystemZfs) Type() string                   { return "zfs" }
 func (f *FileSystemZfs) Name() string { return f.name }
 
 func (f *FileSystemZfs) VgChangeActivateDefault() string { return "" }
-func (f *FileSystemZfs) MkfsDefault() string { return "" }
+func (f *FileSystemZfs) MkfsDefault() string             { return "" }
 
-func (f *FileSystemZfs) Create(devices []string, options FileSystemOptions) error {
+func (f *FileSystemZfs) Create(ctx context.Context, devices []string, options FileSystemOptions) error {
 
 	f.devices = devices
 
@@ -57,16 +58,16 @@ func (f *FileSystemZfs) Create(devices []string, options FileSystemOptions) erro
 	return nil
 }
 
-func (f *FileSystemZfs) Delete() error { return nil }
+func (f *FileSystemZfs) Delete(ctx context.Context) error { return nil }
 
-func (f *FileSystemZfs) Mount(mountpoint string) error {
-	_, err := f.run(fmt.Sprintf("zpool create -m %s %s %s", mountpoint, f.name, strings.Join(f.devices, " ")))
+func (f *FileSystemZfs) Mount(ctx context.Context, mountpoint string) error {
+	_, err := f.run(ctx, fmt.Sprintf("zpool create -m %s %s %s", mountpoint, f.name, strings.Join(f.devices, " ")))
 
 	return err
 }
 
-func (f *FileSystemZfs) Unmount(mountpoint string) error {
-	_, err := f.run(fmt.Sprintf("zpool destroy %s", f.name))
+func (f *FileSystemZfs) Unmount(ctx context.Context, mountpoint string) error {
+	_, err := f.run(ctx, fmt.Sprintf("zpool destroy %s", f.name))
 
 	return err
 }
@@ -20,6 +20,7 @@
 package server
 
 import (
+	"context"
 	"github.com/google/uuid"
 )
 
@@ -64,18 +65,18 @@ type ServerInfo struct {
 type ServerControllerApi interface {
 	Connected() bool
 
-	GetServerInfo() ServerInfo
+	GetServerInfo(context.Context) ServerInfo
 
 	// Allocate a new Storage object to be managed by this controller
 	NewStorage(uuid.UUID, []StorageNamespace) *Storage
 
-	Delete(*Storage) error
+	Delete(context.Context, *Storage) error
 
 	// Retrieve the status of the provided Storage object from the controller
-	GetStatus(*Storage) (StorageStatus, error)
+	GetStatus(context.Context, *Storage) (StorageStatus, error)
 
-	CreateFileSystem(*Storage, FileSystemApi, FileSystemOptions) error
-	DeleteFileSystem(*Storage, FileSystemApi) error
-	MountFileSystem(*Storage, FileSystemApi, string) error
-	UnmountFileSystem(*Storage, FileSystemApi, string) error
+	CreateFileSystem(context.Context, *Storage, FileSystemApi, FileSystemOptions) error
+	DeleteFileSystem(context.Context, *Storage, FileSystemApi) error
+	MountFileSystem(context.Context, *Storage, FileSystemApi, string) error
+	UnmountFileSystem(context.Context, *Storage, FileSystemApi, string) error
 }
@@ -20,6 +20,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -38,7 +39,7 @@ func (*DisabledServerController) Connected() bool {
 	return false
 }
 
-func (*DisabledServerController) GetServerInfo() ServerInfo {
+func (*DisabledServerController) GetServerInfo(context.Context) ServerInfo {
 	return ServerInfo{}
 }
 
@@ -46,26 +47,26 @@ func (*DisabledServerController) NewStorage(uuid.UUID, []StorageNamespace) *Stor
 	return nil
 }
 
-func (*DisabledServerController) Delete(*Storage) error {
+func (*DisabledServerController) Delete(context.Context, *Storage) error {
 	return ErrServerControllerDisabled
 }
 
-func (*DisabledServerController) GetStatus(*Storage) (StorageStatus, error) {
+func (*DisabledServerController) GetStatus(context.Context, *Storage) (StorageStatus, error) {
 	return StorageStatus_Disabled, nil
 }
 
-func (*DisabledServerController) CreateFileSystem(*Storage, FileSystemApi, FileSystemOptions) error {
+func (*DisabledServerController) CreateFileSystem(context.Context, *Storage, FileSystemApi, FileSystemOptions) error {
 	return ErrServerControllerDisabled
 }
 
-func (*DisabledServerController) MountFileSystem(*Storage, FileSystemApi, string) error {
+func (*DisabledServerController) MountFileSystem(context.Context, *Storage, FileSystemApi, string) error {
 	return ErrServerControllerDisabled
 }
 
-func (*DisabledServerController) UnmountFileSystem(*Storage, FileSystemApi, string) error {
+func (*DisabledServerController) UnmountFileSystem(context.Context, *Storage, FileSystemApi, string) error {
 	return ErrServerControllerDisabled
 }
 
-func (*DisabledServerController) DeleteFileSystem(*Storage, FileSystemApi) error {
+func (*DisabledServerController) DeleteFileSystem(context.Context, *Storage, FileSystemApi) error {
 	return ErrServerControllerDisabled
 }
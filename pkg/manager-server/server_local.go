@@ -20,6 +20,7 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"os/exec"
@@ -49,10 +50,10 @@ type LocalServerController struct {
 
 func (c *LocalServerController) Connected() bool { return true }
 
-func (c *LocalServerController) GetServerInfo() ServerInfo {
+func (c *LocalServerController) GetServerInfo(ctx context.Context) ServerInfo {
 	serverInfo := ServerInfo{}
 
-	output, err := c.run("lctl list_nids")
+	output, err := c.run(ctx, "lctl list_nids")
 	if err != nil {
 		return serverInfo
 	}
@@ -79,7 +80,7 @@ func (c *LocalServerController) NewStorage(pid uuid.UUID, expectedNamespaces []S
 	return storage
 }
 
-func (c *LocalServerController) Delete(s *Storage) error {
+func (c *LocalServerController) Delete(ctx context.Context, s *Storage) error {
 	for storageIdx, storage := range c.storages {
 		if storage.Id == s.Id {
 			c.storages = append(c.storages[:storageIdx], c.storages[storageIdx+1:]...)
@@ -90,10 +91,10 @@ func (c *LocalServerController) Delete(s *Storage) error {
 	return nil
 }
 
-func (c *LocalServerController) GetStatus(s *Storage) (StorageStatus, error) {
+func (c *LocalServerController) GetStatus(ctx context.Context, s *Storage) (StorageStatus, error) {
 
 	if len(s.discoveredNamespaces) < len(s.expectedNamespaces) {
-		if err := c.Discover(s); err != nil {
+		if err := c.Discover(ctx, s); err != nil {
 			return StorageStatus_Error, err
 		}
 	}
@@ -105,44 +106,44 @@ func (c *LocalServerController) GetStatus(s *Storage) (StorageStatus, error) {
 	return StorageStatus_Ready, nil
 }
 
-func (c *LocalServerController) CreateFileSystem(s *Storage, fs FileSystemApi, opts FileSystemOptions) error {
-	if err := fs.Create(s.Devices(), opts); err != nil {
+func (c *LocalServerController) CreateFileSystem(ctx context.Context, s *Storage, fs FileSystemApi, opts FileSystemOptions) error {
+	if err := fs.Create(ctx, s.Devices(), opts); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (c *LocalServerController) DeleteFileSystem(s *Storage, fs FileSystemApi) error {
-	return fs.Delete()
+func (c *LocalServerController) DeleteFileSystem(ctx context.Context, s *Storage, fs FileSystemApi) error {
+	return fs.Delete(ctx)
 }
 
-func (c *LocalServerController) MountFileSystem(s *Storage, fs FileSystemApi, mountPoint string) error {
+func (c *LocalServerController) MountFileSystem(ctx context.Context, s *Storage, fs FileSystemApi, mountPoint string) error {
 	if mountPoint == "" {
 		return nil
 	}
 
-	if err := fs.Mount(mountPoint); err != nil {
+	if err := fs.Mount(ctx, mountPoint); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (c *LocalServerController) UnmountFileSystem(s *Storage, fs FileSystemApi, mountPoint string) error {
-	if err := fs.Unmount(mountPoint); err != nil {
+func (c *LocalServerController) UnmountFileSystem(ctx context.Context, s *Storage, fs FileSystemApi, mountPoint string) error {
+	if err := fs.Unmount(ctx, mountPoint); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (c *LocalServerController) Discover(s *Storage) error {
+func (c *LocalServerController) Discover(ctx context.Context, s *Storage) error {
 	if devices, ok := os.LookupEnv("NNF_SUPPLIED_DEVICES"); ok {
 		return c.discoverUsingSuppliedDevices(s, devices)
 	}
 
-	return c.discoverViaNamespaces()
+	return c.discoverViaNamespaces(ctx)
 }
 
 func (c *LocalServerController) discoverUsingSuppliedDevices(s *Storage, devices string) error {
@@ -163,9 +164,9 @@ func (c *LocalServerController) discoverUsingSuppliedDevices(s *Storage, devices
 }
 
 // Discover NVMe Namespaces
-func (c *LocalServerController) discoverViaNamespaces() error {
+func (c *LocalServerController) discoverViaNamespaces(ctx context.Context) error {
 
-	data, err := c.run("nvme list --output-format=json")
+	data, err := c.run(ctx, "nvme list --output-format=json")
 	if err != nil {
 		return err
 	}
@@ -225,8 +226,8 @@ func (c *LocalServerController) findStorage(pid uuid.UUID) *Storage {
 	return nil
 }
 
-func (c *LocalServerController) namespaces() ([]string, error) {
-	nss, err := c.run("ls -A1 /dev/nvme* | { grep -E 'nvme[0-9]+n[0-9]+' || :; }")
+func (c *LocalServerController) namespaces(ctx context.Context) ([]string, error) {
+	nss, err := c.run(ctx, "ls -A1 /dev/nvme* | { grep -E 'nvme[0-9]+n[0-9]+' || :; }")
 
 	// The above will return an err if zero namespaces exist. In
 	// this case, ENOENT is returned and we should instead return
@@ -240,8 +241,8 @@ func (c *LocalServerController) namespaces() ([]string, error) {
 	return strings.Fields(string(nss)), err
 }
 
-func (c *LocalServerController) run(cmd string) ([]byte, error) {
-	return logging.Cli.Trace(cmd, func(cmd string) ([]byte, error) {
+func (c *LocalServerController) run(ctx context.Context, cmd string) ([]byte, error) {
+	return logging.Cli.Trace(ctx, cmd, func(cmd string) ([]byte, error) {
 		return exec.Command("bash", "-c", cmd).Output()
 	})
 }
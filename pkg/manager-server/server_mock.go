@@ -19,7 +19,10 @@
 
 package server
 
-import "github.com/google/uuid"
+import (
+	"context"
+	"github.com/google/uuid"
+)
 
 type MockServerControllerProvider struct{}
 
@@ -37,7 +40,7 @@ type MockServerController struct {
 
 func (c *MockServerController) Connected() bool { return true }
 
-func (m *MockServerController) GetServerInfo() ServerInfo {
+func (m *MockServerController) GetServerInfo(ctx context.Context) ServerInfo {
 	return ServerInfo{
 		LNetNids: []string{"1.2.3.4@tcp0"},
 	}
@@ -53,7 +56,7 @@ func (c *MockServerController) NewStorage(pid uuid.UUID, expectedNamespaces []St
 	return storage
 }
 
-func (c *MockServerController) Delete(s *Storage) error {
+func (c *MockServerController) Delete(ctx context.Context, s *Storage) error {
 	for storageIdx, storage := range c.storage {
 		if storage.Id == s.Id {
 			c.storage = append(c.storage[:storageIdx], c.storage[storageIdx+1:]...)
@@ -64,14 +67,14 @@ func (c *MockServerController) Delete(s *Storage) error {
 	return nil
 }
 
-func (*MockServerController) GetStatus(s *Storage) (StorageStatus, error) {
+func (*MockServerController) GetStatus(ctx context.Context, s *Storage) (StorageStatus, error) {
 	return StorageStatus_Ready, nil
 }
 
-func (*MockServerController) CreateFileSystem(s *Storage, fs FileSystemApi, opts FileSystemOptions) error {
+func (*MockServerController) CreateFileSystem(ctx context.Context, s *Storage, fs FileSystemApi, opts FileSystemOptions) error {
 
 	if fs.IsMockable() {
-		if err := fs.Create(s.Devices(), opts); err != nil {
+		if err := fs.Create(ctx, s.Devices(), opts); err != nil {
 			return err
 		}
 	}
@@ -79,13 +82,13 @@ func (*MockServerController) CreateFileSystem(s *Storage, fs FileSystemApi, opts
 	return nil
 }
 
-func (*MockServerController) MountFileSystem(s *Storage, fs FileSystemApi, mountpoint string) error {
+func (*MockServerController) MountFileSystem(ctx context.Context, s *Storage, fs FileSystemApi, mountpoint string) error {
 	if fs.IsMockable() {
 		if mountpoint == "" {
 			return nil
 		}
 
-		if err := fs.Mount(mountpoint); err != nil {
+		if err := fs.Mount(ctx, mountpoint); err != nil {
 			return err
 		}
 	}
@@ -93,9 +96,9 @@ func (*MockServerController) MountFileSystem(s *Storage, fs FileSystemApi, mount
 	return nil
 }
 
-func (*MockServerController) UnmountFileSystem(s *Storage, fs FileSystemApi, mountpoint string) error {
+func (*MockServerController) UnmountFileSystem(ctx context.Context, s *Storage, fs FileSystemApi, mountpoint string) error {
 	if fs.IsMockable() {
-		if err := fs.Unmount(mountpoint); err != nil {
+		if err := fs.Unmount(ctx, mountpoint); err != nil {
 			return err
 		}
 	}
@@ -103,9 +106,9 @@ func (*MockServerController) UnmountFileSystem(s *Storage, fs FileSystemApi, mou
 	return nil
 }
 
-func (*MockServerController) DeleteFileSystem(s *Storage, fs FileSystemApi) error {
+func (*MockServerController) DeleteFileSystem(ctx context.Context, s *Storage, fs FileSystemApi) error {
 	if fs.IsMockable() {
-		return fs.Delete()
+		return fs.Delete(ctx)
 	}
 	return nil
 }
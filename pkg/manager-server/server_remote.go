@@ -20,6 +20,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -45,7 +46,7 @@ func NewRemoteServerController(opts ServerControllerOptions) ServerControllerApi
 
 func (c *RemoteServerController) Connected() bool { return true }
 
-func (c *RemoteServerController) GetServerInfo() ServerInfo {
+func (c *RemoteServerController) GetServerInfo(context.Context) ServerInfo {
 	return ServerInfo{}
 }
 
@@ -56,26 +57,26 @@ func (c *RemoteServerController) NewStorage(pid uuid.UUID, expectedNamespaces []
 	}
 }
 
-func (c *RemoteServerController) Delete(s *Storage) error {
+func (c *RemoteServerController) Delete(ctx context.Context, s *Storage) error {
 	return nil
 }
 
-func (c *RemoteServerController) GetStatus(s *Storage) (StorageStatus, error) {
+func (c *RemoteServerController) GetStatus(ctx context.Context, s *Storage) (StorageStatus, error) {
 	return StorageStatus_Ready, nil
 }
 
-func (c *RemoteServerController) CreateFileSystem(*Storage, FileSystemApi, FileSystemOptions) error {
+func (c *RemoteServerController) CreateFileSystem(context.Context, *Storage, FileSystemApi, FileSystemOptions) error {
 	return fmt.Errorf("Cannot create file system on remote server")
 }
 
-func (r *RemoteServerController) MountFileSystem(*Storage, FileSystemApi, string) error {
+func (r *RemoteServerController) MountFileSystem(context.Context, *Storage, FileSystemApi, string) error {
 	return nil
 }
 
-func (r *RemoteServerController) UnmountFileSystem(*Storage, FileSystemApi, string) error {
+func (r *RemoteServerController) UnmountFileSystem(context.Context, *Storage, FileSystemApi, string) error {
 	return nil
 }
 
-func (r *RemoteServerController) DeleteFileSystem(*Storage, FileSystemApi) error {
+func (r *RemoteServerController) DeleteFileSystem(context.Context, *Storage, FileSystemApi) error {
 	return nil
 }
@@ -20,6 +20,7 @@
 package server
 
 import (
+	"context"
 	"github.com/google/uuid"
 
 	"github.com/NearNodeFlash/nnf-ec/internal/switchtec/pkg/nvme"
@@ -64,28 +65,28 @@ type StorageNamespace struct {
 	path string
 }
 
-func (s *Storage) GetStatus() (StorageStatus, error) {
-	return s.ctrl.GetStatus(s)
+func (s *Storage) GetStatus(ctx context.Context) (StorageStatus, error) {
+	return s.ctrl.GetStatus(ctx, s)
 }
 
-func (s *Storage) Delete() error {
-	return s.ctrl.Delete(s)
+func (s *Storage) Delete(ctx context.Context) error {
+	return s.ctrl.Delete(ctx, s)
 }
 
-func (s *Storage) CreateFileSystem(fs FileSystemApi, opts FileSystemOptions) error {
-	return s.ctrl.CreateFileSystem(s, fs, opts)
+func (s *Storage) CreateFileSystem(ctx context.Context, fs FileSystemApi, opts FileSystemOptions) error {
+	return s.ctrl.CreateFileSystem(ctx, s, fs, opts)
 }
 
-func (s *Storage) DeleteFileSystem(fs FileSystemApi) error {
-	return s.ctrl.DeleteFileSystem(s, fs)
+func (s *Storage) DeleteFileSystem(ctx context.Context, fs FileSystemApi) error {
+	return s.ctrl.DeleteFileSystem(ctx, s, fs)
 }
 
-func (s *Storage) MountFileSystem(fs FileSystemApi, mountPoint string) error {
-	return s.ctrl.MountFileSystem(s, fs, mountPoint)
+func (s *Storage) MountFileSystem(ctx context.Context, fs FileSystemApi, mountPoint string) error {
+	return s.ctrl.MountFileSystem(ctx, s, fs, mountPoint)
 }
 
-func (s *Storage) UnmountFileSystem(fs FileSystemApi, mountPoint string) error {
-	return s.ctrl.UnmountFileSystem(s, fs, mountPoint)
+func (s *Storage) UnmountFileSystem(ctx context.Context, fs FileSystemApi, mountPoint string) error {
+	return s.ctrl.UnmountFileSystem(ctx, s, fs, mountPoint)
 }
 
 // Returns the list of devices for this pool.
@@ -0,0 +1,335 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviceroot
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// Service Root - The service root and OData documents are generated from the routes registered by the
+// element controller's routers, so any collection a router serves is discoverable by generic Redfish
+// clients without additional registration.
+
+const (
+	ServiceRootOdataId   = "/redfish/v1"
+	ServiceRootOdataType = "#ServiceRoot.v1_9_0.ServiceRoot"
+
+	RedfishVersion = "1.9.0"
+
+	OdataServiceOdataId = ServiceRootOdataId + "/odata"
+	MetadataOdataId     = ServiceRootOdataId + "/$metadata"
+
+	schemaUriFormat = "http://redfish.dmtf.org/schemas/v1/%s_v1.xml"
+)
+
+// Member types of collections whose name is not the plural of the member type
+var memberTypes = map[string]string{
+	"AllocatedVolumes":   "Volume",
+	"Controllers":        "StorageController",
+	"Entries":            "LogEntry",
+	"ExportedFileShares": "FileShare",
+	"ProvidingVolumes":   "Volume",
+	"Registries":         "MessageRegistryFile",
+	"Storage":            "Storage",
+	"Subscriptions":      "EventDestination",
+}
+
+// Singleton resources that are not collections
+var singletonTypes = map[string]string{
+	"CapacitySource": "CapacitySource",
+}
+
+type manager struct {
+	routers ec.Routers
+}
+
+func (m *manager) routes() []ec.Route {
+	routes := make([]ec.Route, 0)
+	for _, router := range m.routers {
+		for _, route := range router.Routes() {
+			if route.Method == ec.GET_METHOD {
+				routes = append(routes, route)
+			}
+		}
+	}
+
+	return routes
+}
+
+// services returns the name and path of each resource served directly off of the service root
+func (m *manager) services() map[string]string {
+	services := make(map[string]string)
+	for _, route := range m.routes() {
+		if strings.HasPrefix(route.Path, ServiceRootOdataId+"/") {
+			name := strings.TrimPrefix(route.Path, ServiceRootOdataId+"/")
+			if !strings.ContainsAny(name, "/{$") && name != "odata" {
+				services[name] = route.Path
+			}
+		}
+	}
+
+	return services
+}
+
+// Get - Return the service root
+func (m *manager) Get() sf.ServiceRootV190ServiceRoot {
+	model := sf.ServiceRootV190ServiceRoot{
+		OdataId:        ServiceRootOdataId,
+		OdataType:      ServiceRootOdataType,
+		OdataContext:   MetadataOdataId + "#ServiceRoot.ServiceRoot",
+		Id:             "RootService",
+		Name:           "Root Service",
+		RedfishVersion: RedfishVersion,
+		Product:        "Near Node Flash Element Controller",
+		ProtocolFeaturesSupported: sf.ServiceRootV190ProtocolFeaturesSupported{
+			ExpandQuery: sf.ServiceRootV190Expand{
+				ExpandAll: true,
+				Levels:    true,
+				Links:     true,
+				NoLinks:   true,
+				MaxLevels: ec.MaxExpandLevels,
+			},
+			// $top and $skip are supported, but TopSkipQuery is not part of this version of the schema
+			SelectQuery: true,
+			FilterQuery: true,
+		},
+	}
+
+	for name, path := range m.services() {
+		ref := sf.OdataV4IdRef{OdataId: path}
+
+		switch name {
+		case "EventService":
+			model.EventService = ref
+		case "Fabrics":
+			model.Fabrics = ref
+		case "Managers":
+			model.Managers = ref
+		case "Registries":
+			model.Registries = ref
+		case "SessionService":
+			model.SessionService = ref
+		case "Storage":
+			model.Storage = ref
+		case "StorageServices":
+			model.StorageServices = ref
+		case "TaskService":
+			model.Tasks = ref
+		case "TelemetryService":
+			model.TelemetryService = ref
+		default:
+			// Resources the service root schema does not define are listed under Oem so they remain
+			// discoverable
+			if model.Oem == nil {
+				model.Oem = map[string]interface{}{}
+			}
+			model.Oem[name] = ref
+		}
+	}
+
+	for _, route := range m.routes() {
+		if strings.HasSuffix(route.Path, "/SessionService/Sessions") {
+			model.Links.Sessions = sf.OdataV4IdRef{OdataId: route.Path}
+		}
+	}
+
+	return model
+}
+
+type odataService struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	Url  string `json:"url"`
+}
+
+// OdataGet - Return the OData service document
+func (m *manager) OdataGet() map[string]interface{} {
+	value := []odataService{{Name: "Service", Kind: "Singleton", Url: ServiceRootOdataId + "/"}}
+
+	services := m.services()
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value = append(value, odataService{Name: name, Kind: "Singleton", Url: services[name]})
+	}
+
+	return map[string]interface{}{
+		"@odata.context": MetadataOdataId,
+		"value":          value,
+	}
+}
+
+type edmx struct {
+	XMLName     xml.Name         `xml:"edmx:Edmx"`
+	Namespace   string           `xml:"xmlns:edmx,attr"`
+	Version     string           `xml:"Version,attr"`
+	References  []edmxReference  `xml:"edmx:Reference"`
+	DataService edmxDataServices `xml:"edmx:DataServices"`
+}
+
+type edmxReference struct {
+	Uri      string        `xml:"Uri,attr"`
+	Includes []edmxInclude `xml:"edmx:Include"`
+}
+
+type edmxInclude struct {
+	Namespace string `xml:"Namespace,attr"`
+}
+
+type edmxDataServices struct {
+	Schema edmxSchema `xml:"Schema"`
+}
+
+type edmxSchema struct {
+	Namespace       string              `xml:"Namespace,attr"`
+	Xmlns           string              `xml:"xmlns,attr"`
+	EntityContainer edmxEntityContainer `xml:"EntityContainer"`
+}
+
+type edmxEntityContainer struct {
+	Name    string `xml:"Name,attr"`
+	Extends string `xml:"Extends,attr"`
+}
+
+// MetadataGet - Return the CSDL metadata document, referencing the schema of each resource type served
+func (m *manager) MetadataGet() ([]byte, error) {
+	namespaces := map[string]bool{"ServiceRoot": true}
+	for _, route := range m.routes() {
+		if namespace := resourceNamespace(route.Path); len(namespace) != 0 {
+			namespaces[namespace] = true
+		}
+	}
+
+	names := make([]string, 0, len(namespaces))
+	for name := range namespaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	doc := edmx{
+		Namespace: "http://docs.oasis-open.org/odata/ns/edmx",
+		Version:   "4.0",
+		DataService: edmxDataServices{
+			Schema: edmxSchema{
+				Namespace: "Service",
+				Xmlns:     "http://docs.oasis-open.org/odata/ns/edm",
+				EntityContainer: edmxEntityContainer{
+					Name:    "Service",
+					Extends: "ServiceRoot.v1_9_0.ServiceContainer",
+				},
+			},
+		},
+	}
+
+	for _, name := range names {
+		reference := edmxReference{
+			Uri:      schemaUri(name),
+			Includes: []edmxInclude{{Namespace: name}},
+		}
+
+		if name == "ServiceRoot" {
+			reference.Includes = append(reference.Includes, edmxInclude{Namespace: "ServiceRoot.v1_9_0"})
+		}
+
+		doc.References = append(doc.References, reference)
+	}
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), data...), nil
+}
+
+func schemaUri(namespace string) string {
+	return fmt.Sprintf(schemaUriFormat, namespace)
+}
+
+// resourceNamespace returns the schema namespace of the resource at the route path, or an empty
+// string if the path does not identify a standard Redfish resource. Paths ending in an identifier
+// are members of the collection named by the preceding segment; paths ending in a name are either
+// a service, a singleton, or a collection.
+func resourceNamespace(path string) string {
+	if strings.Contains(path, "/Oem/") || strings.Contains(path, "/TaskMonitors/") {
+		return ""
+	}
+
+	segments := strings.Split(strings.TrimPrefix(path, ServiceRootOdataId+"/"), "/")
+	last := segments[len(segments)-1]
+
+	if strings.HasPrefix(last, "{") {
+		if len(segments) < 2 {
+			return ""
+		}
+
+		return memberType(segments[len(segments)-2])
+	}
+
+	if strings.ContainsAny(last, "$") || last == "odata" {
+		return ""
+	}
+
+	if len(segments) >= 2 && strings.HasPrefix(segments[len(segments)-2], "{") && !isCollection(last) {
+		// A named resource off of a member, i.e. /Registries/{RegistryId}/Registry
+		if t, ok := singletonTypes[last]; ok {
+			return t
+		}
+
+		return ""
+	}
+
+	if strings.HasSuffix(last, "Service") {
+		return last
+	}
+
+	return memberType(last) + "Collection"
+}
+
+func isCollection(name string) bool {
+	_, singleton := singletonTypes[name]
+	return !singleton && strings.HasSuffix(name, "s") || name == "Storage"
+}
+
+// memberType returns the type of the members of the named collection
+func memberType(collection string) string {
+	if t, ok := memberTypes[collection]; ok {
+		return t
+	}
+
+	switch {
+	case strings.HasSuffix(collection, "ies"):
+		return strings.TrimSuffix(collection, "ies") + "y"
+	case strings.HasSuffix(collection, "ches"), strings.HasSuffix(collection, "shes"), strings.HasSuffix(collection, "sses"):
+		return strings.TrimSuffix(collection, "es")
+	}
+
+	return strings.TrimSuffix(collection, "s")
+}
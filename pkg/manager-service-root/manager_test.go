@@ -0,0 +1,113 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviceroot
+
+import (
+	"encoding/xml"
+	"testing"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+)
+
+func TestResourceNamespace(t *testing.T) {
+	for path, expected := range map[string]string{
+		"/redfish/v1/StorageServices":                                                 "StorageServiceCollection",
+		"/redfish/v1/StorageServices/{StorageServiceId}":                              "StorageService",
+		"/redfish/v1/StorageServices/{StorageServiceId}/CapacitySource":               "CapacitySource",
+		"/redfish/v1/StorageServices/{StorageServiceId}/StoragePools/{StoragePoolId}": "StoragePool",
+		"/redfish/v1/Fabrics/{FabricId}/Switches":                                     "SwitchCollection",
+		"/redfish/v1/Registries/{RegistryId}":                                         "MessageRegistryFile",
+		"/redfish/v1/Registries/{RegistryId}/Registry":                                "",
+		"/redfish/v1/Storage":                                                         "StorageCollection",
+		"/redfish/v1/Storage/{StorageId}/Controllers/{ControllerId}":                  "StorageController",
+		"/redfish/v1/EventService":                                                    "EventService",
+		"/redfish/v1/TaskService/TaskMonitors/{TaskId}":                               "",
+		"/redfish/v1/StorageServices/{StorageServiceId}/Oem/Audit":                    "",
+	} {
+		if namespace := resourceNamespace(path); namespace != expected {
+			t.Errorf("Path %s: Expected: '%s' Actual: '%s'", path, expected, namespace)
+		}
+	}
+}
+
+type testRouter struct{}
+
+func (*testRouter) Name() string         { return "Test" }
+func (*testRouter) Init(ec.Logger) error { return nil }
+func (*testRouter) Start() error         { return nil }
+func (*testRouter) Close() error         { return nil }
+
+func (*testRouter) Routes() ec.Routes {
+	return ec.Routes{
+		{Method: ec.GET_METHOD, Path: "/redfish/v1/Fabrics"},
+		{Method: ec.GET_METHOD, Path: "/redfish/v1/Fabrics/{FabricId}"},
+		{Method: ec.GET_METHOD, Path: "/redfish/v1/SessionService/Sessions"},
+		{Method: ec.POST_METHOD, Path: "/redfish/v1/Widgets"},
+	}
+}
+
+func TestServiceRoot(t *testing.T) {
+	m := &manager{routers: ec.Routers{&testRouter{}}}
+
+	root := m.Get()
+	if root.Fabrics.OdataId != "/redfish/v1/Fabrics" {
+		t.Errorf("Service root missing Fabrics: %+v", root)
+	}
+
+	if root.Links.Sessions.OdataId != "/redfish/v1/SessionService/Sessions" {
+		t.Errorf("Service root missing Sessions link: %+v", root.Links)
+	}
+
+	if _, ok := root.Oem["Widgets"]; ok {
+		t.Errorf("Service root includes resource without GET: %+v", root)
+	}
+
+	data, err := m.MetadataGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc := struct {
+		References []struct {
+			Uri string `xml:"Uri,attr"`
+		} `xml:"Reference"`
+	}{}
+
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Failed to parse metadata: %v", err)
+	}
+
+	expected := map[string]bool{
+		schemaUri("ServiceRoot"):       true,
+		schemaUri("FabricCollection"):  true,
+		schemaUri("Fabric"):            true,
+		schemaUri("SessionCollection"): true,
+	}
+
+	if len(doc.References) != len(expected) {
+		t.Errorf("Metadata references: Expected: %d Actual: %d", len(expected), len(doc.References))
+	}
+
+	for _, ref := range doc.References {
+		if !expected[ref.Uri] {
+			t.Errorf("Unexpected metadata reference %s", ref.Uri)
+		}
+	}
+}
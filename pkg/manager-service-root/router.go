@@ -0,0 +1,82 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviceroot
+
+import (
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+type DefaultApiRouter struct {
+	servicer Api
+}
+
+func NewDefaultApiRouter(s Api) ec.Router {
+	return &DefaultApiRouter{servicer: s}
+}
+
+func (*DefaultApiRouter) Name() string {
+	return "Service Root"
+}
+
+func (*DefaultApiRouter) Init(log ec.Logger) error {
+	return nil
+}
+
+func (*DefaultApiRouter) Start() error {
+	return nil
+}
+
+func (*DefaultApiRouter) Close() error {
+	return nil
+}
+
+func (r *DefaultApiRouter) Routes() ec.Routes {
+	s := r.servicer
+	return ec.Routes{
+		{
+			Name:        "RedfishGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish",
+			HandlerFunc: s.RedfishGet,
+			Response:    map[string]string{},
+		},
+		{
+			Name:        "RedfishV1Get",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1",
+			HandlerFunc: s.RedfishV1Get,
+			Response:    sf.ServiceRootV190ServiceRoot{},
+		},
+		{
+			Name:        "RedfishV1OdataGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/odata",
+			HandlerFunc: s.RedfishV1OdataGet,
+			Response:    map[string]interface{}{},
+		},
+		{
+			Name:        "RedfishV1MetadataGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/$metadata",
+			HandlerFunc: s.RedfishV1MetadataGet,
+		},
+	}
+}
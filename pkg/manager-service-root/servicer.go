@@ -0,0 +1,59 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviceroot
+
+import (
+	"net/http"
+
+	. "github.com/NearNodeFlash/nnf-ec/pkg/common"
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+)
+
+type DefaultApiService struct {
+	*manager
+}
+
+// NewDefaultApiService - Create the service root for the resources served by the routers
+func NewDefaultApiService(routers ec.Routers) Api {
+	return &DefaultApiService{manager: &manager{routers: routers}}
+}
+
+func (s *DefaultApiService) RedfishGet(w http.ResponseWriter, r *http.Request) {
+	EncodeResponse(map[string]string{"v1": ServiceRootOdataId + "/"}, nil, w)
+}
+
+func (s *DefaultApiService) RedfishV1Get(w http.ResponseWriter, r *http.Request) {
+	EncodeResponse(s.Get(), nil, w)
+}
+
+func (s *DefaultApiService) RedfishV1OdataGet(w http.ResponseWriter, r *http.Request) {
+	EncodeResponse(s.OdataGet(), nil, w)
+}
+
+func (s *DefaultApiService) RedfishV1MetadataGet(w http.ResponseWriter, r *http.Request) {
+	data, err := s.MetadataGet()
+	if err != nil {
+		EncodeResponse(nil, ec.NewErrInternalServerError().WithError(err).WithCause("Failed to generate metadata"), w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.Write(data)
+}
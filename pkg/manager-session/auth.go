@@ -0,0 +1,95 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	. "github.com/NearNodeFlash/nnf-ec/pkg/common"
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+)
+
+type accountContextKey struct{}
+
+type accountHolderContextKey struct{}
+
+// accountHolder receives the account that authenticated a request on behalf of middleware that runs
+// ahead of authentication
+type accountHolder struct {
+	account *Account
+}
+
+// AccountFromRequest returns the account that authenticated the request, or nil if the request
+// was not authenticated.
+func AccountFromRequest(r *http.Request) *Account {
+	account, _ := r.Context().Value(accountContextKey{}).(*Account)
+	return account
+}
+
+// WithAccountHolder returns a copy of the request, and a function returning the account that
+// authenticated it once it has been served. This is for middleware, such as auditing, that runs ahead
+// of authentication so it observes rejected requests.
+func WithAccountHolder(r *http.Request) (*http.Request, func() *Account) {
+	holder := &accountHolder{}
+	return r.WithContext(context.WithValue(r.Context(), accountHolderContextKey{}, holder)), func() *Account { return holder.account }
+}
+
+// Middleware rejects requests that do not carry a valid session token or basic authentication
+// credentials. The service root, OData and OpenAPI documents and session creation are always permitted
+// so a client can discover the service and log in, as are the health probes. The metrics are permitted
+// only if enabled by the unauthenticatedMetrics flag.
+func (m *manager) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !m.enabled || m.isUnauthenticatedRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		account, err := m.authenticate(r)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Basic realm="nnf-ec"`)
+			EncodeResponse(nil, err, w)
+			return
+		}
+
+		if holder, ok := r.Context().Value(accountHolderContextKey{}).(*accountHolder); ok {
+			holder.account = account
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), accountContextKey{}, account)))
+	})
+}
+
+func (m *manager) isUnauthenticatedRequest(r *http.Request) bool {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	switch path {
+	case "/redfish", "/redfish/v1", "/redfish/v1/odata", "/redfish/v1/$metadata", ec.LivenessPath, ec.ReadinessPath, ec.OpenAPIPath:
+		return true
+	case ec.MetricsPath:
+		return m.unauthenticatedMetrics
+	case SessionsOdataId:
+		return r.Method == http.MethodPost
+	}
+
+	return false
+}
@@ -0,0 +1,455 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/user"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	msgreg "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry/registries"
+	"github.com/NearNodeFlash/nnf-ec/pkg/persistent"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+const (
+	SessionServiceOdataId = "/redfish/v1/SessionService"
+	SessionsOdataId       = SessionServiceOdataId + "/Sessions"
+
+	// AuthTokenHeader is the Redfish session authentication header
+	AuthTokenHeader = "X-Auth-Token"
+
+	defaultSessionTimeout = 30 * time.Minute
+)
+
+// Account describes a local user account permitted to access the element controller. Accounts are loaded
+// from the account file, a JSON array of accounts. The password is stored as a bcrypt hash, as generated by
+// HashPassword or `htpasswd -nbB` without the user name prefix. The RoleId is one of the Redfish predefined
+// roles and defaults to ReadOnly.
+type Account struct {
+	UserName     string `json:"UserName"`
+	PasswordHash string `json:"PasswordHash"`
+	RoleId       string `json:"RoleId,omitempty"`
+}
+
+type session struct {
+	id         string
+	tokenHash  string
+	account    *Account
+	origin     string
+	created    time.Time
+	lastAccess time.Time
+}
+
+func (s *session) OdataId() string { return fmt.Sprintf("%s/%s", SessionsOdataId, s.id) }
+
+type manager struct {
+	enabled     bool
+	accountFile string
+	timeout     time.Duration
+	persistence bool
+
+	// Role granted to processes connected to the element controller's Unix socket; if unset, socket
+	// clients authenticate as any other client.
+	socketRole string
+
+	// Permit the metrics, which include drive serial numbers, to be read without authentication
+	unauthenticatedMetrics bool
+
+	accounts map[string]*Account
+
+	sync.Mutex
+	sessions map[string]*session // keyed by the digest of the session token
+
+	store *persistent.Store
+
+	log ec.Logger
+}
+
+var SessionManager = manager{
+	timeout:  defaultSessionTimeout,
+	sessions: make(map[string]*session),
+}
+
+func BindFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&SessionManager.enabled, "auth", SessionManager.enabled, "Require authentication for all requests except the service root")
+	fs.StringVar(&SessionManager.accountFile, "accountFile", SessionManager.accountFile, "JSON file of local accounts used for session and basic authentication")
+	fs.DurationVar(&SessionManager.timeout, "sessionTimeout", SessionManager.timeout, "Idle time after which a session is closed")
+	fs.BoolVar(&SessionManager.persistence, "sessionPersistence", SessionManager.persistence, "Preserve sessions across restarts of the element controller")
+	fs.BoolVar(&SessionManager.unauthenticatedMetrics, "unauthenticatedMetrics", SessionManager.unauthenticatedMetrics, "Permit the metrics to be read without authentication")
+	fs.StringVar(&SessionManager.socketRole, "socketRole", SessionManager.socketRole, "Role granted to unauthenticated clients of the Unix domain socket, whose access is controlled by the socket's permissions")
+}
+
+// Initialize the session manager. Accounts are loaded from the account file and, if session persistence
+// is enabled, any sessions recorded in the session database are restored.
+func (m *manager) Initialize(log ec.Logger) error {
+	m.log = log
+
+	if !m.enabled {
+		return nil
+	}
+
+	if err := m.loadAccounts(); err != nil {
+		log.Error(err, "Failed to load accounts", "file", m.accountFile)
+		return err
+	}
+
+	if len(m.socketRole) != 0 {
+		if err := validateRole(m.socketRole); err != nil {
+			log.Error(err, "Socket role not valid")
+			return err
+		}
+	}
+
+	if m.persistence {
+		path := "session.db"
+
+		store, err := persistent.Open(path, false)
+		if err != nil {
+			log.Error(err, "Unable to open database", "path", path)
+			return err
+		}
+
+		m.store = store
+		m.store.Register([]persistent.Registry{newSessionRecoveryRegistry(m)})
+
+		if err := m.store.Replay(); err != nil {
+			log.Error(err, "Failed to replay sessions")
+			return err
+		}
+	}
+
+	log.Info("Session authentication enabled", "accounts", len(m.accounts), "timeout", m.timeout.String())
+
+	return nil
+}
+
+func (m *manager) Close() error {
+	if m.store != nil {
+		return m.store.Close()
+	}
+
+	return nil
+}
+
+func (m *manager) loadAccounts() error {
+	if len(m.accountFile) == 0 {
+		return fmt.Errorf("Authentication requires an account file")
+	}
+
+	data, err := os.ReadFile(m.accountFile)
+	if err != nil {
+		return err
+	}
+
+	accounts := []Account{}
+	if err := json.Unmarshal(data, &accounts); err != nil {
+		return err
+	}
+
+	m.accounts = make(map[string]*Account)
+	for idx := range accounts {
+		account := &accounts[idx]
+		if len(account.UserName) == 0 || len(account.PasswordHash) == 0 {
+			return fmt.Errorf("Account %d requires a UserName and PasswordHash", idx)
+		}
+
+		if _, err := bcrypt.Cost([]byte(account.PasswordHash)); err != nil {
+			return fmt.Errorf("Account %s: PasswordHash is not a bcrypt hash: %w", account.UserName, err)
+		}
+
+		if err := validateRole(account.Role()); err != nil {
+			return fmt.Errorf("Account %s: %w", account.UserName, err)
+		}
+
+		m.accounts[account.UserName] = account
+	}
+
+	return nil
+}
+
+// HashPassword returns the bcrypt hash of a password as recorded in the account file
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return string(hash), err
+}
+
+// unknownAccountHash is compared against when the user name is not known, so an unknown user takes
+// as long to reject as a wrong password.
+var unknownAccountHash = []byte("$2a$10$zZ5rYyejskPLrpzCDM09xO35V7ec0/kw1Cm/WkB3y5QVfJQsWCNDO")
+
+// digest returns the SHA-256 digest of a session token. Tokens are random and long, so a fast digest is
+// sufficient to keep them out of memory and the session database; passwords use bcrypt instead.
+func digest(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// authenticateAccount returns the account matching the user name and password, or nil
+func (m *manager) authenticateAccount(userName, password string) *Account {
+	account, ok := m.accounts[userName]
+	if !ok {
+		bcrypt.CompareHashAndPassword(unknownAccountHash, []byte(password))
+		return nil
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(account.PasswordHash), []byte(password)); err != nil {
+		return nil
+	}
+
+	return account
+}
+
+// authenticate returns the account of the request's session token or basic authentication credentials.
+// The idle timer of a session is restarted on each authenticated request.
+func (m *manager) authenticate(r *http.Request) (*Account, error) {
+	if token := r.Header.Get(AuthTokenHeader); len(token) != 0 {
+		m.Lock()
+		defer m.Unlock()
+
+		s, ok := m.sessions[digest(token)]
+		if !ok {
+			return nil, ec.NewErrUnauthorized().WithCause("Session token not valid").WithEvent(msgreg.NoValidSessionBase())
+		}
+
+		if m.expired(s) {
+			m.deleteSession(s)
+			return nil, ec.NewErrUnauthorized().WithCause("Session expired").WithEvent(msgreg.SessionTerminatedBase())
+		}
+
+		s.lastAccess = time.Now()
+
+		return s.account, nil
+	}
+
+	if userName, password, ok := r.BasicAuth(); ok {
+		if account := m.authenticateAccount(userName, password); account != nil {
+			return account, nil
+		}
+
+		return nil, ec.NewErrUnauthorized().WithCause("Invalid credentials").WithEvent(msgreg.ResourceAtUriUnauthorizedBase(r.URL.Path, "Invalid credentials"))
+	}
+
+	// Clients of the Unix socket were permitted to connect by the socket's permissions
+	if creds := ec.PeerCredentialsFromRequest(r); creds != nil && len(m.socketRole) != 0 {
+		return &Account{UserName: peerUserName(creds), RoleId: m.socketRole}, nil
+	}
+
+	return nil, ec.NewErrUnauthorized().WithCause("Authentication required").WithEvent(msgreg.NoValidSessionBase())
+}
+
+// peerUserName returns the name of the user running the process connected to the Unix socket
+func peerUserName(creds *ec.PeerCredentials) string {
+	uid := strconv.FormatUint(uint64(creds.Uid), 10)
+	if u, err := user.LookupId(uid); err == nil {
+		return u.Username
+	}
+
+	return "uid:" + uid
+}
+
+func (m *manager) expired(s *session) bool {
+	return m.timeout != 0 && time.Since(s.lastAccess) > m.timeout
+}
+
+// expire removes all idle sessions; called with the lock held.
+func (m *manager) expire() {
+	for _, s := range m.sessions {
+		if m.expired(s) {
+			m.deleteSession(s)
+		}
+	}
+}
+
+func (m *manager) findSession(id string) *session {
+	for _, s := range m.sessions {
+		if s.id == id {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// createSession records a new session for the account and returns the session's token. The token
+// itself is not retained; sessions are located by the digest of the token.
+func (m *manager) createSession(account *Account, origin string) (*session, string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+
+	token := hex.EncodeToString(secret)
+
+	s := &session{
+		id:         uuid.New().String(),
+		tokenHash:  digest(token),
+		account:    account,
+		origin:     origin,
+		created:    time.Now(),
+		lastAccess: time.Now(),
+	}
+
+	if m.store != nil {
+		if err := m.recordSession(s); err != nil {
+			return nil, "", err
+		}
+	}
+
+	m.sessions[s.tokenHash] = s
+
+	return s, token, nil
+}
+
+// deleteSession removes the session; called with the lock held.
+func (m *manager) deleteSession(s *session) {
+	delete(m.sessions, s.tokenHash)
+
+	if m.store != nil {
+		if err := m.store.DeleteKey(sessionRegistryPrefix + s.id); err != nil {
+			m.log.Error(err, "Failed to delete session", "id", s.id)
+		}
+	}
+}
+
+// Get -
+func (m *manager) Get(model *sf.SessionServiceV117SessionService) error {
+	model.Id = "SessionService"
+	model.ServiceEnabled = m.enabled
+	model.SessionTimeout = int64(m.timeout.Seconds())
+	model.Sessions = sf.OdataV4IdRef{OdataId: SessionsOdataId}
+	model.Status = sf.ResourceStatus{State: sf.ENABLED_RST, Health: sf.OK_RH}
+	if !m.enabled {
+		model.Status.State = sf.DISABLED_RST
+	}
+
+	return nil
+}
+
+// SessionsGet -
+func (m *manager) SessionsGet(model *sf.SessionCollectionSessionCollection) error {
+	m.Lock()
+	defer m.Unlock()
+
+	m.expire()
+
+	model.Members = make([]sf.OdataV4IdRef, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		model.Members = append(model.Members, sf.OdataV4IdRef{OdataId: s.OdataId()})
+	}
+
+	sort.Slice(model.Members, func(i, j int) bool {
+		return strings.Compare(model.Members[i].OdataId, model.Members[j].OdataId) < 0
+	})
+
+	model.MembersodataCount = int64(len(model.Members))
+
+	return nil
+}
+
+// SessionsPost - Create a new session for the account credentials in the model. On success the session
+// token is returned; it must be supplied in the X-Auth-Token header of subsequent requests.
+func (m *manager) SessionsPost(model *sf.SessionV130Session, origin string) (string, error) {
+	if !m.enabled {
+		return "", ec.NewErrNotImplemented().WithCause("Session service not enabled")
+	}
+
+	if len(model.UserName) == 0 {
+		return "", ec.NewErrBadRequest().WithCause("UserName required").WithEvent(msgreg.PropertyMissingBase("UserName"))
+	}
+
+	account := m.authenticateAccount(model.UserName, model.Password)
+	if account == nil {
+		return "", ec.NewErrUnauthorized().WithCause("Invalid credentials").WithEvent(msgreg.ResourceAtUriUnauthorizedBase(SessionsOdataId, "Invalid credentials"))
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.expire()
+
+	s, token, err := m.createSession(account, origin)
+	if err != nil {
+		return "", ec.NewErrInternalServerError().WithError(err).WithCause("Failed to create session").WithEvent(msgreg.InternalErrorBase())
+	}
+
+	m.getSession(s, model)
+
+	return token, nil
+}
+
+// SessionIdGet -
+func (m *manager) SessionIdGet(id string, model *sf.SessionV130Session) error {
+	m.Lock()
+	defer m.Unlock()
+
+	s := m.findSession(id)
+	if s == nil || m.expired(s) {
+		return ec.NewErrNotFound().WithCause(fmt.Sprintf("Session %s not found", id)).WithEvent(msgreg.ResourceNotFoundBase("Session", id))
+	}
+
+	m.getSession(s, model)
+
+	return nil
+}
+
+// SessionIdDelete - Delete a session. An account may delete its own sessions; deleting the sessions
+// of other accounts requires the ConfigureManager privilege.
+func (m *manager) SessionIdDelete(id string, account *Account) error {
+	m.Lock()
+	defer m.Unlock()
+
+	s := m.findSession(id)
+	if s == nil {
+		return ec.NewErrNotFound().WithCause(fmt.Sprintf("Session %s not found", id)).WithEvent(msgreg.ResourceNotFoundBase("Session", id))
+	}
+
+	if account != nil && account.UserName != s.account.UserName && !account.HasPrivilege(ec.ConfigureManagerPrivilege) {
+		return ec.NewErrForbidden().WithCause(fmt.Sprintf("Account %s cannot delete session %s", account.UserName, id)).WithEvent(msgreg.InsufficientPrivilegeBase())
+	}
+
+	m.deleteSession(s)
+
+	return nil
+}
+
+func (m *manager) getSession(s *session, model *sf.SessionV130Session) {
+	model.OdataId = s.OdataId()
+	model.Id = s.id
+	model.UserName = s.account.UserName
+	model.Password = ""
+	model.ClientOriginIPAddress = s.origin
+	model.SessionType = sf.REDFISH_SV130ST
+}
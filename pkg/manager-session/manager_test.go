@@ -0,0 +1,226 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+func newTestManager(t *testing.T) *manager {
+	hash, err := HashPassword("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accountFile := filepath.Join(t.TempDir(), "accounts.json")
+	data, _ := json.Marshal([]Account{
+		{UserName: "admin", PasswordHash: hash, RoleId: AdministratorRoleId},
+		{UserName: "operator", PasswordHash: hash, RoleId: OperatorRoleId},
+		{UserName: "monitor", PasswordHash: hash},
+	})
+	if err := os.WriteFile(accountFile, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	m := &manager{
+		enabled:     true,
+		accountFile: accountFile,
+		timeout:     time.Minute,
+		sessions:    make(map[string]*session),
+	}
+
+	if err := m.Initialize(logr.Discard()); err != nil {
+		t.Fatalf("Failed to initialize session manager: %v", err)
+	}
+
+	return m
+}
+
+func TestSessionAuthentication(t *testing.T) {
+	m := newTestManager(t)
+
+	handler := m.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if AccountFromRequest(r) == nil {
+			t.Errorf("Authenticated request %s has no account", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(r *http.Request) int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	const path = "/redfish/v1/StorageServices/NNF/StoragePools"
+
+	if code := serve(httptest.NewRequest(http.MethodGet, path, nil)); code != http.StatusUnauthorized {
+		t.Errorf("Unauthenticated request: Expected: %d Actual: %d", http.StatusUnauthorized, code)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, path, nil)
+	r.SetBasicAuth("admin", "wrong")
+	if code := serve(r); code != http.StatusUnauthorized {
+		t.Errorf("Invalid basic auth: Expected: %d Actual: %d", http.StatusUnauthorized, code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, path, nil)
+	r.SetBasicAuth("unknown", "secret")
+	if code := serve(r); code != http.StatusUnauthorized {
+		t.Errorf("Unknown user basic auth: Expected: %d Actual: %d", http.StatusUnauthorized, code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, path, nil)
+	r.SetBasicAuth("admin", "secret")
+	if code := serve(r); code != http.StatusOK {
+		t.Errorf("Valid basic auth: Expected: %d Actual: %d", http.StatusOK, code)
+	}
+
+	if _, err := m.SessionsPost(&sf.SessionV130Session{UserName: "admin", Password: "wrong"}, ""); err == nil {
+		t.Errorf("Session created with invalid credentials")
+	}
+
+	model := &sf.SessionV130Session{UserName: "admin", Password: "secret"}
+	token, err := m.SessionsPost(model, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, path, bytes.NewBuffer(nil))
+	r.Header.Set(AuthTokenHeader, token)
+	if code := serve(r); code != http.StatusOK {
+		t.Errorf("Valid session token: Expected: %d Actual: %d", http.StatusOK, code)
+	}
+
+	// Idle sessions expire
+	m.sessions[digest(token)].lastAccess = time.Now().Add(-2 * m.timeout)
+	if code := serve(r); code != http.StatusUnauthorized {
+		t.Errorf("Expired session token: Expected: %d Actual: %d", http.StatusUnauthorized, code)
+	}
+
+	if err := m.SessionIdGet(model.Id, &sf.SessionV130Session{}); err == nil {
+		t.Errorf("Expired session %s still present", model.Id)
+	}
+}
+
+func TestUnauthenticatedRequests(t *testing.T) {
+	m := newTestManager(t)
+
+	for _, test := range []struct {
+		method          string
+		path            string
+		unauthenticated bool
+	}{
+		{http.MethodGet, "/redfish/v1/", true},
+		{http.MethodGet, ec.OpenAPIPath, true},
+		{http.MethodGet, ec.ReadinessPath, true},
+		{http.MethodPost, SessionsOdataId, true},
+		{http.MethodGet, SessionsOdataId, false},
+		{http.MethodGet, "/redfish/v1/StorageServices", false},
+		{http.MethodGet, ec.MetricsPath, false},
+	} {
+		if unauthenticated := m.isUnauthenticatedRequest(httptest.NewRequest(test.method, test.path, nil)); unauthenticated != test.unauthenticated {
+			t.Errorf("%s %s: Unauthenticated: Expected: %t Actual: %t", test.method, test.path, test.unauthenticated, unauthenticated)
+		}
+	}
+
+	// The metrics expose drive serial numbers and are only unauthenticated if explicitly permitted
+	m.unauthenticatedMetrics = true
+	if !m.isUnauthenticatedRequest(httptest.NewRequest(http.MethodGet, ec.MetricsPath, nil)) {
+		t.Errorf("Metrics require authentication when permitted without")
+	}
+}
+
+func TestAccountPasswordHash(t *testing.T) {
+	// A password digest that is not a bcrypt hash is rejected when the accounts are loaded
+	accountFile := filepath.Join(t.TempDir(), "accounts.json")
+	data, _ := json.Marshal([]Account{
+		{UserName: "admin", PasswordHash: digest("secret"), RoleId: AdministratorRoleId},
+	})
+	if err := os.WriteFile(accountFile, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	m := &manager{accountFile: accountFile}
+	if err := m.loadAccounts(); err == nil {
+		t.Errorf("Account with SHA-256 password digest loaded")
+	}
+}
+
+func TestSessionAuthorization(t *testing.T) {
+	m := newTestManager(t)
+
+	for _, test := range []struct {
+		userName string
+		method   string
+		route    ec.Route
+		expected int
+	}{
+		{"monitor", http.MethodGet, ec.Route{Method: ec.GET_METHOD}, http.StatusOK},
+		{"monitor", http.MethodDelete, ec.Route{Method: ec.DELETE_METHOD}, http.StatusForbidden},
+		{"operator", http.MethodDelete, ec.Route{Method: ec.DELETE_METHOD}, http.StatusOK},
+		{"operator", http.MethodPost, ec.Route{Method: ec.POST_METHOD, Privilege: ec.ConfigureManagerPrivilege}, http.StatusForbidden},
+		{"admin", http.MethodPost, ec.Route{Method: ec.POST_METHOD, Privilege: ec.ConfigureManagerPrivilege}, http.StatusOK},
+	} {
+		handler := m.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := m.Authorize(r, test.route.RequiredPrivilege()); err != nil {
+				ec.EncodeResponse(nil, err, w)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		r := httptest.NewRequest(test.method, "/redfish/v1/Storage/0/Volumes", nil)
+		r.SetBasicAuth(test.userName, "secret")
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		if w.Code != test.expected {
+			t.Errorf("%s %s privilege %s: Expected: %d Actual: %d", test.userName, test.method, test.route.RequiredPrivilege(), test.expected, w.Code)
+		}
+	}
+
+	// Accounts may delete their own sessions, but not the sessions of other accounts
+	model := &sf.SessionV130Session{UserName: "admin", Password: "secret"}
+	if _, err := m.SessionsPost(model, ""); err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+
+	if err := m.SessionIdDelete(model.Id, m.accounts["monitor"]); err == nil {
+		t.Errorf("Account monitor deleted session of account admin")
+	}
+
+	if err := m.SessionIdDelete(model.Id, m.accounts["admin"]); err != nil {
+		t.Errorf("Failed to delete session: %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/NearNodeFlash/nnf-ec/pkg/persistent"
+)
+
+// Session Persistence - When session persistence is enabled each session is recorded as a key in the session
+// database. The key holds only the digest of the session token, so the database cannot be used to recover an
+// active token. Sessions are restored on start with a fresh idle timer; sessions of accounts no longer in the
+// account file are discarded.
+
+const (
+	sessionRegistryPrefix = "SESSION_"
+)
+
+type sessionPersistentMetadata struct {
+	UserName  string    `json:"UserName"`
+	TokenHash string    `json:"TokenHash"`
+	Origin    string    `json:"Origin,omitempty"`
+	Created   time.Time `json:"Created"`
+}
+
+func (m *manager) recordSession(s *session) error {
+	metadata, err := json.Marshal(&sessionPersistentMetadata{
+		UserName:  s.account.UserName,
+		TokenHash: s.tokenHash,
+		Origin:    s.origin,
+		Created:   s.created,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	ledger, err := m.store.NewKey(sessionRegistryPrefix+s.id, metadata)
+	if err != nil {
+		return err
+	}
+
+	return ledger.Close(false)
+}
+
+type sessionRecoveryRegistry struct {
+	manager *manager
+}
+
+func newSessionRecoveryRegistry(m *manager) persistent.Registry {
+	return &sessionRecoveryRegistry{manager: m}
+}
+
+func (*sessionRecoveryRegistry) Prefix() string { return sessionRegistryPrefix }
+
+func (r *sessionRecoveryRegistry) NewReplay(id string) persistent.ReplayHandler {
+	return &sessionRecoveryReplayHandler{id: id, manager: r.manager}
+}
+
+type sessionRecoveryReplayHandler struct {
+	id       string
+	metadata sessionPersistentMetadata
+	manager  *manager
+}
+
+func (h *sessionRecoveryReplayHandler) Metadata(data []byte) error {
+	return json.Unmarshal(data, &h.metadata)
+}
+
+func (*sessionRecoveryReplayHandler) Entry(t uint32, data []byte) error {
+	return nil
+}
+
+func (h *sessionRecoveryReplayHandler) Done() (bool, error) {
+	m := h.manager
+
+	account, ok := m.accounts[h.metadata.UserName]
+	if !ok {
+		m.log.Info("Discarding session of removed account", "id", h.id, "user", h.metadata.UserName)
+		return true, nil
+	}
+
+	m.sessions[h.metadata.TokenHash] = &session{
+		id:         h.id,
+		tokenHash:  h.metadata.TokenHash,
+		account:    account,
+		origin:     h.metadata.Origin,
+		created:    h.metadata.Created,
+		lastAccess: time.Now(),
+	}
+
+	return false, nil
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"fmt"
+	"net/http"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	msgreg "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry/registries"
+)
+
+// Roles - The Redfish predefined roles and their assigned privileges. An account without a
+// role is granted the ReadOnly role.
+const (
+	AdministratorRoleId = "Administrator"
+	OperatorRoleId      = "Operator"
+	ReadOnlyRoleId      = "ReadOnly"
+
+	defaultRoleId = ReadOnlyRoleId
+)
+
+var roles = map[string][]ec.Privilege{
+	AdministratorRoleId: {
+		ec.LoginPrivilege,
+		ec.ConfigureComponentsPrivilege,
+		ec.ConfigureManagerPrivilege,
+		ec.ConfigureUsersPrivilege,
+		ec.ConfigureSelfPrivilege,
+	},
+	OperatorRoleId: {
+		ec.LoginPrivilege,
+		ec.ConfigureComponentsPrivilege,
+		ec.ConfigureSelfPrivilege,
+	},
+	ReadOnlyRoleId: {
+		ec.LoginPrivilege,
+		ec.ConfigureSelfPrivilege,
+	},
+}
+
+func validateRole(roleId string) error {
+	if _, ok := roles[roleId]; !ok {
+		return fmt.Errorf("Role %s not supported", roleId)
+	}
+
+	return nil
+}
+
+// HasPrivilege returns true if the account's role is assigned the privilege
+func (a *Account) HasPrivilege(privilege ec.Privilege) bool {
+	for _, p := range roles[a.Role()] {
+		if p == privilege {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Role returns the account's role identifier
+func (a *Account) Role() string {
+	if len(a.RoleId) == 0 {
+		return defaultRoleId
+	}
+
+	return a.RoleId
+}
+
+// Authorize permits the request if the authenticated account holds the privilege
+func (m *manager) Authorize(r *http.Request, privilege ec.Privilege) error {
+	if !m.enabled || m.isUnauthenticatedRequest(r) {
+		return nil
+	}
+
+	account := AccountFromRequest(r)
+	if account == nil {
+		return ec.NewErrUnauthorized().WithCause("Authentication required").WithEvent(msgreg.NoValidSessionBase())
+	}
+
+	if !account.HasPrivilege(privilege) {
+		return ec.NewErrForbidden().WithCause(fmt.Sprintf("Account %s lacks privilege %s", account.UserName, privilege)).WithEvent(msgreg.InsufficientPrivilegeBase())
+	}
+
+	return nil
+}
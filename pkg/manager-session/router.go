@@ -0,0 +1,106 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+type DefaultApiRouter struct {
+	servicer Api
+}
+
+func NewDefaultApiRouter(s Api) ec.Router {
+	return &DefaultApiRouter{servicer: s}
+}
+
+func (*DefaultApiRouter) Name() string {
+	return "Session Service"
+}
+
+func (*DefaultApiRouter) Init(log ec.Logger) error {
+	return SessionManager.Initialize(log)
+}
+
+func (*DefaultApiRouter) Start() error {
+	return nil
+}
+
+func (*DefaultApiRouter) Close() error {
+	return SessionManager.Close()
+}
+
+// Middleware authenticates requests to all routes of the element controller
+func (*DefaultApiRouter) Middleware() mux.MiddlewareFunc {
+	return SessionManager.Middleware
+}
+
+// Authorize requests to all routes of the element controller
+func (*DefaultApiRouter) Authorize(r *http.Request, privilege ec.Privilege) error {
+	return SessionManager.Authorize(r, privilege)
+}
+
+func (r *DefaultApiRouter) Routes() ec.Routes {
+	s := r.servicer
+	return ec.Routes{
+		{
+			Name:        "RedfishV1SessionServiceGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/SessionService",
+			HandlerFunc: s.RedfishV1SessionServiceGet,
+			Response:    sf.SessionServiceV117SessionService{},
+		},
+		{
+			Name:        "RedfishV1SessionServiceSessionsGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/SessionService/Sessions",
+			HandlerFunc: s.RedfishV1SessionServiceSessionsGet,
+			Response:    sf.SessionCollectionSessionCollection{},
+		},
+		{
+			Name:               "RedfishV1SessionServiceSessionsPost",
+			Method:             ec.POST_METHOD,
+			Path:               "/redfish/v1/SessionService/Sessions",
+			HandlerFunc:        s.RedfishV1SessionServiceSessionsPost,
+			Response:           sf.SessionV130Session{},
+			Model:              sf.SessionV130Session{},
+			RequiredProperties: []string{"UserName", "Password"},
+		},
+		{
+			Name:        "RedfishV1SessionServiceSessionsSessionIdGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/SessionService/Sessions/{SessionId}",
+			HandlerFunc: s.RedfishV1SessionServiceSessionsSessionIdGet,
+			Response:    sf.SessionV130Session{},
+		},
+		{
+			Name:        "RedfishV1SessionServiceSessionsSessionIdDelete",
+			Method:      ec.DELETE_METHOD,
+			Path:        "/redfish/v1/SessionService/Sessions/{SessionId}",
+			HandlerFunc: s.RedfishV1SessionServiceSessionsSessionIdDelete,
+			Privilege:   ec.ConfigureSelfPrivilege,
+		},
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package session
+
+import (
+	"net"
+	"net/http"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+
+	. "github.com/NearNodeFlash/nnf-ec/pkg/common"
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+)
+
+type DefaultApiService struct {
+	*manager
+}
+
+func NewDefaultApiService() Api {
+	return &DefaultApiService{manager: &SessionManager}
+}
+
+func (s *DefaultApiService) RedfishV1SessionServiceGet(w http.ResponseWriter, r *http.Request) {
+
+	model := sf.SessionServiceV117SessionService{
+		OdataId:   SessionServiceOdataId,
+		OdataType: "#SessionService.v1_1_7.SessionService",
+		Name:      "Session Service",
+	}
+
+	err := s.Get(&model)
+
+	EncodeResponse(model, err, w)
+}
+
+func (s *DefaultApiService) RedfishV1SessionServiceSessionsGet(w http.ResponseWriter, r *http.Request) {
+
+	model := sf.SessionCollectionSessionCollection{
+		OdataId:   SessionsOdataId,
+		OdataType: "#SessionCollection.SessionCollection",
+		Name:      "Session Collection",
+	}
+
+	err := s.SessionsGet(&model)
+
+	EncodeResponse(model, err, w)
+}
+
+func (s *DefaultApiService) RedfishV1SessionServiceSessionsPost(w http.ResponseWriter, r *http.Request) {
+
+	model := sf.SessionV130Session{}
+
+	if err := UnmarshalRequest(r, &model); err != nil {
+		err = ec.NewErrBadRequest().WithError(err).WithCause("Failed to unmarshal request")
+		EncodeResponse(model, err, w)
+		return
+	}
+
+	origin, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		origin = r.RemoteAddr
+	}
+
+	token, err := s.SessionsPost(&model, origin)
+	if err == nil {
+		model.OdataType = "#Session.v1_3_0.Session"
+		model.Name = "User Session"
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(AuthTokenHeader, token)
+		w.Header().Set("Location", model.OdataId)
+		w.WriteHeader(http.StatusCreated)
+	}
+
+	EncodeResponse(model, err, w)
+}
+
+func (s *DefaultApiService) RedfishV1SessionServiceSessionsSessionIdGet(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	sessionId := params["SessionId"]
+
+	model := sf.SessionV130Session{
+		OdataType: "#Session.v1_3_0.Session",
+		Name:      "User Session",
+	}
+
+	err := s.SessionIdGet(sessionId, &model)
+
+	EncodeResponse(model, err, w)
+}
+
+func (s *DefaultApiService) RedfishV1SessionServiceSessionsSessionIdDelete(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	sessionId := params["SessionId"]
+
+	err := s.SessionIdDelete(sessionId, AccountFromRequest(r))
+
+	EncodeResponse(nil, err, w)
+}
@@ -0,0 +1,32 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package task
+
+import (
+	"net/http"
+)
+
+type Api interface {
+	RedfishV1TaskServiceGet(w http.ResponseWriter, r *http.Request)
+	RedfishV1TaskServiceTasksGet(w http.ResponseWriter, r *http.Request)
+	RedfishV1TaskServiceTasksTaskIdGet(w http.ResponseWriter, r *http.Request)
+	RedfishV1TaskServiceTasksTaskIdDelete(w http.ResponseWriter, r *http.Request)
+	RedfishV1TaskServiceTaskMonitorsTaskIdGet(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,188 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	msgreg "github.com/NearNodeFlash/nnf-ec/pkg/manager-message-registry/registries"
+	"github.com/NearNodeFlash/nnf-ec/pkg/persistent"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+var errDatabaseClosed = errors.New("task database closed")
+
+type manager struct {
+	tasks *ec.TaskManager
+	store *persistent.Store
+	log   ec.Logger
+
+	// Tasks recorded in the store; used to choose between creating and updating a task's key
+	recorded map[string]bool
+	replayed []*ec.Task
+}
+
+var TaskManager = manager{
+	tasks:    ec.Tasks,
+	recorded: make(map[string]bool),
+}
+
+// Initialize the task manager. The task database is opened and any tasks recorded by a previous
+// run of the element controller are restored.
+func (m *manager) Initialize(log ec.Logger) error {
+	m.log = log
+
+	path := "task.db"
+
+	store, err := persistent.Open(path, false)
+	if err != nil {
+		log.Error(err, "Unable to open database", "path", path)
+		return err
+	}
+
+	m.store = store
+	m.store.Register([]persistent.Registry{newTaskRecoveryRegistry(m)})
+
+	return m.tasks.SetStore(m, log)
+}
+
+func (m *manager) Close() error {
+	if m.store != nil {
+		err := m.store.Close()
+		m.store = nil
+		return err
+	}
+
+	return nil
+}
+
+// Get -
+func (m *manager) Get(model *sf.TaskServiceV115TaskService) error {
+	now := time.Now()
+
+	model.Id = "TaskService"
+	model.ServiceEnabled = true
+	model.DateTime = &now
+	model.CompletedTaskOverWritePolicy = sf.OLDEST_TSV115OWP
+	model.Tasks = sf.OdataV4IdRef{OdataId: ec.TasksOdataId}
+	model.Status = sf.ResourceStatus{State: sf.ENABLED_RST, Health: sf.OK_RH}
+
+	return nil
+}
+
+// TasksGet -
+func (m *manager) TasksGet(model *sf.TaskCollectionTaskCollection) error {
+	ids := m.tasks.List()
+
+	model.Members = make([]sf.OdataV4IdRef, len(ids))
+	for idx, id := range ids {
+		model.Members[idx] = sf.OdataV4IdRef{OdataId: fmt.Sprintf("%s/%s", ec.TasksOdataId, id)}
+	}
+
+	model.MembersodataCount = int64(len(model.Members))
+
+	return nil
+}
+
+// TaskIdGet -
+func (m *manager) TaskIdGet(id string, model *sf.TaskV150Task) error {
+	t := m.tasks.Get(id)
+	if t == nil {
+		return ec.NewErrNotFound().WithCause(fmt.Sprintf("Task %s not found", id)).WithEvent(msgreg.ResourceNotFoundBase("Task", id))
+	}
+
+	t.Model(model)
+
+	return nil
+}
+
+// TaskIdDelete -
+func (m *manager) TaskIdDelete(id string) error {
+	return m.tasks.Delete(id)
+}
+
+// TaskMonitorGet -
+func (m *manager) TaskMonitorGet(id string, w http.ResponseWriter) error {
+	return m.tasks.Monitor(id, w)
+}
+
+// Load - Load all tasks recorded in the task database
+func (m *manager) Load() ([]*ec.Task, error) {
+	m.replayed = make([]*ec.Task, 0)
+
+	if err := m.store.Replay(); err != nil {
+		return nil, err
+	}
+
+	return m.replayed, nil
+}
+
+// Save - Record the task in the task database. The first save of a task creates the task's key;
+// subsequent saves log the updated task to the key's ledger.
+func (m *manager) Save(t *ec.Task) error {
+	if m.store == nil {
+		return errDatabaseClosed
+	}
+
+	data, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	key := taskRegistryPrefix + t.Id
+
+	if !m.recorded[t.Id] {
+		ledger, err := m.store.NewKey(key, data)
+		if err != nil {
+			return err
+		}
+
+		m.recorded[t.Id] = true
+
+		return ledger.Close(false)
+	}
+
+	ledger, err := m.store.OpenKey(key)
+	if err != nil {
+		return err
+	}
+
+	if err := ledger.Log(taskUpdateLogEntryType, data); err != nil {
+		return err
+	}
+
+	return ledger.Close(false)
+}
+
+// Delete - Delete the task from the task database
+func (m *manager) Delete(id string) error {
+	if m.store == nil {
+		return errDatabaseClosed
+	}
+
+	delete(m.recorded, id)
+
+	return m.store.DeleteKey(taskRegistryPrefix + id)
+}
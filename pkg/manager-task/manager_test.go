@@ -0,0 +1,103 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package task
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+func newTestManager(t *testing.T) *manager {
+	m := &manager{
+		tasks:    ec.NewTaskManager(),
+		recorded: make(map[string]bool),
+	}
+
+	if err := m.Initialize(logr.Discard()); err != nil {
+		t.Fatalf("Failed to initialize task manager: %v", err)
+	}
+
+	return m
+}
+
+func TestTaskInterruptedByRestart(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	m := newTestManager(t)
+
+	r := httptest.NewRequest(http.MethodPost, "/test", nil)
+	r.Header.Set("Prefer", "respond-async")
+
+	w := httptest.NewRecorder()
+	progress, release := make(chan bool), make(chan bool)
+	m.tasks.Start(w, r, "Test", func(ctx context.Context) (interface{}, error) {
+		ec.TaskFromContext(ctx).SetPercentComplete(50)
+		close(progress)
+		<-release
+		return nil, nil
+	})
+
+	id := w.Header().Get("Location")[len(ec.TaskMonitorsPath)+1:]
+
+	<-progress
+
+	// The element controller stops while the task is running
+	m.Close()
+
+	defer func() {
+		close(release)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := m.tasks.Wait(ctx); err != nil {
+			t.Errorf("Task did not complete: %v", err)
+		}
+	}()
+
+	// The running task is restored as interrupted with its last recorded progress, and remains
+	// interrupted across subsequent restarts
+	for restart := 1; restart <= 2; restart++ {
+		m := newTestManager(t)
+
+		task := m.tasks.Get(id)
+		if task == nil {
+			t.Fatalf("Restart %d: Task %s not restored", restart, id)
+		}
+
+		if task.State != ec.TaskInterrupted || task.PercentComplete != 50 {
+			t.Errorf("Restart %d: Expected task %s interrupted at 50%%, got %s at %d%%", restart, id, task.State, task.PercentComplete)
+		}
+
+		model := sf.TaskV150Task{}
+		if err := m.TaskIdGet(id, &model); err != nil || model.TaskStatus != sf.CRITICAL_RH {
+			t.Errorf("Restart %d: Unexpected task model %+v: %v", restart, model, err)
+		}
+
+		m.Close()
+	}
+}
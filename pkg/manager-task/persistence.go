@@ -0,0 +1,79 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package task
+
+import (
+	"encoding/json"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	"github.com/NearNodeFlash/nnf-ec/pkg/persistent"
+)
+
+// Task Persistence - Each task is recorded as a key in the task database. The key's metadata holds the
+// task when it was started, and each update logs the task in full; the most recent record wins on replay.
+
+const (
+	taskRegistryPrefix = "TASK_"
+)
+
+const (
+	taskUpdateLogEntryType uint32 = iota
+)
+
+type taskRecoveryRegistry struct {
+	manager *manager
+}
+
+func newTaskRecoveryRegistry(m *manager) persistent.Registry {
+	return &taskRecoveryRegistry{manager: m}
+}
+
+func (*taskRecoveryRegistry) Prefix() string { return taskRegistryPrefix }
+
+func (r *taskRecoveryRegistry) NewReplay(id string) persistent.ReplayHandler {
+	return &taskRecoveryReplayHandler{id: id, manager: r.manager}
+}
+
+type taskRecoveryReplayHandler struct {
+	id      string
+	task    ec.Task
+	manager *manager
+}
+
+func (h *taskRecoveryReplayHandler) Metadata(data []byte) error {
+	return json.Unmarshal(data, &h.task)
+}
+
+func (h *taskRecoveryReplayHandler) Entry(t uint32, data []byte) error {
+	switch t {
+	case taskUpdateLogEntryType:
+		h.task = ec.Task{}
+		return json.Unmarshal(data, &h.task)
+	}
+
+	return nil
+}
+
+func (h *taskRecoveryReplayHandler) Done() (bool, error) {
+	h.manager.recorded[h.id] = true
+	h.manager.replayed = append(h.manager.replayed, &h.task)
+
+	return false, nil
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package task
+
+import (
+	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+type DefaultApiRouter struct {
+	servicer Api
+}
+
+func NewDefaultApiRouter(s Api) ec.Router {
+	return &DefaultApiRouter{servicer: s}
+}
+
+func (*DefaultApiRouter) Name() string {
+	return "Task Service"
+}
+
+func (*DefaultApiRouter) Init(log ec.Logger) error {
+	return TaskManager.Initialize(log)
+}
+
+func (*DefaultApiRouter) Start() error {
+	return nil
+}
+
+func (*DefaultApiRouter) Close() error {
+	return TaskManager.Close()
+}
+
+func (r *DefaultApiRouter) Routes() ec.Routes {
+	s := r.servicer
+	return ec.Routes{
+		{
+			Name:        "RedfishV1TaskServiceGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/TaskService",
+			HandlerFunc: s.RedfishV1TaskServiceGet,
+			Response:    sf.TaskServiceV115TaskService{},
+		},
+		{
+			Name:        "RedfishV1TaskServiceTasksGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/TaskService/Tasks",
+			HandlerFunc: s.RedfishV1TaskServiceTasksGet,
+			Response:    sf.TaskCollectionTaskCollection{},
+		},
+		{
+			Name:        "RedfishV1TaskServiceTasksTaskIdGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/TaskService/Tasks/{TaskId}",
+			HandlerFunc: s.RedfishV1TaskServiceTasksTaskIdGet,
+			Response:    sf.TaskV150Task{},
+		},
+		{
+			Name:        "RedfishV1TaskServiceTasksTaskIdDelete",
+			Method:      ec.DELETE_METHOD,
+			Path:        "/redfish/v1/TaskService/Tasks/{TaskId}",
+			HandlerFunc: s.RedfishV1TaskServiceTasksTaskIdDelete,
+			Privilege:   ec.ConfigureManagerPrivilege,
+		},
+		{
+			Name:        "RedfishV1TaskServiceTaskMonitorsTaskIdGet",
+			Method:      ec.GET_METHOD,
+			Path:        "/redfish/v1/TaskService/TaskMonitors/{TaskId}",
+			HandlerFunc: s.RedfishV1TaskServiceTaskMonitorsTaskIdGet,
+		},
+	}
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package task
+
+import (
+	"net/http"
+
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+
+	. "github.com/NearNodeFlash/nnf-ec/pkg/common"
+)
+
+type DefaultApiService struct {
+	*manager
+}
+
+func NewDefaultApiService() Api {
+	return &DefaultApiService{manager: &TaskManager}
+}
+
+func (s *DefaultApiService) RedfishV1TaskServiceGet(w http.ResponseWriter, r *http.Request) {
+
+	model := sf.TaskServiceV115TaskService{
+		OdataId:   "/redfish/v1/TaskService",
+		OdataType: "#TaskService.v1_1_5.TaskService",
+		Name:      "Task Service",
+	}
+
+	err := s.Get(&model)
+
+	EncodeResponse(model, err, w)
+}
+
+func (s *DefaultApiService) RedfishV1TaskServiceTasksGet(w http.ResponseWriter, r *http.Request) {
+
+	model := sf.TaskCollectionTaskCollection{
+		OdataId:   "/redfish/v1/TaskService/Tasks",
+		OdataType: "#TaskCollection.TaskCollection",
+		Name:      "Task Collection",
+	}
+
+	err := s.TasksGet(&model)
+
+	EncodeResponse(model, err, w)
+}
+
+func (s *DefaultApiService) RedfishV1TaskServiceTasksTaskIdGet(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	taskId := params["TaskId"]
+
+	model := sf.TaskV150Task{}
+
+	err := s.TaskIdGet(taskId, &model)
+
+	EncodeResponse(model, err, w)
+}
+
+func (s *DefaultApiService) RedfishV1TaskServiceTasksTaskIdDelete(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	taskId := params["TaskId"]
+
+	err := s.TaskIdDelete(taskId)
+
+	EncodeResponse(nil, err, w)
+}
+
+func (s *DefaultApiService) RedfishV1TaskServiceTaskMonitorsTaskIdGet(w http.ResponseWriter, r *http.Request) {
+	params := Params(r)
+	taskId := params["TaskId"]
+
+	if err := s.TaskMonitorGet(taskId, w); err != nil {
+		EncodeResponse(nil, err, w)
+	}
+}
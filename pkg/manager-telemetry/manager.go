@@ -21,6 +21,7 @@ package telemetry
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/senseyeio/duration"
@@ -31,11 +32,18 @@ import (
 
 var TelemetryManager = manager{}
 
+// The manager's mutex protects the registered metrics and the report schedule, which are recorded
+// by the manager's background routine and read by the service handlers.
 type manager struct {
+	sync.Mutex
+
 	metrics []metric
 
 	nextReportTime time.Time   // The next time at which the manager will wake and perform a measurement of the necessary metrics
 	timer          *time.Timer // Timer used to wake the manager when necessary
+
+	stop chan struct{} // Closed to stop the background routine
+	done chan struct{} // Closed by the background routine when it has stopped
 }
 
 // Metric Definition contains the definition, metadata, or characteristics for a metric.
@@ -62,15 +70,46 @@ const (
 // Initialize the Telemetry Manager. The manager is responsible for periodically gathering
 // metrics that have been registered through calls to RegisterMetric() and have a Periodic
 // metric definition type.
+// Initializing an initialized manager restarts the background routine.
 func (m *manager) Initialize() error {
+	m.Close()
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.nextReportTime = time.Now().Add(DefaultReportDuration)
 	m.timer = time.NewTimer(DefaultReportDuration)
-	go m.run()
+	m.stop = make(chan struct{})
+	m.done = make(chan struct{})
+
+	go m.run(m.timer, m.stop, m.done)
+
+	return nil
+}
+
+// Close stops the background routine of the manager, waiting for any measurement in progress
+func (m *manager) Close() error {
+	m.Lock()
+	stop, done := m.stop, m.done
+	m.stop, m.done = nil, nil
+	if m.timer != nil {
+		m.timer.Stop()
+	}
+	m.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+
 	return nil
 }
 
 // Register Metric allows users of the Telemetry Manager to create a new Metric that will be managed and
 // reported by the Telemetry Manager.
 func (m *manager) RegisterMetric(definition *MetricDefinition, reportDefinition *MetricReportDefinition, generator MetricReportGenerator) error {
+	m.Lock()
+	defer m.Unlock()
 
 	id := definition.Id
 	if len(id) == 0 {
@@ -138,7 +177,8 @@ func (m *manager) RegisterMetric(definition *MetricDefinition, reportDefinition
 
 	// If this metric arrives before our current expiration time, adjust the
 	// metric timer to expire at this metrics desired time.
-	if !metric.nextReportTime.IsZero() && metric.nextReportTime.Before(m.nextReportTime) {
+	if m.timer != nil && !metric.nextReportTime.IsZero() && metric.nextReportTime.Before(m.nextReportTime) {
+		m.nextReportTime = metric.nextReportTime
 		m.timer.Reset(metric.nextReportTime.Sub(now))
 	}
 
@@ -147,13 +187,18 @@ func (m *manager) RegisterMetric(definition *MetricDefinition, reportDefinition
 
 // Run is meant to capture metrics that have been registered with the Telemetry Manager through calls
 // to RegisterMetric(). While run executes, it will periodically wake up to record metrics per their
-// defined Metric Definition Type and Schedule.
-func (m *manager) run() {
+// defined Metric Definition Type and Schedule. Run returns when stop is closed.
+func (m *manager) run(timer *time.Timer, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
 
 	for {
 		select {
+		case <-stop:
+			return
+
+		case currentTime := <-timer.C:
+			m.Lock()
 
-		case currentTime := <-m.timer.C:
 			nextReportTime := currentTime.Add(DefaultReportDuration)
 			for idx := range m.metrics {
 				metric := &m.metrics[idx]
@@ -184,7 +229,9 @@ func (m *manager) run() {
 
 			// Reset our timer to expire at the next metric time
 			m.nextReportTime = nextReportTime
-			m.timer.Reset(nextReportTime.Sub(currentTime))
+			timer.Reset(nextReportTime.Sub(currentTime))
+
+			m.Unlock()
 		}
 	}
 }
@@ -199,6 +246,7 @@ type metric struct {
 	nextReportTime   time.Time
 }
 
+// findMetric returns the metric with the given id. The caller must hold the manager lock.
 func findMetric(id string) *metric {
 	for idx := range TelemetryManager.metrics {
 		if TelemetryManager.metrics[idx].id == id {
@@ -309,6 +357,8 @@ func Get(model *sf.TelemetryServiceV121TelemetryService) error {
 }
 
 func MetricDefinitionsGet(model *sf.MetricDefinitionCollectionMetricDefinitionCollection) error {
+	TelemetryManager.Lock()
+	defer TelemetryManager.Unlock()
 
 	model.MembersodataCount = int64(len(TelemetryManager.metrics))
 	model.Members = make([]sf.OdataV4IdRef, model.MembersodataCount)
@@ -322,6 +372,8 @@ func MetricDefinitionsGet(model *sf.MetricDefinitionCollectionMetricDefinitionCo
 }
 
 func MetricDefinitionIdGet(model *sf.MetricDefinitionV110MetricDefinition, id string) error {
+	TelemetryManager.Lock()
+	defer TelemetryManager.Unlock()
 
 	m := findMetric(id)
 	if m == nil {
@@ -334,6 +386,8 @@ func MetricDefinitionIdGet(model *sf.MetricDefinitionV110MetricDefinition, id st
 }
 
 func MetricReportDefinitionsGet(model *sf.MetricReportDefinitionCollectionMetricReportDefinitionCollection) error {
+	TelemetryManager.Lock()
+	defer TelemetryManager.Unlock()
 
 	model.MembersodataCount = int64(len(TelemetryManager.metrics))
 	model.Members = make([]sf.OdataV4IdRef, model.MembersodataCount)
@@ -347,6 +401,9 @@ func MetricReportDefinitionsGet(model *sf.MetricReportDefinitionCollectionMetric
 }
 
 func MetricReportDefinitionIdGet(model *sf.MetricReportDefinitionV133MetricReportDefinition, id string) error {
+	TelemetryManager.Lock()
+	defer TelemetryManager.Unlock()
+
 	m := findMetric(id)
 	if m == nil {
 		return ec.NewErrNotFound()
@@ -358,6 +415,8 @@ func MetricReportDefinitionIdGet(model *sf.MetricReportDefinitionV133MetricRepor
 }
 
 func MetricReportsGet(model *sf.MetricReportCollectionMetricReportCollection) error {
+	TelemetryManager.Lock()
+	defer TelemetryManager.Unlock()
 
 	model.MembersodataCount = int64(len(TelemetryManager.metrics))
 	model.Members = make([]sf.OdataV4IdRef, model.MembersodataCount)
@@ -371,6 +430,9 @@ func MetricReportsGet(model *sf.MetricReportCollectionMetricReportCollection) er
 }
 
 func MetricReportIdGet(model *sf.MetricReportV140MetricReport, id string) error {
+	TelemetryManager.Lock()
+	defer TelemetryManager.Unlock()
+
 	m := findMetric(id)
 	if m == nil {
 		return ec.NewErrNotFound()
@@ -387,5 +449,9 @@ func MetricReportIdGet(model *sf.MetricReportV140MetricReport, id string) error
 
 	*model = m.report
 
+	// The report values are recorded in place; return a copy that is safe to encode
+	// after the lock is released.
+	model.MetricValues = append([]MetricReportValue(nil), m.report.MetricValues...)
+
 	return nil
 }
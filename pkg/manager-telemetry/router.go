@@ -21,6 +21,7 @@ package telemetry
 
 import (
 	ec "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
 )
 
 // Router contains all the Redfish / Swordfish API calls for the Telemetry Service
@@ -45,7 +46,7 @@ func (*DefaultApiRouter) Start() error {
 }
 
 func (*DefaultApiRouter) Close() error {
-	return nil
+	return TelemetryManager.Close()
 }
 
 func (r *DefaultApiRouter) Routes() ec.Routes {
@@ -56,42 +57,49 @@ func (r *DefaultApiRouter) Routes() ec.Routes {
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/TelemetryService",
 			HandlerFunc: s.RedfishV1TelemetryServiceGet,
+			Response:    sf.TelemetryServiceV121TelemetryService{},
 		},
 		{
 			Name:        "RedfishV1TelemetryMetricDefinitionsGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/TelemetryService/MetricDefinitions",
 			HandlerFunc: s.RedfishV1TelemetryMetricDefinitionsGet,
+			Response:    sf.MetricDefinitionCollectionMetricDefinitionCollection{},
 		},
 		{
 			Name:        "RedfishV1TelemetryMetricDefinitionIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/TelemetryService/MetricDefinitions/{MetricDefinitionId}",
 			HandlerFunc: s.RedfishV1TelemetryMetricDefinitionIdGet,
+			Response:    sf.MetricDefinitionV110MetricDefinition{},
 		},
 		{
 			Name:        "RedfishV1TelemetryMetricReportDefinitionsGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/TelemetryService/MetricReportDefinitions",
 			HandlerFunc: s.RedfishV1TelemetryMetricReportDefinitionsGet,
+			Response:    sf.MetricReportDefinitionCollectionMetricReportDefinitionCollection{},
 		},
 		{
 			Name:        "RedfishV1TelemetryMetricReportDefinitionIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/TelemetryService/MetricReportDefinitions/{MetricReportDefinitionId}",
 			HandlerFunc: s.RedfishV1TelemetryMetricReportDefinitionIdGet,
+			Response:    sf.MetricReportDefinitionV133MetricReportDefinition{},
 		},
 		{
 			Name:        "RedfishV1TelemetryMetricReportsGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/TelemetryService/MetricReports",
 			HandlerFunc: s.RedfishV1TelemetryMetricReportsGet,
+			Response:    sf.MetricReportCollectionMetricReportCollection{},
 		},
 		{
 			Name:        "RedfishV1TelemetryMetricReportIdGet",
 			Method:      ec.GET_METHOD,
 			Path:        "/redfish/v1/TelemetryService/MetricReports/{MetricReportId}",
 			HandlerFunc: s.RedfishV1TelemetryMetricReportIdGet,
+			Response:    sf.MetricReportV140MetricReport{},
 		},
 	}
 }
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"sync/atomic"
 )
 
 const (
@@ -35,6 +36,11 @@ type Store struct {
 	path       string
 	storage    PersistentStorageApi
 	registries []Registry
+
+	// Operations found interrupted by the most recent replay
+	interrupted []InterruptedOperation
+
+	closed atomic.Bool
 }
 
 func Open(path string, readOnly bool) (*Store, error) {
@@ -42,7 +48,27 @@ func Open(path string, readOnly bool) (*Store, error) {
 	return &Store{path: path, storage: s, registries: make([]Registry, 0)}, err
 }
 
-func (s *Store) Close() error { return s.storage.Close() }
+func (s *Store) Close() error {
+	s.closed.Store(true)
+	return s.storage.Close()
+}
+
+// IsOpen returns true if the store was opened and has not been closed
+func (s *Store) IsOpen() bool { return s != nil && s.storage != nil && !s.closed.Load() }
+
+// Size returns the bytes the store occupies on disk, split between the LSM tree and the value log. Zero
+// is returned if the store is closed or its storage cannot report a size.
+func (s *Store) Size() (lsm, vlog int64) {
+	if !s.IsOpen() {
+		return 0, 0
+	}
+
+	if sizer, ok := s.storage.(PersistentStorageSizer); ok {
+		return sizer.Size()
+	}
+
+	return 0, 0
+}
 
 func (s *Store) Register(registries []Registry) {
 	for _, registry := range registries {
@@ -58,6 +84,8 @@ func (s *Store) Register(registries []Registry) {
 }
 
 func (s *Store) Replay() error {
+	s.interrupted = make([]InterruptedOperation, 0)
+
 	for _, r := range s.registries {
 
 		deleteKeys := make([]string, 0)
@@ -104,6 +132,11 @@ func (s *Store) Replay() error {
 	return nil
 }
 
+// InterruptedOperations returns the operations found interrupted by the most recent replay
+func (s *Store) InterruptedOperations() []InterruptedOperation {
+	return s.interrupted
+}
+
 func (s *Store) MakeKey(registry Registry, id string) string {
 	return registry.Prefix() + id
 }
@@ -158,6 +191,22 @@ func (s *Store) OpenKey(key string) (*Ledger, error) {
 	return nil, ErrRegistryNotFound
 }
 
+// Value returns the key's ledger as recorded in storage
+func (s *Store) Value(key string) ([]byte, error) {
+	ledger, err := s.OpenKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return ledger.bytes, nil
+}
+
+// NewTransaction returns a transaction for updating several keys atomically. Ledgers created or opened through
+// the transaction record their updates in the transaction; nothing is written to storage until Commit.
+func (s *Store) NewTransaction() *Transaction {
+	return &Transaction{s: s, ledgers: make([]*Ledger, 0)}
+}
+
 func (s *Store) DeleteKey(key string) error {
 	ledger, err := s.OpenKey(key)
 	if err != nil {
@@ -178,11 +227,13 @@ func (s *Store) runReplay(registry Registry, key string, data []byte) (delete bo
 	id := string(key[len(registry.Prefix()):])
 	it := newIterator(data)
 	replay := registry.NewReplay(string(id))
+	lastEntryType := metadataTlvType
 	for tlv, done := it.Next(); !done; tlv, done = it.Next() {
 		if tlv.t == metadataTlvType {
 			err = replay.Metadata(tlv.v)
 		} else {
 			err = replay.Entry(tlv.t, tlv.v)
+			lastEntryType = tlv.t
 		}
 
 		if err != nil {
@@ -190,7 +241,16 @@ func (s *Store) runReplay(registry Registry, key string, data []byte) (delete bo
 		}
 	}
 
-	return replay.Done()
+	delete, err = replay.Done()
+
+	if reporter, ok := replay.(InterruptedOperationReporter); ok {
+		op := InterruptedOperation{Key: key, LastEntryType: lastEntryType}
+		if reporter.InterruptedOperation(&op) {
+			s.interrupted = append(s.interrupted, op)
+		}
+	}
+
+	return delete, err
 }
 
 type ReplayHandler interface {
@@ -199,6 +259,25 @@ type ReplayHandler interface {
 	Done() (bool, error)
 }
 
+// InterruptedOperationReporter is optionally implemented by a ReplayHandler to report a ledger that ends part
+// way through an operation, such as a start entry without the matching complete entry. It is called after Done
+// with the Key and LastEntryType fields filled in; the handler fills in the remaining fields and returns true
+// if the operation was interrupted.
+type InterruptedOperationReporter interface {
+	InterruptedOperation(op *InterruptedOperation) bool
+}
+
+// InterruptedOperation describes an operation found interrupted during replay
+type InterruptedOperation struct {
+	Key           string // The ledger key
+	LastEntryType uint32 // The type of the last entry recorded in the ledger
+
+	Object    string // The object the operation was acting on
+	Operation string // The operation that was interrupted
+	LastEntry string // The name of the last entry recorded in the ledger
+	Action    string // The action replay took as a result of the interruption
+}
+
 const (
 	metadataTlvType uint32 = 0xFFFFFFFF
 )
@@ -251,6 +330,11 @@ type Ledger struct {
 	s     *Store
 	key   string
 	bytes []byte
+
+	// The transaction this ledger belongs to, if any, and whether the ledger is
+	// to be deleted when the transaction commits.
+	txn    *Transaction
+	delete bool
 }
 
 func (l *Ledger) Log(t uint32, v []byte) error {
@@ -258,6 +342,10 @@ func (l *Ledger) Log(t uint32, v []byte) error {
 	tlv := newTlv(t, v)
 	l.bytes = append(l.bytes, tlv.bytes()...)
 
+	if l.txn != nil {
+		return nil
+	}
+
 	err := l.s.storage.Update(func(txn PersistentStorageTransactionApi) error {
 		return txn.Set(l.key, l.bytes)
 	})
@@ -266,6 +354,11 @@ func (l *Ledger) Log(t uint32, v []byte) error {
 }
 
 func (l *Ledger) Close(delete bool) error {
+	if l.txn != nil {
+		l.delete = l.delete || delete
+		return nil
+	}
+
 	if delete {
 		return l.s.storage.Delete(l.key)
 	}
@@ -279,3 +372,82 @@ func (s *Store) newKeyLedger(key string, bytes []byte) *Ledger {
 func (s *Store) existingKeyLedger(key string) *Ledger {
 	return &Ledger{s: s, key: key}
 }
+
+// Transaction groups the updates to several ledgers so they are written to storage in a single storage
+// transaction; either every update is applied or none are.
+type Transaction struct {
+	s       *Store
+	ledgers []*Ledger
+	done    bool
+}
+
+// NewKey creates the provided key with metadata as part of the transaction.
+func (txn *Transaction) NewKey(key string, metadata []byte) (*Ledger, error) {
+	if !txn.s.isRegistered(key) {
+		return nil, ErrRegistryNotFound
+	}
+
+	tlv := newTlv(metadataTlvType, metadata)
+
+	return txn.add(txn.s.newKeyLedger(key, tlv.bytes())), nil
+}
+
+// OpenKey opens an existing key as part of the transaction.
+func (txn *Transaction) OpenKey(key string) (*Ledger, error) {
+	ledger, err := txn.s.OpenKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return txn.add(ledger), nil
+}
+
+// Commit writes every ledger in the transaction to storage atomically. The transaction cannot be used
+// once committed or discarded.
+func (txn *Transaction) Commit() error {
+	if txn.done {
+		return ErrTransactionDone
+	}
+	txn.done = true
+
+	return txn.s.storage.Update(func(t PersistentStorageTransactionApi) error {
+		for _, l := range txn.ledgers {
+			if l.delete {
+				if err := t.Delete(l.key); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := t.Set(l.key, l.bytes); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Discard drops every update recorded in the transaction.
+func (txn *Transaction) Discard() {
+	txn.done = true
+	txn.ledgers = nil
+}
+
+func (txn *Transaction) add(ledger *Ledger) *Ledger {
+	ledger.txn = txn
+	txn.ledgers = append(txn.ledgers, ledger)
+	return ledger
+}
+
+func (s *Store) isRegistered(key string) bool {
+	for _, r := range s.registries {
+		if strings.HasPrefix(key, r.Prefix()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var ErrTransactionDone = errors.New("transaction has already been committed or discarded")
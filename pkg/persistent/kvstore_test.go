@@ -138,3 +138,154 @@ func TestStore(t *testing.T) {
 		}
 	}
 }
+
+type testTransactionRegistry struct{}
+
+func (*testTransactionRegistry) Prefix() string                 { return "TX" }
+func (*testTransactionRegistry) NewReplay(string) ReplayHandler { return nil }
+
+func TestStoreTransaction(t *testing.T) {
+	store, err := Open("testing-transaction.db", false)
+	if err != nil {
+		t.Fatalf("Failed to open testing-transaction.db: Error: %s", err)
+	}
+
+	defer store.Close()
+
+	registry := testTransactionRegistry{}
+	store.Register([]Registry{&registry})
+
+	keys := []string{store.MakeKey(&registry, "0"), store.MakeKey(&registry, "1")}
+
+	// Create several keys in a single transaction
+	{
+		txn := store.NewTransaction()
+		for _, key := range keys {
+			ledger, err := txn.NewKey(key, testMetadata[:])
+			if err != nil {
+				t.Fatalf("Failed to create new ledger key %s: Error: %s", key, err)
+			}
+
+			if err := ledger.Log(0, []byte("0")); err != nil {
+				t.Errorf("Failed to log ledger entry for key %s: Error: %s", key, err)
+			}
+		}
+
+		// Nothing is written until the transaction commits
+		for _, key := range keys {
+			if _, err := store.OpenKey(key); err == nil {
+				t.Errorf("Key %s present before transaction commit", key)
+			}
+		}
+
+		if err := txn.Commit(); err != nil {
+			t.Fatalf("Failed to commit transaction: Error: %s", err)
+		}
+
+		if err := txn.Commit(); err == nil {
+			t.Errorf("Second commit of transaction succeeded")
+		}
+	}
+
+	// Log to and delete several keys in a single transaction
+	{
+		txn := store.NewTransaction()
+		for _, key := range keys {
+			ledger, err := txn.OpenKey(key)
+			if err != nil {
+				t.Fatalf("Failed to open ledger key %s: Error: %s", key, err)
+			}
+
+			if err := ledger.Log(1, []byte("1")); err != nil {
+				t.Errorf("Failed to log ledger entry for key %s: Error: %s", key, err)
+			}
+
+			ledger.Close(true)
+		}
+
+		for _, key := range keys {
+			if _, err := store.OpenKey(key); err != nil {
+				t.Errorf("Key %s removed before transaction commit", key)
+			}
+		}
+
+		if err := txn.Commit(); err != nil {
+			t.Fatalf("Failed to commit transaction: Error: %s", err)
+		}
+
+		for _, key := range keys {
+			if _, err := store.OpenKey(key); err == nil {
+				t.Errorf("Key %s present after transaction delete", key)
+			}
+		}
+	}
+}
+
+type testInterruptedRegistry struct{}
+
+func (*testInterruptedRegistry) Prefix() string { return "TI" }
+func (*testInterruptedRegistry) NewReplay(id string) ReplayHandler {
+	return &testInterruptedReplay{}
+}
+
+type testInterruptedReplay struct {
+	lastEntryType uint32
+}
+
+func (*testInterruptedReplay) Metadata([]byte) error { return nil }
+func (r *testInterruptedReplay) Entry(t uint32, data []byte) error {
+	r.lastEntryType = t
+	return nil
+}
+func (*testInterruptedReplay) Done() (bool, error) { return false, nil }
+
+// Even entry types start an operation and odd entry types complete it
+func (r *testInterruptedReplay) InterruptedOperation(op *InterruptedOperation) bool {
+	if r.lastEntryType%2 != 0 {
+		return false
+	}
+
+	op.Operation = "Test"
+	return true
+}
+
+func TestStoreInterruptedOperations(t *testing.T) {
+	store, err := Open("testing-interrupted.db", false)
+	if err != nil {
+		t.Fatalf("Failed to open testing-interrupted.db: Error: %s", err)
+	}
+
+	defer store.Close()
+
+	registry := testInterruptedRegistry{}
+	store.Register([]Registry{&registry})
+
+	for id, entries := range map[string]int{"complete": 2, "interrupted": 3} {
+		key := store.MakeKey(&registry, id)
+		ledger, err := store.NewKey(key, testMetadata[:])
+		if err != nil {
+			t.Fatalf("Failed to create new ledger key %s: Error: %s", key, err)
+		}
+
+		for i := 0; i < entries; i++ {
+			if err := ledger.Log(uint32(i), nil); err != nil {
+				t.Errorf("Failed to log ledger entry %d: Error: %s", i, err)
+			}
+		}
+
+		defer store.DeleteKey(key)
+	}
+
+	if err := store.Replay(); err != nil {
+		t.Fatalf("Failed to run replay: Error: %s", err)
+	}
+
+	ops := store.InterruptedOperations()
+	if len(ops) != 1 {
+		t.Fatalf("Interrupted operation count incorrect: Expected: 1 Actual: %d", len(ops))
+	}
+
+	if ops[0].Key != store.MakeKey(&registry, "interrupted") || ops[0].LastEntryType != 2 || ops[0].Operation != "Test" {
+		t.Errorf("Interrupted operation incorrect: %+v", ops[0])
+	}
+}
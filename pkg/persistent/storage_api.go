@@ -56,11 +56,18 @@ type PersistentStorageApi interface {
 	Close() error
 }
 
+// PersistentStorageSizer is optionally implemented by a PersistentStorageApi that can report the bytes it
+// occupies on disk, split between the log-structured merge tree and the value log.
+type PersistentStorageSizer interface {
+	Size() (lsm, vlog int64)
+}
+
 // PersistentStorageTransactionApi provides an interface for interacting with persistent storage transactions
 type PersistentStorageTransactionApi interface {
 	NewIterator(prefix string) PersistentStorageIteratorApi
 	Set(key string, value []byte) error
 	Get(key string) ([]byte, error)
+	Delete(key string) error
 }
 
 // Persistent Storage Iterator API provides an iterface for interacting with persistent storage iterators
@@ -47,6 +47,11 @@ func (txn *base64PersistentStorageTransaction) Set(key string, value []byte) err
 	return nil
 }
 
+func (txn *base64PersistentStorageTransaction) Delete(key string) error {
+	delete(txn.data, key)
+	return nil
+}
+
 func (txn *base64PersistentStorageTransaction) NewIterator(prefix string) PersistentStorageIteratorApi {
 	itr := base64PersistentStorageIterator{
 		keys:  make([]string, 0),
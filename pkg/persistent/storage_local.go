@@ -91,6 +91,11 @@ func (s *localPersistentStorage) Close() error {
 	return err
 }
 
+// Size returns the sizes of the LSM tree and value log as last computed by BadgerDB
+func (s *localPersistentStorage) Size() (lsm, vlog int64) {
+	return s.DB.Size()
+}
+
 func (s *localPersistentStorage) View(fn func(PersistentStorageTransactionApi) error) error {
 	return s.DB.View(func(txn *badger.Txn) error {
 		return fn(&localPersistentStorageTransaction{txn})
@@ -161,6 +166,10 @@ func (txn *localPersistentStorageTransaction) Set(key string, value []byte) erro
 	return txn.Txn.Set([]byte(key), []byte(value))
 }
 
+func (txn *localPersistentStorageTransaction) Delete(key string) error {
+	return txn.Txn.Delete([]byte(key))
+}
+
 func (txn *localPersistentStorageTransaction) Get(key string) ([]byte, error) {
 	value := []byte{}
 	item, err := txn.Txn.Get([]byte(key))
@@ -11,6 +11,7 @@
 package openapi
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -77,32 +78,67 @@ func UnmarshalOem(oem map[string]interface{}, out interface{}) error {
 			continue
 		}
 
+		value := oem[fieldName]
+		typeError := &UnmarshalOemTypeError{Field: fieldName, Value: value, Type: fieldTyp.Type}
+
 		switch fieldTyp.Type.Kind() {
 		case reflect.Bool:
-			fieldVal.SetBool(oem[fieldName].(bool))
+			b, ok := value.(bool)
+			if !ok {
+				return typeError
+			}
+			fieldVal.SetBool(b)
 		case reflect.Int:
-			if _, ok := oem[fieldName].(float64); ok {
-				fieldVal.SetInt(int64(oem[fieldName].(float64)))
-			} else {
-				fieldVal.SetInt(oem[fieldName].(int64))
+			switch v := value.(type) {
+			case float64:
+				fieldVal.SetInt(int64(v))
+			case int64:
+				fieldVal.SetInt(v)
+			default:
+				return typeError
 			}
 		case reflect.String:
-			fieldVal.SetString(oem[fieldName].(string))
+			str, ok := value.(string)
+			if !ok {
+				return typeError
+			}
+			fieldVal.SetString(str)
 		case reflect.Struct:
-			if err := UnmarshalOem(oem[fieldName].(map[string]interface{}), fieldVal.Addr().Interface()); err != nil {
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				return typeError
+			}
+			if err := UnmarshalOem(m, fieldVal.Addr().Interface()); err != nil {
 				return err
 			}
 		case reflect.Slice:
 
-			arr := reflect.ValueOf(oem[fieldName])
+			arr := reflect.ValueOf(value)
+			if arr.Kind() != reflect.Slice {
+				return typeError
+			}
 
 			// Resize the fieldVal to take the provided slice/array length
 			fieldVal.Set(reflect.MakeSlice(fieldVal.Type(), arr.Len(), arr.Len()))
 
-			// This assumes an array of strings. We could probably nest this same as reflect.Struct
-			// to make it an array of arbitrary types if we wanted to.
+			// Elements are either of the slice type (as encoded by MarshalOem), or, for a slice of
+			// structures decoded from JSON, a map that is unmarshaled the same as reflect.Struct.
+			// Anything else must be a string.
 			for j := 0; j < arr.Len(); j++ {
-				fieldVal.Index(j).SetString(arr.Index(j).Interface().(string))
+				elemVal := fieldVal.Index(j)
+				elem := arr.Index(j).Interface()
+
+				if elem != nil && reflect.TypeOf(elem).AssignableTo(elemVal.Type()) {
+					elemVal.Set(reflect.ValueOf(elem))
+				} else if m, ok := elem.(map[string]interface{}); ok && elemVal.Kind() == reflect.Struct {
+					if err := UnmarshalOem(m, elemVal.Addr().Interface()); err != nil {
+						return err
+					}
+				} else if str, ok := elem.(string); ok && elemVal.Kind() == reflect.String {
+					elemVal.SetString(str)
+				} else {
+					return typeError
+				}
 			}
 
 		default:
@@ -143,3 +179,15 @@ func (e *InvalidUnmarshalOemError) Error() string {
 
 	return "oem: Unmarshal(nil " + e.Type.String() + ")"
 }
+
+// UnmarshalOemTypeError describes an OEM value that does not match the type of the field it is
+// unmarshaled into
+type UnmarshalOemTypeError struct {
+	Field string
+	Value interface{}
+	Type  reflect.Type
+}
+
+func (e *UnmarshalOemTypeError) Error() string {
+	return fmt.Sprintf("oem: cannot unmarshal %T into field %s of type %s", e.Value, e.Field, e.Type.String())
+}
@@ -16,10 +16,11 @@ func Test(t *testing.T) {
 	}
 
 	type Oem struct {
-		Bool   bool
-		String string
-		Slice  []string
-		Struct OemNested
+		Bool        bool
+		String      string
+		Slice       []string
+		Struct      OemNested
+		StructSlice []OemNested
 	}
 
 	oem := Oem{
@@ -29,6 +30,7 @@ func Test(t *testing.T) {
 		Struct: OemNested{
 			Int: 42,
 		},
+		StructSlice: []OemNested{{Int: 1}, {Int: 2}},
 	}
 
 	oem.Slice[0] = "test0"
@@ -65,4 +67,54 @@ func Test(t *testing.T) {
 			t.Errorf("Slice index %d mismatch: Expected: '%s' Actual: '%s'", i, v, oem2.Slice[i])
 		}
 	}
+
+	if len(oem2.StructSlice) != len(oem.StructSlice) {
+		t.Fatalf("Struct slice length mismatch: Expected: %d Actual: %d", len(oem.StructSlice), len(oem2.StructSlice))
+	}
+
+	for i, v := range oem.StructSlice {
+		if v.Int != oem2.StructSlice[i].Int {
+			t.Errorf("Struct slice index %d mismatch: Expected: %d Actual: %d", i, v.Int, oem2.StructSlice[i].Int)
+		}
+	}
+
+	// Unmarshal directly from the marshaled OEM data without a round trip through JSON
+	oem3 := Oem{}
+	if err := UnmarshalOem(MarshalOem(oem), &oem3); err != nil {
+		t.Error(err)
+	}
+
+	if len(oem3.StructSlice) != len(oem.StructSlice) || oem3.StructSlice[1].Int != oem.StructSlice[1].Int {
+		t.Errorf("Failed unmarshal of struct slice OEM data: %+v", oem3.StructSlice)
+	}
+}
+
+func TestUnmarshalOemTypeError(t *testing.T) {
+
+	type OemNested struct {
+		Int int
+	}
+
+	type Oem struct {
+		Bool        bool
+		String      string
+		Slice       []string
+		Struct      OemNested
+		StructSlice []OemNested
+	}
+
+	for _, oem := range []map[string]interface{}{
+		{"Bool": "true"},
+		{"String": 42.0},
+		{"Slice": "test0"},
+		{"Slice": []interface{}{42.0}},
+		{"Struct": "nested"},
+		{"Struct": map[string]interface{}{"Int": "42"}},
+		{"StructSlice": []interface{}{"nested"}},
+	} {
+		err := UnmarshalOem(oem, &Oem{})
+		if _, ok := err.(*UnmarshalOemTypeError); !ok {
+			t.Errorf("Unmarshal %v: Expected type error: Actual: %v", oem, err)
+		}
+	}
 }
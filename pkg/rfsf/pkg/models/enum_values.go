@@ -0,0 +1,348 @@
+/*
+ * Swordfish API
+ *
+ * This contains the definition of the Swordfish extensions to a Redfish service.
+ *
+ * Code generated by enum_values_generator.py. DO NOT EDIT.
+ */
+
+package openapi
+
+// EnumValues returns the allowed values of the named enumerated string type, or false if the
+// type is not enumerated
+func EnumValues(name string) ([]string, bool) {
+	values, ok := enumValues[name]
+	return values, ok
+}
+
+var enumValues = map[string][]string{
+	"AccelerationFunctionV102AccelerationFunctionType":      {"Encryption", "Compression", "PacketInspection", "PacketSwitch", "Scheduler", "AudioProcessing", "VideoProcessing", "OEM"},
+	"AccountServiceV172AccountProviderTypes":                {"RedfishService", "ActiveDirectoryService", "LDAPService", "OEM"},
+	"AccountServiceV172AuthenticationTypes":                 {"Token", "KerberosKeytab", "UsernameAndPassword", "OEM"},
+	"AccountServiceV172LocalAccountAuth":                    {"Enabled", "Disabled", "Fallback", "LocalFirst"},
+	"CertificateCertificateType":                            {"PEM", "PKCS7"},
+	"CertificateKeyUsage":                                   {"DigitalSignature", "NonRepudiation", "KeyEncipherment", "DataEncipherment", "KeyAgreement", "KeyCertSign", "CRLSigning", "EncipherOnly", "DecipherOnly", "ServerAuthentication", "ClientAuthentication", "CodeSigning", "EmailProtection", "Timestamping", "OCSPSigning"},
+	"ChassisV1140ChassisType":                               {"Rack", "Blade", "Enclosure", "StandAlone", "RackMount", "Card", "Cartridge", "Row", "Pod", "Expansion", "Sidecar", "Zone", "Sled", "Shelf", "Drawer", "Module", "Component", "IPBasedDrive", "RackGroup", "StorageEnclosure", "Other"},
+	"ChassisV1140EnvironmentalClass":                        {"A1", "A2", "A3", "A4"},
+	"ChassisV1140IndicatorLED":                              {"Unknown", "Lit", "Blinking", "Off"},
+	"ChassisV1140IntrusionSensor":                           {"Normal", "HardwareIntrusion", "TamperingDetected"},
+	"ChassisV1140IntrusionSensorReArm":                      {"Manual", "Automatic"},
+	"ChassisV1140PowerState":                                {"On", "Off", "PoweringOn", "PoweringOff"},
+	"CircuitBreakerStates":                                  {"Normal", "Tripped", "Off"},
+	"CircuitNominalVoltageType":                             {"AC100To240V", "AC100To277V", "AC120V", "AC200To240V", "AC200To277V", "AC208V", "AC230V", "AC240V", "AC240AndDC380V", "AC277V", "AC277AndDC380V", "AC400V", "AC480V", "DC240V", "DC380V", "DCNeg48V"},
+	"CircuitPhaseWiringType":                                {"OnePhase3Wire", "TwoPhase3Wire", "OneOrTwoPhase3Wire", "TwoPhase4Wire", "ThreePhase4Wire", "ThreePhase5Wire"},
+	"CircuitPlugType":                                       {"NEMA_5_15P", "NEMA_L5_15P", "NEMA_5_20P", "NEMA_L5_20P", "NEMA_L5_30P", "NEMA_6_15P", "NEMA_L6_15P", "NEMA_6_20P", "NEMA_L6_20P", "NEMA_L6_30P", "NEMA_L14_20P", "NEMA_L14_30P", "NEMA_L15_20P", "NEMA_L15_30P", "NEMA_L21_20P", "NEMA_L21_30P", "NEMA_L22_20P", "NEMA_L22_30P", "California_CS8265", "California_CS8365", "IEC_60320_C14", "IEC_60320_C20", "IEC_60309_316P6", "IEC_60309_332P6", "IEC_60309_363P6", "IEC_60309_516P6", "IEC_60309_532P6", "IEC_60309_563P6", "IEC_60309_460P9", "IEC_60309_560P9", "Field_208V_3P4W_60A", "Field_400V_3P5W_32A"},
+	"CircuitPowerRestorePolicyTypes":                        {"AlwaysOn", "AlwaysOff", "LastState"},
+	"CircuitPowerState":                                     {"On", "Off"},
+	"CircuitV110CircuitType":                                {"Mains", "Branch", "Subfeed", "Feeder"},
+	"CircuitV110VoltageType":                                {"AC", "DC"},
+	"ComputerSystemBootSource":                              {"None", "Pxe", "Floppy", "Cd", "Usb", "Hdd", "BiosSetup", "Utilities", "Diags", "UefiShell", "UefiTarget", "SDCard", "UefiHttp", "RemoteDrive", "UefiBootNext"},
+	"ComputerSystemV1130AutomaticRetryConfig":               {"Disabled", "RetryAttempts", "RetryAlways"},
+	"ComputerSystemV1130BootOrderTypes":                     {"BootOrder", "AliasBootOrder"},
+	"ComputerSystemV1130BootProgressTypes":                  {"None", "PrimaryProcessorInitializationStarted", "BusInitializationStarted", "MemoryInitializationStarted", "SecondaryProcessorInitializationStarted", "PCIResourceConfigStarted", "SystemHardwareInitializationComplete", "OSBootStarted", "OSRunning", "OEM"},
+	"ComputerSystemV1130BootSourceOverrideEnabled":          {"Disabled", "Once", "Continuous"},
+	"ComputerSystemV1130BootSourceOverrideMode":             {"Legacy", "UEFI"},
+	"ComputerSystemV1130GraphicalConnectTypesSupported":     {"KVMIP", "OEM"},
+	"ComputerSystemV1130HostingRole":                        {"ApplicationServer", "StorageServer", "Switch", "Appliance", "BareMetalServer", "VirtualMachineServer", "ContainerServer"},
+	"ComputerSystemV1130IndicatorLED":                       {"Unknown", "Lit", "Blinking", "Off"},
+	"ComputerSystemV1130InterfaceType":                      {"TPM1_2", "TPM2_0", "TCM1_0"},
+	"ComputerSystemV1130InterfaceTypeSelection":             {"None", "FirmwareUpdate", "BiosSetting", "OemMethod"},
+	"ComputerSystemV1130MemoryMirroring":                    {"System", "DIMM", "Hybrid", "None"},
+	"ComputerSystemV1130PowerRestorePolicyTypes":            {"AlwaysOn", "AlwaysOff", "LastState"},
+	"ComputerSystemV1130PowerState":                         {"On", "Off", "PoweringOn", "PoweringOff"},
+	"ComputerSystemV1130SystemType":                         {"Physical", "Virtual", "OS", "PhysicallyPartitioned", "VirtuallyPartitioned", "Composed"},
+	"ComputerSystemV1130WatchdogTimeoutActions":             {"None", "ResetSystem", "PowerCycle", "PowerDown", "OEM"},
+	"ComputerSystemV1130WatchdogWarningActions":             {"None", "DiagnosticInterrupt", "SMI", "MessagingInterrupt", "SCI", "OEM"},
+	"ConnectionMethodV100ConnectionMethodType":              {"Redfish", "SNMP", "IPMI15", "IPMI20", "NETCONF", "OEM"},
+	"ConnectionV100AccessCapability":                        {"Read", "Write"},
+	"ConnectionV100AccessState":                             {"Optimized", "NonOptimized", "Standby", "Unavailable", "Transitioning"},
+	"ConnectionV100ConnectionType":                          {"Storage", "Memory"},
+	"ConsistencyGroupApplicationConsistencyMethod":          {"HotStandby", "VASA", "VDI", "VSS", "Other"},
+	"ConsistencyGroupConsistencyType":                       {"CrashConsistent", "ApplicationConsistent"},
+	"DataProtectionLoSCapabilitiesFailureDomainScope":       {"Server", "Rack", "RackGroup", "Row", "Datacenter", "Region"},
+	"DataProtectionLoSCapabilitiesRecoveryAccessScope":      {"OnlineActive", "OnlinePassive", "Nearline", "Offline"},
+	"DataSecurityLoSCapabilitiesAntiVirusScanTrigger":       {"None", "OnFirstRead", "OnPatternUpdate", "OnUpdate", "OnRename"},
+	"DataSecurityLoSCapabilitiesAuthenticationType":         {"None", "PKI", "Ticket", "Password"},
+	"DataSecurityLoSCapabilitiesDataSanitizationPolicy":     {"None", "Clear", "CryptographicErase"},
+	"DataSecurityLoSCapabilitiesKeySize":                    {"Bits_0", "Bits_112", "Bits_128", "Bits_192", "Bits_256"},
+	"DataSecurityLoSCapabilitiesSecureChannelProtocol":      {"None", "TLS", "IPsec", "RPCSEC_GSS"},
+	"DataStorageLoSCapabilitiesProvisioningPolicy":          {"Fixed", "Thin"},
+	"DataStorageLoSCapabilitiesStorageAccessCapability":     {"Read", "Write", "WriteOnce", "Append", "Streaming", "Execute"},
+	"DriveV1110EncryptionAbility":                           {"None", "SelfEncryptingDrive", "Other"},
+	"DriveV1110EncryptionStatus":                            {"Unecrypted", "Unlocked", "Locked", "Foreign", "Unencrypted"},
+	"DriveV1110HotspareReplacementModeType":                 {"Revertible", "NonRevertible"},
+	"DriveV1110HotspareType":                                {"None", "Global", "Chassis", "Dedicated"},
+	"DriveV1110MediaType":                                   {"HDD", "SSD", "SMR"},
+	"DriveV1110StatusIndicator":                             {"OK", "Fail", "Rebuild", "PredictiveFailureAnalysis", "Hotspare", "InACriticalArray", "InAFailedArray"},
+	"EndpointGroupAccessState":                              {"Optimized", "NonOptimized", "Standby", "Unavailable", "Transitioning"},
+	"EndpointGroupV130GroupType":                            {"Client", "Server", "Initiator", "Target"},
+	"EndpointV150EntityRole":                                {"Initiator", "Target", "Both"},
+	"EndpointV150EntityType":                                {"StorageInitiator", "RootComplex", "NetworkController", "Drive", "StorageExpander", "DisplayController", "Bridge", "Processor", "Volume", "AccelerationFunction", "MediaController", "MemoryChunk", "Switch", "FabricBridge", "Manager"},
+	"EthernetInterfaceV162DHCPFallback":                     {"Static", "AutoConfig", "None"},
+	"EthernetInterfaceV162DHCPv6OperatingMode":              {"Stateful", "Stateless", "Disabled"},
+	"EthernetInterfaceV162EthernetDeviceType":               {"Physical", "Virtual"},
+	"EthernetInterfaceV162LinkStatus":                       {"LinkUp", "NoLink", "LinkDown"},
+	"EventDestinationEventFormatType":                       {"Event", "MetricReport"},
+	"EventDestinationV190DeliveryRetryPolicy":               {"TerminateAfterRetries", "SuspendRetries", "RetryForever"},
+	"EventDestinationV190EventDestinationProtocol":          {"Redfish", "SNMPv1", "SNMPv2c", "SNMPv3", "SMTP", "SyslogTLS", "SyslogTCP", "SyslogUDP", "SyslogRELP", "OEM"},
+	"EventDestinationV190SNMPAuthenticationProtocols":       {"None", "CommunityString", "HMAC_MD5", "HMAC_SHA96"},
+	"EventDestinationV190SNMPEncryptionProtocols":           {"None", "CBC_DES", "CFB128_AES128"},
+	"EventDestinationV190SubscriptionType":                  {"RedfishEvent", "SSE", "SNMPTrap", "SNMPInform", "Syslog", "OEM"},
+	"EventDestinationV190SyslogFacility":                    {"Kern", "User", "Mail", "Daemon", "Auth", "Syslog", "LPR", "News", "UUCP", "Cron", "Authpriv", "FTP", "NTP", "Security", "Console", "SolarisCron", "Local0", "Local1", "Local2", "Local3", "Local4", "Local5", "Local6", "Local7"},
+	"EventDestinationV190SyslogSeverity":                    {"Emergency", "Alert", "Critical", "Error", "Warning", "Notice", "Informational", "Debug", "All"},
+	"EventEventType":                                        {"StatusChange", "ResourceUpdated", "ResourceAdded", "ResourceRemoved", "Alert", "MetricReport", "Other"},
+	"EventServiceV170SMTPAuthenticationMethods":             {"None", "AutoDetect", "Plain", "Login", "CRAM_MD5"},
+	"EventServiceV170SMTPConnectionProtocol":                {"None", "AutoDetect", "StartTLS", "TLS_SSL"},
+	"ExternalAccountProviderV113AccountProviderTypes":       {"RedfishService", "ActiveDirectoryService", "LDAPService", "OEM"},
+	"ExternalAccountProviderV113AuthenticationTypes":        {"Token", "KerberosKeytab", "UsernameAndPassword", "OEM"},
+	"FacilityV101FacilityType":                              {"Room", "Floor", "Building", "Site"},
+	"FileShareV120QuotaType":                                {"Soft", "Hard"},
+	"FileSystemFileProtocol":                                {"NFSv3", "NFSv4_0", "NFSv4_1", "SMBv2_0", "SMBv2_1", "SMBv3_0", "SMBv3_0_2", "SMBv3_1_1"},
+	"FileSystemV122CharacterCodeSet":                        {"ASCII", "Unicode", "ISO2022", "ISO8859_1", "ExtendedUNIXCode", "UTF_8", "UTF_16", "UCS_2"},
+	"HostInterfaceV130AuthenticationMode":                   {"AuthNone", "BasicAuth", "RedfishSessionAuth", "OemAuth"},
+	"HostInterfaceV130HostInterfaceType":                    {"NetworkHostInterface"},
+	"IOPerformanceLoSCapabilitiesV100IOAccessPattern":       {"ReadWrite", "SequentialRead", "SequentialWrite", "RandomReadNew", "RandomReadAgain"},
+	"IOPerformanceLoSCapabilitiesV130IOAccessPattern":       {"ReadWrite", "SequentialRead", "SequentialWrite", "RandomReadNew", "RandomReadAgain"},
+	"IPAddressesV1010AddressState":                          {"Preferred", "Deprecated", "Tentative", "Failed"},
+	"IPAddressesV1010IPv4AddressOrigin":                     {"Static", "DHCP", "BOOTP", "IPv4LinkLocal"},
+	"IPAddressesV1010IPv6AddressOrigin":                     {"Static", "DHCPv6", "LinkLocal", "SLAAC"},
+	"IPAddressesV113AddressState":                           {"Preferred", "Deprecated", "Tentative", "Failed"},
+	"IPAddressesV113IPv4AddressOrigin":                      {"Static", "DHCP", "BOOTP", "IPv4LinkLocal"},
+	"IPAddressesV113IPv6AddressOrigin":                      {"Static", "DHCPv6", "LinkLocal", "SLAAC"},
+	"JobV105JobState":                                       {"New", "Starting", "Running", "Suspended", "Interrupted", "Pending", "Stopping", "Completed", "Cancelled", "Exception", "Service", "UserIntervention", "Continue"},
+	"LogEntryV170EventSeverity":                             {"OK", "Warning", "Critical"},
+	"LogEntryV170LogDiagnosticDataTypes":                    {"Manager", "PreOS", "OS", "OEM"},
+	"LogEntryV170LogEntryCode":                              {"Assert", "Deassert", "Lower Non-critical - going low", "Lower Non-critical - going high", "Lower Critical - going low", "Lower Critical - going high", "Lower Non-recoverable - going low", "Lower Non-recoverable - going high", "Upper Non-critical - going low", "Upper Non-critical - going high", "Upper Critical - going low", "Upper Critical - going high", "Upper Non-recoverable - going low", "Upper Non-recoverable - going high", "Transition to Idle", "Transition to Active", "Transition to Busy", "State Deasserted", "State Asserted", "Predictive Failure deasserted", "Predictive Failure asserted", "Limit Not Exceeded", "Limit Exceeded", "Performance Met", "Performance Lags", "Transition to OK", "Transition to Non-Critical from OK", "Transition to Critical from less severe", "Transition to Non-recoverable from less severe", "Transition to Non-Critical from more severe", "Transition to Critical from Non-recoverable", "Transition to Non-recoverable", "Monitor", "Informational", "Device Removed / Device Absent", "Device Inserted / Device Present", "Device Disabled", "Device Enabled", "Transition to Running", "Transition to In Test", "Transition to Power Off", "Transition to On Line", "Transition to Off Line", "Transition to Off Duty", "Transition to Degraded", "Transition to Power Save", "Install Error", "Fully Redundant", "Redundancy Lost", "Redundancy Degraded", "Non-redundant:Sufficient Resources from Redundant", "Non-redundant:Sufficient Resources from Insufficient Resources", "Non-redundant:Insufficient Resources", "Redundancy Degraded from Fully Redundant", "Redundancy Degraded from Non-redundant", "D0 Power State", "D1 Power State", "D2 Power State", "D3 Power State", "OEM"},
+	"LogEntryV170LogEntryType":                              {"Event", "SEL", "Oem"},
+	"LogEntryV170SensorType":                                {"Platform Security Violation Attempt", "Temperature", "Voltage", "Current", "Fan", "Physical Chassis Security", "Processor", "Power Supply / Converter", "PowerUnit", "CoolingDevice", "Other Units-based Sensor", "Memory", "Drive Slot/Bay", "POST Memory Resize", "System Firmware Progress", "Event Logging Disabled", "System Event", "Critical Interrupt", "Button/Switch", "Module/Board", "Microcontroller/Coprocessor", "Add-in Card", "Chassis", "ChipSet", "Other FRU", "Cable/Interconnect", "Terminator", "SystemBoot/Restart", "Boot Error", "BaseOSBoot/InstallationStatus", "OS Stop/Shutdown", "Slot/Connector", "System ACPI PowerState", "Watchdog", "Platform Alert", "Entity Presence", "Monitor ASIC/IC", "LAN", "Management Subsystem Health", "Battery", "Session Audit", "Version Change", "FRUState", "OEM"},
+	"LogServiceV120LogDiagnosticDataTypes":                  {"Manager", "PreOS", "OS", "OEM"},
+	"LogServiceV120LogEntryTypes":                           {"Event", "SEL", "Multiple", "OEM"},
+	"LogServiceV120OverWritePolicy":                         {"Unknown", "WrapsWhenFull", "NeverOverWrites"},
+	"LogServiceV120SyslogFacility":                          {"Kern", "User", "Mail", "Daemon", "Auth", "Syslog", "LPR", "News", "UUCP", "Cron", "Authpriv", "FTP", "NTP", "Security", "Console", "SolarisCron", "Local0", "Local1", "Local2", "Local3", "Local4", "Local5", "Local6", "Local7"},
+	"LogServiceV120SyslogSeverity":                          {"Emergency", "Alert", "Critical", "Error", "Warning", "Notice", "Informational", "Debug", "All"},
+	"ManagerAccountV162AccountTypes":                        {"Redfish", "SNMP", "OEM"},
+	"ManagerAccountV162SNMPAuthenticationProtocols":         {"None", "HMAC_MD5", "HMAC_SHA96"},
+	"ManagerAccountV162SNMPEncryptionProtocols":             {"None", "CBC_DES", "CFB128_AES128"},
+	"ManagerNetworkProtocolV161NotifyIPv6Scope":             {"Link", "Site", "Organization"},
+	"ManagerNetworkProtocolV161SNMPAuthenticationProtocols": {"Account", "CommunityString", "HMAC_MD5", "HMAC_SHA96"},
+	"ManagerNetworkProtocolV161SNMPCommunityAccessMode":     {"Full", "Limited"},
+	"ManagerNetworkProtocolV161SNMPEncryptionProtocols":     {"None", "Account", "CBC_DES", "CFB128_AES128"},
+	"ManagerV1100CommandConnectTypesSupported":              {"SSH", "Telnet", "IPMI", "Oem"},
+	"ManagerV1100GraphicalConnectTypesSupported":            {"KVMIP", "Oem"},
+	"ManagerV1100ManagerType":                               {"ManagementController", "EnclosureManager", "BMC", "RackManager", "AuxiliaryController", "Service"},
+	"ManagerV1100ResetToDefaultsType":                       {"ResetAll", "PreserveNetworkAndUsers", "PreserveNetwork"},
+	"ManagerV1100SerialConnectTypesSupported":               {"SSH", "Telnet", "IPMI", "Oem"},
+	"MediaControllerV110MediaControllerType":                {"Memory"},
+	"MemoryChunksV140AddressRangeType":                      {"Volatile", "PMEM", "Block"},
+	"MemoryV1100BaseModuleType":                             {"RDIMM", "UDIMM", "SO_DIMM", "LRDIMM", "Mini_RDIMM", "Mini_UDIMM", "SO_RDIMM_72b", "SO_UDIMM_72b", "SO_DIMM_16b", "SO_DIMM_32b", "Die"},
+	"MemoryV1100ErrorCorrection":                            {"NoECC", "SingleBitECC", "MultiBitECC", "AddressParity"},
+	"MemoryV1100MemoryClassification":                       {"Volatile", "ByteAccessiblePersistent", "Block"},
+	"MemoryV1100MemoryDeviceType":                           {"DDR", "DDR2", "DDR3", "DDR4", "DDR4_SDRAM", "DDR4E_SDRAM", "LPDDR4_SDRAM", "DDR3_SDRAM", "LPDDR3_SDRAM", "DDR2_SDRAM", "DDR2_SDRAM_FB_DIMM", "DDR2_SDRAM_FB_DIMM_PROBE", "DDR_SGRAM", "DDR_SDRAM", "ROM", "SDRAM", "EDO", "FastPageMode", "PipelinedNibble", "Logical", "HBM", "HBM2"},
+	"MemoryV1100MemoryMedia":                                {"DRAM", "NAND", "Intel3DXPoint", "Proprietary"},
+	"MemoryV1100MemoryType":                                 {"DRAM", "NVDIMM_N", "NVDIMM_F", "NVDIMM_P", "IntelOptane"},
+	"MemoryV1100OperatingMemoryModes":                       {"Volatile", "PMEM", "Block"},
+	"MemoryV1100SecurityStates":                             {"Enabled", "Disabled", "Unlocked", "Locked", "Frozen", "Passphraselimit"},
+	"MessageRegistryV142ParamType":                          {"string", "number"},
+	"MetricDefinitionV110Calculable":                        {"NonCalculatable", "Summable", "NonSummable"},
+	"MetricDefinitionV110CalculationAlgorithmEnum":          {"Average", "Maximum", "Minimum", "OEM"},
+	"MetricDefinitionV110ImplementationType":                {"PhysicalSensor", "Calculated", "Synthesized", "DigitalMeter"},
+	"MetricDefinitionV110MetricDataType":                    {"Boolean", "DateTime", "Decimal", "Integer", "String", "Enumeration"},
+	"MetricDefinitionV110MetricType":                        {"Numeric", "Discrete", "Gauge", "Counter", "Countdown"},
+	"MetricReportDefinitionV133CalculationAlgorithmEnum":    {"Average", "Maximum", "Minimum", "Summation"},
+	"MetricReportDefinitionV133CollectionTimeScope":         {"Point", "Interval", "StartupInterval"},
+	"MetricReportDefinitionV133MetricReportDefinitionType":  {"Periodic", "OnChange", "OnRequest"},
+	"MetricReportDefinitionV133ReportActionsEnum":           {"LogToMetricReportsCollection", "RedfishEvent"},
+	"MetricReportDefinitionV133ReportUpdatesEnum":           {"Overwrite", "AppendWrapsWhenFull", "AppendStopsWhenFull", "NewReport"},
+	"NVMeDomainNVMeDeviceType":                              {"Drive", "JBOF", "FabricAttachArray"},
+	"NetworkDeviceFunctionV150AuthenticationMethod":         {"None", "CHAP", "MutualCHAP"},
+	"NetworkDeviceFunctionV150BootMode":                     {"Disabled", "PXE", "iSCSI", "FibreChannel", "FibreChannelOverEthernet"},
+	"NetworkDeviceFunctionV150IPAddressType":                {"IPv4", "IPv6"},
+	"NetworkDeviceFunctionV150NetworkDeviceTechnology":      {"Disabled", "Ethernet", "FibreChannel", "iSCSI", "FibreChannelOverEthernet", "InfiniBand"},
+	"NetworkDeviceFunctionV150WWNSource":                    {"ConfiguredLocally", "ProvidedByFabric"},
+	"NetworkPortV130FlowControl":                            {"None", "TX", "RX", "TX_RX"},
+	"NetworkPortV130LinkNetworkTechnology":                  {"Ethernet", "InfiniBand", "FibreChannel"},
+	"NetworkPortV130LinkStatus":                             {"Down", "Up", "Starting", "Training"},
+	"NetworkPortV130PortConnectionType":                     {"NotConnected", "NPort", "PointToPoint", "PrivateLoop", "PublicLoop", "Generic", "ExtenderFabric"},
+	"NetworkPortV130SupportedEthernetCapabilities":          {"WakeOnLAN", "EEE"},
+	"OutletGroupPowerState":                                 {"On", "Off"},
+	"OutletPowerState":                                      {"On", "Off"},
+	"OutletReceptacleType":                                  {"NEMA_5_15R", "NEMA_5_20R", "NEMA_L5_20R", "NEMA_L5_30R", "NEMA_L6_20R", "NEMA_L6_30R", "IEC_60320_C13", "IEC_60320_C19", "CEE_7_Type_E", "CEE_7_Type_F", "SEV_1011_TYPE_12", "SEV_1011_TYPE_23", "BS_1363_Type_G"},
+	"OutletV110VoltageType":                                 {"AC", "DC"},
+	"PCIeDevicePCIeTypes":                                   {"Gen1", "Gen2", "Gen3", "Gen4", "Gen5"},
+	"PCIeDeviceV150DeviceType":                              {"SingleFunction", "MultiFunction", "Simulated"},
+	"PCIeFunctionV123DeviceClass":                           {"UnclassifiedDevice", "MassStorageController", "NetworkController", "DisplayController", "MultimediaController", "MemoryController", "Bridge", "CommunicationController", "GenericSystemPeripheral", "InputDeviceController", "DockingStation", "Processor", "SerialBusController", "WirelessController", "IntelligentController", "SatelliteCommunicationsController", "EncryptionController", "SignalProcessingController", "ProcessingAccelerators", "NonEssentialInstrumentation", "Coprocessor", "UnassignedClass", "Other"},
+	"PCIeFunctionV123FunctionType":                          {"Physical", "Virtual"},
+	"PCIeSlotsV140SlotTypes":                                {"FullLength", "HalfLength", "LowProfile", "Mini", "M2", "OEM", "OCP3Small", "OCP3Large", "U2"},
+	"PhysicalContextPhysicalContext":                        {"Room", "Intake", "Exhaust", "LiquidInlet", "LiquidOutlet", "Front", "Back", "Upper", "Lower", "CPU", "CPUSubsystem", "GPU", "GPUSubsystem", "FPGA", "Accelerator", "ASIC", "Backplane", "SystemBoard", "PowerSupply", "PowerSubsystem", "VoltageRegulator", "Rectifier", "StorageDevice", "NetworkingDevice", "ComputeBay", "StorageBay", "NetworkBay", "ExpansionBay", "PowerSupplyBay", "Memory", "MemorySubsystem", "Chassis", "Fan", "CoolingSubsystem", "Motor", "Transformer", "ACUtilityInput", "ACStaticBypassInput", "ACMaintenanceBypassInput", "DCBus", "ACOutput", "ACInput"},
+	"PhysicalContextPhysicalSubContext":                     {"Input", "Output"},
+	"PortV130FlowControl":                                   {"None", "TX", "RX", "TX_RX"},
+	"PortV130LinkNetworkTechnology":                         {"Ethernet", "InfiniBand", "FibreChannel", "GenZ"},
+	"PortV130LinkState":                                     {"Enabled", "Disabled"},
+	"PortV130LinkStatus":                                    {"LinkUp", "Starting", "Training", "LinkDown", "NoLink"},
+	"PortV130PortConnectionType":                            {"NotConnected", "NPort", "PointToPoint", "PrivateLoop", "PublicLoop", "Generic", "ExtenderFabric"},
+	"PortV130PortMedium":                                    {"Electrical", "Optical"},
+	"PortV130PortType":                                      {"UpstreamPort", "DownstreamPort", "InterswitchPort", "ManagementPort", "BidirectionalPort", "UnconfiguredPort"},
+	"PortV130SupportedEthernetCapabilities":                 {"WakeOnLAN", "EEE"},
+	"PowerDistributionV101PowerEquipmentType":               {"RackPDU", "FloorPDU", "ManualTransferSwitch", "AutomaticTransferSwitch", "Switchgear"},
+	"PowerDistributionV101TransferSensitivityType":          {"High", "Medium", "Low"},
+	"PowerV161InputType":                                    {"AC", "DC"},
+	"PowerV161LineInputVoltageType":                         {"Unknown", "ACLowLine", "ACMidLine", "ACHighLine", "DCNeg48V", "DC380V", "AC120V", "AC240V", "AC277V", "ACandDCWideRange", "ACWideRange", "DC240V"},
+	"PowerV161PowerLimitException":                          {"NoAction", "HardPowerOff", "LogEventOnly", "Oem"},
+	"PowerV161PowerSupplyType":                              {"Unknown", "AC", "DC", "ACorDC"},
+	"PrivilegesPrivilegeType":                               {"Login", "ConfigureManager", "ConfigureUsers", "ConfigureSelf", "ConfigureComponents", "NoAuth"},
+	"ProcessorV1100BaseSpeedPriorityState":                  {"Enabled", "Disabled"},
+	"ProcessorV1100FpgaType":                                {"Integrated", "Discrete"},
+	"ProcessorV1100InstructionSet":                          {"x86", "x86-64", "IA-64", "ARM-A32", "ARM-A64", "MIPS32", "MIPS64", "PowerISA", "OEM"},
+	"ProcessorV1100ProcessorArchitecture":                   {"x86", "IA-64", "ARM", "MIPS", "Power", "OEM"},
+	"ProcessorV1100ProcessorMemoryType":                     {"L1Cache", "L2Cache", "L3Cache", "L4Cache", "L5Cache", "L6Cache", "L7Cache", "HBM1", "HBM2", "HBM3", "SGRAM", "GDDR", "GDDR2", "GDDR3", "GDDR4", "GDDR5", "GDDR5X", "GDDR6", "DDR", "DDR2", "DDR3", "DDR4", "DDR5", "SDRAM", "SRAM", "Flash", "OEM"},
+	"ProcessorV1100ProcessorType":                           {"CPU", "GPU", "FPGA", "DSP", "Accelerator", "Core", "Thread", "OEM"},
+	"ProcessorV1100SystemInterfaceType":                     {"QPI", "UPI", "PCIe", "Ethernet", "AMBA", "CCIX", "CXL", "OEM"},
+	"ProcessorV1100TurboState":                              {"Enabled", "Disabled"},
+	"ProtocolProtocol":                                      {"PCIe", "AHCI", "UHCI", "SAS", "SATA", "USB", "NVMe", "FC", "iSCSI", "FCoE", "FCP", "FICON", "NVMeOverFabrics", "SMB", "NFSv3", "NFSv4", "HTTP", "HTTPS", "FTP", "SFTP", "iWARP", "RoCE", "RoCEv2", "I2C", "TCP", "UDP", "TFTP", "GenZ", "MultiProtocol", "InfiniBand", "Ethernet", "OEM"},
+	"RedundancyV109RedundancyMode":                          {"Failover", "N+m", "Sharing", "Sparing"},
+	"RedundancyV117RedundancyMode":                          {"Failover", "N+m", "Sharing", "Sparing"},
+	"RedundancyV125RedundancyMode":                          {"Failover", "N+m", "Sharing", "Sparing"},
+	"RedundancyV135RedundancyMode":                          {"Failover", "N+m", "Sharing", "Sparing", "NotRedundant"},
+	"ResourceBlockV133CompositionState":                     {"Composing", "ComposedAndAvailable", "Composed", "Unused", "Failed", "Unavailable"},
+	"ResourceBlockV133ResourceBlockType":                    {"Compute", "Processor", "Memory", "Network", "Storage", "ComputerSystem", "Expansion"},
+	"ResourceHealth":                                        {"OK", "Warning", "Critical"},
+	"ResourceIndicatorLED":                                  {"Lit", "Blinking", "Off"},
+	"ResourcePowerState":                                    {"On", "Off", "PoweringOn", "PoweringOff"},
+	"ResourceResetType":                                     {"On", "ForceOff", "GracefulShutdown", "GracefulRestart", "ForceRestart", "Nmi", "ForceOn", "PushPowerButton", "PowerCycle"},
+	"ResourceState":                                         {"Enabled", "Disabled", "StandbyOffline", "StandbySpare", "InTest", "Starting", "Absent", "UnavailableOffline", "Deferring", "Quiesced", "Updating", "Qualified"},
+	"ResourceV1100DurableNameFormat":                        {"NAA", "iQN", "FC_WWN", "UUID", "EUI", "NQN", "NSID", "NGUID"},
+	"ResourceV1100LocationType":                             {"Slot", "Bay", "Connector", "Socket"},
+	"ResourceV1100Orientation":                              {"FrontToBack", "BackToFront", "TopToBottom", "BottomToTop", "LeftToRight", "RightToLeft"},
+	"ResourceV1100RackUnits":                                {"OpenU", "EIA_310"},
+	"ResourceV1100Reference":                                {"Top", "Bottom", "Front", "Rear", "Left", "Right", "Middle"},
+	"ResourceV1111DurableNameFormat":                        {"NAA", "iQN", "FC_WWN", "UUID", "EUI"},
+	"ResourceV1210DurableNameFormat":                        {"NAA", "iQN", "FC_WWN", "UUID", "EUI"},
+	"ResourceV130RackUnits":                                 {"OpenU", "EIA_310"},
+	"ResourceV139DurableNameFormat":                         {"NAA", "iQN", "FC_WWN", "UUID", "EUI"},
+	"ResourceV139RackUnits":                                 {"OpenU", "EIA_310"},
+	"ResourceV148DurableNameFormat":                         {"NAA", "iQN", "FC_WWN", "UUID", "EUI"},
+	"ResourceV148RackUnits":                                 {"OpenU", "EIA_310"},
+	"ResourceV157DurableNameFormat":                         {"NAA", "iQN", "FC_WWN", "UUID", "EUI"},
+	"ResourceV157LocationType":                              {"Slot", "Bay", "Connector", "Socket"},
+	"ResourceV157Orientation":                               {"FrontToBack", "BackToFront", "TopToBottom", "BottomToTop", "LeftToRight", "RightToLeft"},
+	"ResourceV157RackUnits":                                 {"OpenU", "EIA_310"},
+	"ResourceV157Reference":                                 {"Top", "Bottom", "Front", "Rear", "Left", "Right", "Middle"},
+	"ResourceV166DurableNameFormat":                         {"NAA", "iQN", "FC_WWN", "UUID", "EUI", "NQN", "NSID"},
+	"ResourceV166LocationType":                              {"Slot", "Bay", "Connector", "Socket"},
+	"ResourceV166Orientation":                               {"FrontToBack", "BackToFront", "TopToBottom", "BottomToTop", "LeftToRight", "RightToLeft"},
+	"ResourceV166RackUnits":                                 {"OpenU", "EIA_310"},
+	"ResourceV166Reference":                                 {"Top", "Bottom", "Front", "Rear", "Left", "Right", "Middle"},
+	"ResourceV175DurableNameFormat":                         {"NAA", "iQN", "FC_WWN", "UUID", "EUI", "NQN", "NSID"},
+	"ResourceV175LocationType":                              {"Slot", "Bay", "Connector", "Socket"},
+	"ResourceV175Orientation":                               {"FrontToBack", "BackToFront", "TopToBottom", "BottomToTop", "LeftToRight", "RightToLeft"},
+	"ResourceV175RackUnits":                                 {"OpenU", "EIA_310"},
+	"ResourceV175Reference":                                 {"Top", "Bottom", "Front", "Rear", "Left", "Right", "Middle"},
+	"ResourceV185DurableNameFormat":                         {"NAA", "iQN", "FC_WWN", "UUID", "EUI", "NQN", "NSID"},
+	"ResourceV185LocationType":                              {"Slot", "Bay", "Connector", "Socket"},
+	"ResourceV185Orientation":                               {"FrontToBack", "BackToFront", "TopToBottom", "BottomToTop", "LeftToRight", "RightToLeft"},
+	"ResourceV185RackUnits":                                 {"OpenU", "EIA_310"},
+	"ResourceV185Reference":                                 {"Top", "Bottom", "Front", "Rear", "Left", "Right", "Middle"},
+	"ResourceV193DurableNameFormat":                         {"NAA", "iQN", "FC_WWN", "UUID", "EUI", "NQN", "NSID"},
+	"ResourceV193LocationType":                              {"Slot", "Bay", "Connector", "Socket"},
+	"ResourceV193Orientation":                               {"FrontToBack", "BackToFront", "TopToBottom", "BottomToTop", "LeftToRight", "RightToLeft"},
+	"ResourceV193RackUnits":                                 {"OpenU", "EIA_310"},
+	"ResourceV193Reference":                                 {"Top", "Bottom", "Front", "Rear", "Left", "Right", "Middle"},
+	"ScheduleV101DayOfWeek":                                 {"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday", "Every"},
+	"ScheduleV101MonthOfYear":                               {"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December", "Every"},
+	"ScheduleV111DayOfWeek":                                 {"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday", "Every"},
+	"ScheduleV111MonthOfYear":                               {"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December", "Every"},
+	"ScheduleV121DayOfWeek":                                 {"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday", "Every"},
+	"ScheduleV121MonthOfYear":                               {"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December", "Every"},
+	"SecureBootDatabaseV100ResetKeysType":                   {"ResetAllKeysToDefault", "DeleteAllKeys"},
+	"SecureBootV110ResetKeysType":                           {"ResetAllKeysToDefault", "DeleteAllKeys", "DeletePK"},
+	"SecureBootV110SecureBootCurrentBootType":               {"Enabled", "Disabled"},
+	"SecureBootV110SecureBootModeType":                      {"SetupMode", "UserMode", "AuditMode", "DeployedMode"},
+	"SensorElectricalContext":                               {"Line1", "Line2", "Line3", "Neutral", "LineToLine", "Line1ToLine2", "Line2ToLine3", "Line3ToLine1", "LineToNeutral", "Line1ToNeutral", "Line2ToNeutral", "Line3ToNeutral", "Line1ToNeutralAndL1L2", "Line2ToNeutralAndL1L2", "Line2ToNeutralAndL2L3", "Line3ToNeutralAndL3L1", "Total"},
+	"SensorV111ImplementationType":                          {"PhysicalSensor", "Synthesized", "Reported"},
+	"SensorV111ReadingType":                                 {"Temperature", "Humidity", "Power", "EnergykWh", "EnergyJoules", "Voltage", "Current", "Frequency", "Pressure", "LiquidLevel", "Rotational", "AirFlow", "LiquidFlow", "Barometric", "Altitude", "Percent"},
+	"SensorV111ThresholdActivation":                         {"Increasing", "Decreasing", "Either"},
+	"SensorVoltageType":                                     {"AC", "DC"},
+	"SerialInterfaceV117BitRate":                            {"1200", "2400", "4800", "9600", "19200", "38400", "57600", "115200", "230400"},
+	"SerialInterfaceV117ConnectorType":                      {"RJ45", "RJ11", "DB9 Female", "DB9 Male", "DB25 Female", "DB25 Male", "USB", "mUSB", "uUSB"},
+	"SerialInterfaceV117DataBits":                           {"5", "6", "7", "8"},
+	"SerialInterfaceV117FlowControl":                        {"None", "Software", "Hardware"},
+	"SerialInterfaceV117Parity":                             {"None", "Even", "Odd", "Mark", "Space"},
+	"SerialInterfaceV117PinOut":                             {"Cisco", "Cyclades", "Digi"},
+	"SerialInterfaceV117SignalType":                         {"Rs232", "Rs485"},
+	"SerialInterfaceV117StopBits":                           {"1", "2"},
+	"SessionV130SessionTypes":                               {"HostConsole", "ManagerConsole", "IPMI", "KVMIP", "OEM", "Redfish", "VirtualMedia", "WebUI"},
+	"SignatureSignatureTypeRegistry":                        {"UEFI"},
+	"StorageControllerV100ANAAccessState":                   {"Optimized", "NonOptimized", "Inacessible", "PersistentLoss"},
+	"StorageControllerV100NVMeControllerType":               {"Admin", "Discovery", "IO"},
+	"StorageGroupAccessCapability":                          {"Read", "ReadWrite"},
+	"StorageGroupV150AuthenticationMethod":                  {"None", "CHAP", "MutualCHAP", "DHCHAP"},
+	"StorageReplicaInfoReplicaFaultDomain":                  {"Local", "Remote"},
+	"StorageReplicaInfoReplicaType":                         {"Mirror", "Snapshot", "Clone", "TokenizedClone"},
+	"StorageReplicaInfoReplicaUpdateMode":                   {"Active", "Synchronous", "Asynchronous", "Adaptive"},
+	"StorageReplicaInfoV102ConsistencyState":                {"Consistent", "Inconsistent"},
+	"StorageReplicaInfoV102ConsistencyStatus":               {"Consistent", "InProgress", "Disabled", "InError"},
+	"StorageReplicaInfoV102ConsistencyType":                 {"SequentiallyConsistent"},
+	"StorageReplicaInfoV102ReplicaPriority":                 {"Low", "Same", "High", "Urgent"},
+	"StorageReplicaInfoV102ReplicaProgressStatus":           {"Completed", "Dormant", "Initializing", "Preparing", "Synchronizing", "Resyncing", "Restoring", "Fracturing", "Splitting", "FailingOver", "FailingBack", "Detaching", "Aborting", "Mixed", "Suspending", "RequiresFracture", "RequiresResync", "RequiresActivate", "Pending", "RequiresDetach", "Terminating", "RequiresSplit", "RequiresResume"},
+	"StorageReplicaInfoV102ReplicaReadOnlyAccess":           {"SourceElement", "ReplicaElement", "Both"},
+	"StorageReplicaInfoV102ReplicaRecoveryMode":             {"Automatic", "Manual"},
+	"StorageReplicaInfoV102ReplicaRole":                     {"Source", "Target"},
+	"StorageReplicaInfoV102ReplicaState":                    {"Initialized", "Unsynchronized", "Synchronized", "Broken", "Fractured", "Split", "Inactive", "Suspended", "Failedover", "Prepared", "Aborted", "Skewed", "Mixed", "Partitioned", "Invalid", "Restored"},
+	"StorageReplicaInfoV102UndiscoveredElement":             {"SourceElement", "ReplicaElement"},
+	"StorageReplicaInfoV112ConsistencyState":                {"Consistent", "Inconsistent"},
+	"StorageReplicaInfoV112ConsistencyStatus":               {"Consistent", "InProgress", "Disabled", "InError"},
+	"StorageReplicaInfoV112ConsistencyType":                 {"SequentiallyConsistent"},
+	"StorageReplicaInfoV112ReplicaPriority":                 {"Low", "Same", "High", "Urgent"},
+	"StorageReplicaInfoV112ReplicaProgressStatus":           {"Completed", "Dormant", "Initializing", "Preparing", "Synchronizing", "Resyncing", "Restoring", "Fracturing", "Splitting", "FailingOver", "FailingBack", "Detaching", "Aborting", "Mixed", "Suspending", "RequiresFracture", "RequiresResync", "RequiresActivate", "Pending", "RequiresDetach", "Terminating", "RequiresSplit", "RequiresResume"},
+	"StorageReplicaInfoV112ReplicaReadOnlyAccess":           {"SourceElement", "ReplicaElement", "Both"},
+	"StorageReplicaInfoV112ReplicaRecoveryMode":             {"Automatic", "Manual"},
+	"StorageReplicaInfoV112ReplicaRole":                     {"Source", "Target"},
+	"StorageReplicaInfoV112ReplicaState":                    {"Initialized", "Unsynchronized", "Synchronized", "Broken", "Fractured", "Split", "Inactive", "Suspended", "Failedover", "Prepared", "Aborted", "Skewed", "Mixed", "Partitioned", "Invalid", "Restored"},
+	"StorageReplicaInfoV112UndiscoveredElement":             {"SourceElement", "ReplicaElement"},
+	"StorageReplicaInfoV120ConsistencyState":                {"Consistent", "Inconsistent"},
+	"StorageReplicaInfoV120ConsistencyStatus":               {"Consistent", "InProgress", "Disabled", "InError"},
+	"StorageReplicaInfoV120ConsistencyType":                 {"SequentiallyConsistent"},
+	"StorageReplicaInfoV120ReplicaPriority":                 {"Low", "Same", "High", "Urgent"},
+	"StorageReplicaInfoV120ReplicaProgressStatus":           {"Completed", "Dormant", "Initializing", "Preparing", "Synchronizing", "Resyncing", "Restoring", "Fracturing", "Splitting", "FailingOver", "FailingBack", "Detaching", "Aborting", "Mixed", "Suspending", "RequiresFracture", "RequiresResync", "RequiresActivate", "Pending", "RequiresDetach", "Terminating", "RequiresSplit", "RequiresResume"},
+	"StorageReplicaInfoV120ReplicaReadOnlyAccess":           {"SourceElement", "ReplicaElement", "Both"},
+	"StorageReplicaInfoV120ReplicaRecoveryMode":             {"Automatic", "Manual"},
+	"StorageReplicaInfoV120ReplicaRole":                     {"Source", "Target"},
+	"StorageReplicaInfoV120ReplicaState":                    {"Initialized", "Unsynchronized", "Synchronized", "Broken", "Fractured", "Split", "Inactive", "Suspended", "Failedover", "Prepared", "Aborted", "Skewed", "Mixed", "Partitioned", "Invalid", "Restored"},
+	"StorageReplicaInfoV120UndiscoveredElement":             {"SourceElement", "ReplicaElement"},
+	"StorageReplicaInfoV130ConsistencyState":                {"Consistent", "Inconsistent"},
+	"StorageReplicaInfoV130ConsistencyStatus":               {"Consistent", "InProgress", "Disabled", "InError"},
+	"StorageReplicaInfoV130ConsistencyType":                 {"SequentiallyConsistent"},
+	"StorageReplicaInfoV130ReplicaPriority":                 {"Low", "Same", "High", "Urgent"},
+	"StorageReplicaInfoV130ReplicaProgressStatus":           {"Completed", "Dormant", "Initializing", "Preparing", "Synchronizing", "Resyncing", "Restoring", "Fracturing", "Splitting", "FailingOver", "FailingBack", "Detaching", "Aborting", "Mixed", "Suspending", "RequiresFracture", "RequiresResync", "RequiresActivate", "Pending", "RequiresDetach", "Terminating", "RequiresSplit", "RequiresResume"},
+	"StorageReplicaInfoV130ReplicaReadOnlyAccess":           {"SourceElement", "ReplicaElement", "Both"},
+	"StorageReplicaInfoV130ReplicaRecoveryMode":             {"Automatic", "Manual"},
+	"StorageReplicaInfoV130ReplicaRole":                     {"Source", "Target"},
+	"StorageReplicaInfoV130ReplicaState":                    {"Initialized", "Unsynchronized", "Synchronized", "Broken", "Fractured", "Split", "Inactive", "Suspended", "Failedover", "Prepared", "Aborted", "Skewed", "Mixed", "Partitioned", "Invalid", "Restored"},
+	"StorageReplicaInfoV130UndiscoveredElement":             {"SourceElement", "ReplicaElement"},
+	"TaskServiceV115OverWritePolicy":                        {"Manual", "Oldest"},
+	"TaskV150TaskState":                                     {"New", "Starting", "Running", "Suspended", "Interrupted", "Pending", "Stopping", "Completed", "Killed", "Exception", "Service", "Cancelling", "Cancelled"},
+	"TelemetryServiceV121CollectionFunction":                {"Average", "Maximum", "Minimum", "Summation"},
+	"ThermalV162ReadingUnits":                               {"RPM", "Percent"},
+	"TriggersV112DiscreteTriggerConditionEnum":              {"Specified", "Changed"},
+	"TriggersV112MetricTypeEnum":                            {"Numeric", "Discrete"},
+	"TriggersV112ThresholdActivation":                       {"Increasing", "Decreasing", "Either"},
+	"TriggersV112TriggerActionEnum":                         {"LogToLogService", "RedfishEvent", "RedfishMetricReport"},
+	"UpdateServiceV182ApplyTime":                            {"Immediate", "OnReset", "AtMaintenanceWindowStart", "InMaintenanceWindowOnReset"},
+	"UpdateServiceV182TransferProtocolType":                 {"CIFS", "FTP", "SFTP", "HTTP", "HTTPS", "NSF", "SCP", "TFTP", "OEM", "NFS"},
+	"VirtualMediaV132ConnectedVia":                          {"NotConnected", "URI", "Applet", "Oem"},
+	"VirtualMediaV132MediaType":                             {"CD", "Floppy", "USBStick", "DVD"},
+	"VirtualMediaV132TransferMethod":                        {"Stream", "Upload"},
+	"VirtualMediaV132TransferProtocolType":                  {"CIFS", "FTP", "SFTP", "HTTP", "HTTPS", "NFS", "SCP", "TFTP", "OEM"},
+	"VolumeEncryptionTypes":                                 {"NativeDriveEncryption", "ControllerAssisted", "SoftwareAssisted"},
+	"VolumeInitializeMethod":                                {"Skip", "Background", "Foreground"},
+	"VolumeInitializeType":                                  {"Fast", "Slow"},
+	"VolumeRAIDType":                                        {"RAID0", "RAID1", "RAID3", "RAID4", "RAID5", "RAID6", "RAID10", "RAID01", "RAID6TP", "RAID1E", "RAID50", "RAID60", "RAID00", "RAID10E", "RAID1Triple", "RAID10Triple", "None"},
+	"VolumeReadCachePolicyType":                             {"ReadAhead", "AdaptiveReadAhead", "Off"},
+	"VolumeVolumeType":                                      {"RawDevice", "NonRedundant", "Mirrored", "StripedWithParity", "SpannedMirrors", "SpannedStripesWithParity"},
+	"VolumeVolumeUsageType":                                 {"Data", "SystemData", "CacheOnly", "SystemReserve", "ReplicationReserve"},
+	"VolumeWriteCachePolicyType":                            {"WriteThrough", "ProtectedWriteBack", "UnprotectedWriteBack", "Off"},
+	"VolumeWriteCacheStateType":                             {"Unprotected", "Protected", "Degraded"},
+	"VolumeWriteHoleProtectionPolicyType":                   {"Off", "Journaling", "DistributedLog", "Oem"},
+	"ZoneV150ExternalAccessibility":                         {"GloballyAccessible", "NonZonedAccessible", "ZoneOnly", "NoInternalRouting"},
+	"ZoneV150ZoneType":                                      {"Default", "ZoneOfEndpoints", "ZoneOfZones"},
+}
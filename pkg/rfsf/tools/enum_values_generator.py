@@ -0,0 +1,95 @@
+#!/usr/local/bin/python3
+#
+# Copyright 2026 Hewlett Packard Enterprise Development LP
+# Other additional copyright holders may be indicated within.
+#
+# The entirety of this work is licensed under the Apache License,
+# Version 2.0 (the "License"); you may not use this file except
+# in compliance with the License.
+#
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+#
+#
+# Script to generate the list of allowed values of every enumerated
+# string type found in the models directory, i.e.
+#
+#    type ResourceState string
+#
+#    // List of Resource_State
+#    const (
+#        ENABLED_RST ResourceState = "Enabled"
+#        ...
+#    )
+#
+# The list permits request bodies to be checked against the allowed
+# values at run time, which reflection alone cannot provide.
+#
+# This script should run after patch_generated_constants.py
+
+import argparse, os, re
+
+TYPE_RE = re.compile(r'^type (\w+) string$')
+CONST_RE = re.compile(r'^\s*\w+\s+(\w+)\s*=\s*"([^"]*)"')
+
+HEADER = '''/*
+ * Swordfish API
+ *
+ * This contains the definition of the Swordfish extensions to a Redfish service.
+ *
+ * Code generated by enum_values_generator.py. DO NOT EDIT.
+ */
+
+package openapi
+
+// EnumValues returns the allowed values of the named enumerated string type, or false if the
+// type is not enumerated
+func EnumValues(name string) ([]string, bool) {
+	values, ok := enumValues[name]
+	return values, ok
+}
+
+var enumValues = map[string][]string{
+'''
+
+def find_enums(path):
+    # Returns the enumerated string types declared in the file and their values
+    enums = {}
+    with open(path) as fp:
+        for ln in fp:
+            match = TYPE_RE.match(ln)
+            if match:
+                enums.setdefault(match.group(1), [])
+                continue
+
+            match = CONST_RE.match(ln)
+            if match and match.group(1) in enums:
+                enums[match.group(1)].append(match.group(2))
+
+    return {name: values for name, values in enums.items() if len(values) != 0}
+
+if __name__ == '__main__':
+    parser = argparse.ArgumentParser(description='Generate the allowed values of the enumerated string types in a given directory')
+    parser.add_argument('dir', help='directory of the generated models')
+    parser.add_argument('--file', default='enum_values.go', help='name of the generated file')
+
+    args = parser.parse_args()
+
+    enums = {}
+    for file in sorted(os.listdir(args.dir)):
+        if file.startswith('model_') and file.endswith('.go'):
+            enums.update(find_enums(os.path.join(args.dir, file)))
+
+    with open(os.path.join(args.dir, args.file), 'w') as fp:
+        fp.write(HEADER)
+        for name in sorted(enums):
+            values = ', '.join('"{0}"'.format(value) for value in enums[name])
+            fp.write('\t"{0}": {{{1}}},\n'.format(name, values))
+        fp.write('}\n')
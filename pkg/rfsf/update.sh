@@ -72,6 +72,10 @@ echo "Patching routermux openapi imports"
 echo "Patching generated Go constants"
 ./tools/patch_generated_constants.py ./pkg/models
 
+echo "Generating enumerated value lists"
+./tools/enum_values_generator.py ./pkg/models
+gofmt -w pkg/models/enum_values.go
+
 echo "Generating Storage Platform API endpoints"
 ./tools/storage_platform_generator.py sp_api.go api_default.go sp_api_default.go ./pkg/routermux
 gofmt -w pkg/routermux/sp_api_default.go
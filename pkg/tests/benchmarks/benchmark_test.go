@@ -20,6 +20,7 @@
 package benchmarks
 
 import (
+	"context"
 	"testing"
 
 	ec "github.com/NearNodeFlash/nnf-ec/pkg"
@@ -49,7 +50,7 @@ func BenchmarkStorage(b *testing.B) {
 			CapacityBytes: 1024 * 1024,
 		}
 
-		if err := ss.StorageServiceIdStoragePoolsPost(ss.Id(), sp); err != nil {
+		if err := ss.StorageServiceIdStoragePoolsPost(context.Background(), ss.Id(), sp); err != nil {
 			b.Fatalf("Failed to create storage pool %d Error: %+v", i, err)
 		}
 
@@ -58,7 +59,7 @@ func BenchmarkStorage(b *testing.B) {
 	}
 
 	for i, pool := range pools {
-		if err := ss.StorageServiceIdStoragePoolIdDelete(ss.Id(), pool.Id); err != nil {
+		if err := ss.StorageServiceIdStoragePoolIdDelete(context.Background(), ss.Id(), pool.Id); err != nil {
 			b.Fatalf("Failed to delete storage pool %d ID: %s Error: %v", i, pool.Id, err)
 		}
 
@@ -0,0 +1,232 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package benchmarks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	ec "github.com/NearNodeFlash/nnf-ec/pkg"
+	nnf "github.com/NearNodeFlash/nnf-ec/pkg/manager-nnf"
+	server "github.com/NearNodeFlash/nnf-ec/pkg/manager-server"
+
+	elementcontroller "github.com/NearNodeFlash/nnf-ec/pkg/ec"
+	openapi "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/common"
+	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
+)
+
+// TestConcurrentRequests drives parallel create, read and delete requests through the
+// http router of a mock element controller. Run with -race to verify the managers are
+// safe for concurrent use.
+func TestConcurrentRequests(t *testing.T) {
+	const (
+		port    = 8083
+		workers = 8
+		cycles  = 4
+	)
+
+	c := ec.NewController(ec.NewMockOptions(false))
+
+	opts := elementcontroller.NewDefaultOptions()
+	opts.Http = true
+	opts.Port = port
+	if err := c.Init(opts); err != nil {
+		t.Fatalf("Failed to initialize nnf controller: %v", err)
+	}
+	defer c.Close()
+
+	go c.Run()
+	time.Sleep(1 * time.Second)
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	ss := nnf.NewDefaultStorageService(false /* deleteUnknownVolumes */, false /* replaceMissingVolumes */)
+	base := fmt.Sprintf("http://localhost:%d/redfish/v1/StorageServices/%s", port, ss.Id())
+
+	do := func(method, url string, body interface{}, model interface{}, status ...int) error {
+		var buf bytes.Buffer
+		if body != nil {
+			if err := json.NewEncoder(&buf).Encode(body); err != nil {
+				return err
+			}
+		}
+
+		req, err := http.NewRequest(method, url, &buf)
+		if err != nil {
+			return err
+		}
+
+		rsp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer rsp.Body.Close()
+
+		for _, s := range status {
+			if rsp.StatusCode == s {
+				if model != nil {
+					return json.NewDecoder(rsp.Body).Decode(model)
+				}
+				return nil
+			}
+		}
+
+		return fmt.Errorf("unexpected status %d", rsp.StatusCode)
+	}
+
+	health := &elementcontroller.HealthResponse{}
+	if err := do(http.MethodGet, fmt.Sprintf("http://localhost:%d%s", port, elementcontroller.ReadinessPath), nil, health, http.StatusOK); err != nil {
+		t.Fatalf("Element controller not ready: %v %+v", err, health)
+	}
+
+	ep := &sf.EndpointV150Endpoint{}
+	if err := do(http.MethodGet, base+"/Endpoints/0", nil, ep, http.StatusOK); err != nil {
+		t.Fatalf("Failed to get rabbit endpoint: %v", err)
+	}
+
+	run := func(worker int) error {
+		for cycle := 0; cycle < cycles; cycle++ {
+			sp := &sf.StoragePoolV150StoragePool{}
+			if err := do(http.MethodPost, base+"/StoragePools", &sf.StoragePoolV150StoragePool{
+				CapacityBytes: 1024 * 1024,
+				Oem: openapi.MarshalOem(nnf.AllocationPolicyOem{
+					Policy:     nnf.SpareAllocationPolicyType,
+					Compliance: nnf.RelaxedAllocationComplianceType,
+				}),
+			}, sp, http.StatusOK, http.StatusCreated); err != nil {
+				return fmt.Errorf("worker %d: create storage pool: %w", worker, err)
+			}
+
+			sg := &sf.StorageGroupV150StorageGroup{}
+			if err := do(http.MethodPost, base+"/StorageGroups", &sf.StorageGroupV150StorageGroup{
+				Links: sf.StorageGroupV150Links{
+					StoragePool:    sf.OdataV4IdRef{OdataId: sp.OdataId},
+					ServerEndpoint: sf.OdataV4IdRef{OdataId: ep.OdataId},
+				},
+			}, sg, http.StatusOK, http.StatusCreated); err != nil {
+				return fmt.Errorf("worker %d: create storage group: %w", worker, err)
+			}
+
+			fs := &sf.FileSystemV122FileSystem{}
+			if err := do(http.MethodPost, base+"/FileSystems", &sf.FileSystemV122FileSystem{
+				Links: sf.FileSystemV122Links{
+					StoragePool: sf.OdataV4IdRef{OdataId: sp.OdataId},
+				},
+				Oem: openapi.MarshalOem(server.FileSystemOem{
+					Type: "zfs",
+					Name: fmt.Sprintf("zfs-%d-%d", worker, cycle),
+				}),
+			}, fs, http.StatusOK, http.StatusCreated); err != nil {
+				return fmt.Errorf("worker %d: create file system: %w", worker, err)
+			}
+
+			for _, url := range []string{
+				base + "/StoragePools",
+				base + "/StoragePools/" + sp.Id,
+				base + "/StorageGroups/" + sg.Id,
+				base + "/FileSystems/" + fs.Id,
+				base + "/Endpoints",
+				base + "/CapacitySource",
+			} {
+				if err := do(http.MethodGet, url, nil, nil, http.StatusOK); err != nil {
+					return fmt.Errorf("worker %d: get %s: %w", worker, url, err)
+				}
+			}
+
+			for _, url := range []string{
+				base + "/FileSystems/" + fs.Id,
+				base + "/StorageGroups/" + sg.Id,
+				base + "/StoragePools/" + sp.Id,
+			} {
+				if err := do(http.MethodDelete, url, nil, nil, http.StatusOK, http.StatusNoContent); err != nil {
+					return fmt.Errorf("worker %d: delete %s: %w", worker, url, err)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	// Concurrent readers of the other managers exercise the NVMe, fabric, event and
+	// telemetry state, and the metrics and readiness drawn from all managers, alongside
+	// the storage service mutations. The expanded collections read every storage pool,
+	// group and file system while the workers operate on them.
+	done := make(chan struct{})
+	readers := sync.WaitGroup{}
+	for _, url := range []string{
+		"/redfish/v1/StorageServices/" + ss.Id() + "/StoragePools?$expand=.",
+		"/redfish/v1/StorageServices/" + ss.Id() + "/StorageGroups?$expand=.",
+		"/redfish/v1/StorageServices/" + ss.Id() + "/FileSystems?$expand=.",
+		"/redfish/v1/Storage/0/Volumes",
+		"/redfish/v1/Fabrics/Rabbit/Switches/0/Ports",
+		"/redfish/v1/Fabrics/Rabbit/Endpoints/1",
+		"/redfish/v1/EventService/Events",
+		"/redfish/v1/TelemetryService/MetricReports",
+		elementcontroller.MetricsPath,
+		elementcontroller.ReadinessPath,
+	} {
+		readers.Add(1)
+		go func(url string) {
+			defer readers.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					do(http.MethodGet, fmt.Sprintf("http://localhost:%d%s", port, url), nil, nil)
+				}
+			}
+		}(url)
+	}
+
+	errs := make(chan error, workers)
+	wg := sync.WaitGroup{}
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			errs <- run(worker)
+		}(worker)
+	}
+
+	wg.Wait()
+	close(done)
+	readers.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	pools := &sf.StoragePoolCollectionStoragePoolCollection{}
+	if err := do(http.MethodGet, base+"/StoragePools", nil, pools, http.StatusOK); err != nil {
+		t.Fatalf("Failed to list storage pools: %v", err)
+	}
+
+	if pools.MembersodataCount != 0 {
+		t.Errorf("Expected no storage pools to remain, found %d", pools.MembersodataCount)
+	}
+}
@@ -20,9 +20,14 @@
 package filesystem
 
 import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
 	"testing"
 
 	ec "github.com/NearNodeFlash/nnf-ec/pkg"
+	elementcontroller "github.com/NearNodeFlash/nnf-ec/pkg/ec"
 	nnf "github.com/NearNodeFlash/nnf-ec/pkg/manager-nnf"
 	server "github.com/NearNodeFlash/nnf-ec/pkg/manager-server"
 
@@ -53,13 +58,13 @@ func TestFileSystem(t *testing.T) {
 		}),
 	}
 
-	if err := ss.StorageServiceIdStoragePoolsPost(ss.Id(), sp); err != nil {
+	if err := ss.StorageServiceIdStoragePoolsPost(context.Background(), ss.Id(), sp); err != nil {
 		t.Fatalf("Failed to create storage pool Error: %+v", err)
 	}
 
 	rabbitEndpointId := "0"
 	ep := &sf.EndpointV150Endpoint{}
-	if err := ss.StorageServiceIdEndpointIdGet(ss.Id(), rabbitEndpointId, ep); err != nil {
+	if err := ss.StorageServiceIdEndpointIdGet(context.Background(), ss.Id(), rabbitEndpointId, ep); err != nil {
 		t.Fatalf("Failed to get endpoint ID: %s Error: %+v", rabbitEndpointId, err)
 	}
 
@@ -70,7 +75,7 @@ func TestFileSystem(t *testing.T) {
 		},
 	}
 
-	if err := ss.StorageServiceIdStorageGroupPost(ss.Id(), sg); err != nil {
+	if err := ss.StorageServiceIdStorageGroupPost(context.Background(), ss.Id(), sg); err != nil {
 		t.Fatalf("Failed to create storage group Pool ID: %s Error: %+v", sp.Id, err)
 	}
 
@@ -84,7 +89,7 @@ func TestFileSystem(t *testing.T) {
 		}),
 	}
 
-	if err := ss.StorageServiceIdFileSystemsPost(ss.Id(), fs); err != nil {
+	if err := ss.StorageServiceIdFileSystemsPost(context.Background(), ss.Id(), fs); err != nil {
 		t.Fatalf("Failed to create file system Pool ID: %s Error: %+v", sp.Id, err)
 	}
 
@@ -96,7 +101,7 @@ func TestFileSystem(t *testing.T) {
 		},
 	}
 
-	if err := ss.StorageServiceIdFileSystemIdExportedSharesPost(ss.Id(), fs.Id, sh); err != nil {
+	if err := ss.StorageServiceIdFileSystemIdExportedSharesPost(context.Background(), ss.Id(), fs.Id, sh); err != nil {
 		t.Fatalf("Failed to create file share Pool ID: %s Error: %+v", sp.Id, err)
 	}
 
@@ -107,7 +112,7 @@ func TestFileSystem(t *testing.T) {
 	}
 
 	t.Logf("Deleting Storage Pool %s", sp.Id)
-	if err := ss.StorageServiceIdStoragePoolIdDelete(ss.Id(), sp.Id); err != nil {
+	if err := ss.StorageServiceIdStoragePoolIdDelete(context.Background(), ss.Id(), sp.Id); err != nil {
 		t.Fatalf("Failed to delete storage pool %s Error: %+v", sp.Id, err)
 	}
 }
@@ -132,7 +137,7 @@ func (*testFileSystem) Name() string                          { return "test" }
 func (*testFileSystem) VgChangeActivateDefault() string       { return "" }
 func (*testFileSystem) MkfsDefault() string                   { return "" }
 
-func (fs *testFileSystem) Create(devices []string, options server.FileSystemOptions) error {
+func (fs *testFileSystem) Create(ctx context.Context, devices []string, options server.FileSystemOptions) error {
 	fs.t.Logf("Test File System: Create File System: Devices: %+v Options: %+v", devices, options)
 
 	if devices == nil {
@@ -150,7 +155,7 @@ func (fs *testFileSystem) Create(devices []string, options server.FileSystemOpti
 	return nil
 }
 
-func (fs *testFileSystem) Delete() error {
+func (fs *testFileSystem) Delete(ctx context.Context) error {
 	fs.t.Logf("Test File System: Delete File System")
 
 	if fs.created == false {
@@ -165,7 +170,7 @@ func (fs *testFileSystem) Delete() error {
 	return nil
 }
 
-func (fs *testFileSystem) Mount(mountpoint string) error {
+func (fs *testFileSystem) Mount(ctx context.Context, mountpoint string) error {
 	fs.t.Logf("Test File System: Mount %s", mountpoint)
 
 	if mountpoint == "" {
@@ -179,7 +184,7 @@ func (fs *testFileSystem) Mount(mountpoint string) error {
 	return nil
 }
 
-func (fs *testFileSystem) Unmount(mountpoint string) error {
+func (fs *testFileSystem) Unmount(ctx context.Context, mountpoint string) error {
 	fs.t.Logf("Test File System: Unmount")
 
 	if fs.mountpoint == "" {
@@ -202,3 +207,159 @@ func (f *testFileSystem) LoadRecoveryData(map[string]string) {
 func (f *testFileSystem) LoadDeviceList([]string) {
 
 }
+
+func TestFileSystemDeleteInterrupted(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	testFs := &interruptingFileSystem{}
+	server.FileSystemRegistry.RegisterFileSystem(testFs)
+
+	start := func() (*elementcontroller.Controller, nnf.StorageServiceApi) {
+		c := ec.NewController(ec.NewMockOptions(true))
+		if err := c.Init(nil); err != nil {
+			t.Fatalf("Failed to start nnf controller")
+		}
+
+		return c, nnf.NewDefaultStorageService(false /* deleteUnknownVolumes */, false /* replaceMissingVolumes */)
+	}
+
+	c, ss := start()
+
+	sp := &sf.StoragePoolV150StoragePool{
+		CapacityBytes: 1024 * 1024,
+		Oem: openapi.MarshalOem(nnf.AllocationPolicyOem{
+			Policy:     nnf.SpareAllocationPolicyType,
+			Compliance: nnf.RelaxedAllocationComplianceType,
+		}),
+	}
+
+	if err := ss.StorageServiceIdStoragePoolsPost(context.Background(), ss.Id(), sp); err != nil {
+		t.Fatalf("Failed to create storage pool Error: %+v", err)
+	}
+
+	fs := &sf.FileSystemV122FileSystem{
+		Links: sf.FileSystemV122Links{
+			StoragePool: sf.OdataV4IdRef{OdataId: sp.OdataId},
+		},
+		Oem: openapi.MarshalOem(server.FileSystemOem{
+			Type: testFs.Type(),
+			Name: testFs.Name(),
+		}),
+	}
+
+	// Export a file share to each of two endpoints; the unmount of the second is interrupted
+	for endpointId, mountpoint := range []string{"/mnt/deleted", "/mnt/interrupted"} {
+		ep := &sf.EndpointV150Endpoint{}
+		if err := ss.StorageServiceIdEndpointIdGet(context.Background(), ss.Id(), strconv.Itoa(endpointId), ep); err != nil {
+			t.Fatalf("Failed to get endpoint ID: %d Error: %+v", endpointId, err)
+		}
+
+		sg := &sf.StorageGroupV150StorageGroup{
+			Links: sf.StorageGroupV150Links{
+				StoragePool:    sf.OdataV4IdRef{OdataId: sp.OdataId},
+				ServerEndpoint: sf.OdataV4IdRef{OdataId: ep.OdataId},
+			},
+		}
+
+		if err := ss.StorageServiceIdStorageGroupPost(context.Background(), ss.Id(), sg); err != nil {
+			t.Fatalf("Failed to create storage group Pool ID: %s Error: %+v", sp.Id, err)
+		}
+
+		if len(fs.Id) == 0 {
+			if err := ss.StorageServiceIdFileSystemsPost(context.Background(), ss.Id(), fs); err != nil {
+				t.Fatalf("Failed to create file system Pool ID: %s Error: %+v", sp.Id, err)
+			}
+		}
+
+		sh := &sf.FileShareV120FileShare{
+			FileSharePath: mountpoint,
+			Links: sf.FileShareV120Links{
+				FileSystem: sf.OdataV4IdRef{OdataId: fs.OdataId},
+				Endpoint:   sf.OdataV4IdRef{OdataId: ep.OdataId},
+			},
+		}
+
+		if err := ss.StorageServiceIdFileSystemIdExportedSharesPost(context.Background(), ss.Id(), fs.Id, sh); err != nil {
+			t.Fatalf("Failed to create file share %s Error: %+v", mountpoint, err)
+		}
+	}
+
+	testFs.interrupted = "/mnt/interrupted"
+	if err := ss.StorageServiceIdFileSystemIdDelete(context.Background(), ss.Id(), fs.Id); err == nil {
+		t.Fatalf("Delete of file system %s succeeded with an interrupted unmount", fs.Id)
+	}
+
+	// The file share that was unmounted is deleted and the file system and the remaining file share are
+	// retained, both before and after the element controller restarts and replays the store
+	expectShares := func(when string) {
+		shares := &sf.FileShareCollectionFileShareCollection{}
+		if err := ss.StorageServiceIdFileSystemIdExportedSharesGet(context.Background(), ss.Id(), fs.Id, shares); err != nil {
+			t.Fatalf("%s: Failed to list file shares of file system %s: %v", when, fs.Id, err)
+		}
+
+		if shares.MembersodataCount != 1 {
+			t.Fatalf("%s: File shares: Expected: 1 Actual: %+v", when, shares.Members)
+		}
+
+		sh := &sf.FileShareV120FileShare{}
+		if err := ss.StorageServiceIdFileSystemIdExportedShareIdGet(context.Background(), ss.Id(), fs.Id, path.Base(shares.Members[0].OdataId), sh); err != nil || sh.FileSharePath != testFs.interrupted {
+			t.Errorf("%s: Remaining file share: Expected: %s Actual: %s Error: %v", when, testFs.interrupted, sh.FileSharePath, err)
+		}
+	}
+
+	expectShares("Interrupted")
+
+	c.Close()
+	c, ss = start()
+	defer c.Close()
+
+	expectShares("Replayed")
+
+	// Once the unmount succeeds the storage pool is deleted along with everything built upon it
+	testFs.interrupted = ""
+	if err := ss.StorageServiceIdStoragePoolIdDelete(context.Background(), ss.Id(), sp.Id); err != nil {
+		t.Fatalf("Failed to delete storage pool %s Error: %+v", sp.Id, err)
+	}
+
+	fileSystems := &sf.FileSystemCollectionFileSystemCollection{}
+	if err := ss.StorageServiceIdFileSystemsGet(context.Background(), ss.Id(), fileSystems); err != nil || fileSystems.MembersodataCount != 0 {
+		t.Errorf("File systems after storage pool delete: Expected: 0 Actual: %+v Error: %v", fileSystems.Members, err)
+	}
+}
+
+// interruptingFileSystem is a mockable file system whose unmount of the interrupted mount point fails
+type interruptingFileSystem struct {
+	interrupted string
+}
+
+func (fs *interruptingFileSystem) New(oem server.FileSystemOem) (server.FileSystemApi, error) {
+	return fs, nil
+}
+
+func (*interruptingFileSystem) IsType(oem *server.FileSystemOem) bool {
+	return oem.Type == "interrupting"
+}
+func (*interruptingFileSystem) IsMockable() bool                { return true }
+func (*interruptingFileSystem) Type() string                    { return "interrupting" }
+func (*interruptingFileSystem) Name() string                    { return "interrupting" }
+func (*interruptingFileSystem) VgChangeActivateDefault() string { return "" }
+func (*interruptingFileSystem) MkfsDefault() string             { return "" }
+
+func (*interruptingFileSystem) Create(ctx context.Context, devices []string, options server.FileSystemOptions) error {
+	return nil
+}
+
+func (*interruptingFileSystem) Delete(ctx context.Context) error                   { return nil }
+func (*interruptingFileSystem) Mount(ctx context.Context, mountpoint string) error { return nil }
+
+func (fs *interruptingFileSystem) Unmount(ctx context.Context, mountpoint string) error {
+	if mountpoint == fs.interrupted {
+		return fmt.Errorf("unmount of %s interrupted", mountpoint)
+	}
+
+	return nil
+}
+
+func (*interruptingFileSystem) GenerateRecoveryData() map[string]string { return map[string]string{} }
+func (*interruptingFileSystem) LoadRecoveryData(map[string]string)      {}
+func (*interruptingFileSystem) LoadDeviceList([]string)                 {}
@@ -20,13 +20,24 @@
 package benchmarks
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/google/uuid"
+
 	ec "github.com/NearNodeFlash/nnf-ec/pkg"
+	"github.com/NearNodeFlash/nnf-ec/pkg/common"
+	event "github.com/NearNodeFlash/nnf-ec/pkg/manager-event"
 	nnf "github.com/NearNodeFlash/nnf-ec/pkg/manager-nnf"
+	nvme "github.com/NearNodeFlash/nnf-ec/pkg/manager-nvme"
 	server "github.com/NearNodeFlash/nnf-ec/pkg/manager-server"
+	"github.com/NearNodeFlash/nnf-ec/pkg/persistent"
 
+	elementcontroller "github.com/NearNodeFlash/nnf-ec/pkg/ec"
 	openapi "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/common"
 	sf "github.com/NearNodeFlash/nnf-ec/pkg/rfsf/pkg/models"
 )
@@ -42,7 +53,7 @@ func TestStoragePools(t *testing.T) {
 	ss := nnf.NewDefaultStorageService(true /* deleteUnknownVolumes */, true /* replaceMissingVolumes */)
 
 	cs := &sf.CapacityCapacitySource{}
-	if err := ss.StorageServiceIdCapacitySourceGet(ss.Id(), cs); err != nil {
+	if err := ss.StorageServiceIdCapacitySourceGet(context.Background(), ss.Id(), cs); err != nil {
 		t.Errorf("Failed to retrieve capacity source: %v", err)
 	}
 
@@ -50,7 +61,7 @@ func TestStoragePools(t *testing.T) {
 	// for status. This can bringout bugs where the server is down.
 	{
 		epc := &sf.EndpointCollectionEndpointCollection{}
-		if err := ss.StorageServiceIdEndpointsGet(ss.Id(), epc); err != nil {
+		if err := ss.StorageServiceIdEndpointsGet(context.Background(), ss.Id(), epc); err != nil {
 			t.Errorf("Failed to retrieve endpoints: %v", err)
 		}
 
@@ -58,7 +69,7 @@ func TestStoragePools(t *testing.T) {
 			epid := ref.OdataId[strings.LastIndex(ref.OdataId, "/")+1:]
 
 			ep := &sf.EndpointV150Endpoint{}
-			if err := ss.StorageServiceIdEndpointIdGet(ss.Id(), epid, ep); err != nil {
+			if err := ss.StorageServiceIdEndpointIdGet(context.Background(), ss.Id(), epid, ep); err != nil {
 				t.Errorf("Failed to retrieve endpoint id %s: %v", epid, err)
 			}
 		}
@@ -76,7 +87,7 @@ func TestStoragePools(t *testing.T) {
 			}),
 		}
 
-		if err := ss.StorageServiceIdStoragePoolsPost(ss.Id(), sp); err != nil {
+		if err := ss.StorageServiceIdStoragePoolsPost(context.Background(), ss.Id(), sp); err != nil {
 			t.Fatalf("Failed to create storage pool %d Error: %+v", j, err)
 		}
 
@@ -84,7 +95,7 @@ func TestStoragePools(t *testing.T) {
 
 		rabbitEndpointId := "0"
 		ep := &sf.EndpointV150Endpoint{}
-		if err := ss.StorageServiceIdEndpointIdGet(ss.Id(), rabbitEndpointId, ep); err != nil {
+		if err := ss.StorageServiceIdEndpointIdGet(context.Background(), ss.Id(), rabbitEndpointId, ep); err != nil {
 			t.Fatalf("Failed to get endpoint ID: %s Error: %+v", rabbitEndpointId, err)
 		}
 
@@ -95,7 +106,7 @@ func TestStoragePools(t *testing.T) {
 			},
 		}
 
-		if err := ss.StorageServiceIdStorageGroupPost(ss.Id(), sg); err != nil {
+		if err := ss.StorageServiceIdStorageGroupPost(context.Background(), ss.Id(), sg); err != nil {
 			t.Fatalf("Failed to create storage group Pool ID: %s Error: %+v", sp.Id, err)
 		}
 
@@ -109,7 +120,7 @@ func TestStoragePools(t *testing.T) {
 			}),
 		}
 
-		if err := ss.StorageServiceIdFileSystemsPost(ss.Id(), fs); err != nil {
+		if err := ss.StorageServiceIdFileSystemsPost(context.Background(), ss.Id(), fs); err != nil {
 			t.Fatalf("Failed to create file system Pool ID: %s Error: %+v", sp.Id, err)
 		}
 
@@ -121,7 +132,7 @@ func TestStoragePools(t *testing.T) {
 			},
 		}
 
-		if err := ss.StorageServiceIdFileSystemIdExportedSharesPost(ss.Id(), fs.Id, sh); err != nil {
+		if err := ss.StorageServiceIdFileSystemIdExportedSharesPost(context.Background(), ss.Id(), fs.Id, sh); err != nil {
 			t.Fatalf("Failed to create file share Pool ID: %s Error: %+v", sp.Id, err)
 		}
 	}
@@ -129,8 +140,504 @@ func TestStoragePools(t *testing.T) {
 	t.Logf("Created %d Storage Pools. Starting Delete...", len(pools))
 
 	for _, pool := range pools {
-		if err := ss.StorageServiceIdStoragePoolIdDelete(ss.Id(), pool.Id); err != nil {
+		if err := ss.StorageServiceIdStoragePoolIdDelete(context.Background(), ss.Id(), pool.Id); err != nil {
 			t.Fatalf("Failed to delete storage pool ID %s Error: %v", pool.Id, err)
 		}
 	}
 }
+
+func TestStorageServiceAudit(t *testing.T) {
+	c := ec.NewController(ec.NewMockOptions(false))
+	defer c.Close()
+
+	if err := c.Init(nil); err != nil {
+		t.Fatalf("Failed to start nnf controller")
+	}
+
+	ss := nnf.NewDefaultStorageService(false /* deleteUnknownVolumes */, false /* replaceMissingVolumes */)
+
+	report := &nnf.AuditReport{}
+	if err := ss.StorageServiceIdAuditGet(context.Background(), ss.Id(), report); err != nil {
+		t.Fatalf("Failed to retrieve audit report: %v", err)
+	}
+
+	if !report.Consistent {
+		t.Errorf("Audit report of an empty storage service is inconsistent: %+v", report)
+	}
+
+	sp := &sf.StoragePoolV150StoragePool{
+		CapacityBytes: 1024 * 1024,
+		Oem: openapi.MarshalOem(nnf.AllocationPolicyOem{
+			Policy:     nnf.SpareAllocationPolicyType,
+			Compliance: nnf.RelaxedAllocationComplianceType,
+		}),
+	}
+
+	if err := ss.StorageServiceIdStoragePoolsPost(context.Background(), ss.Id(), sp); err != nil {
+		t.Fatalf("Failed to create storage pool: %v", err)
+	}
+
+	if err := ss.StorageServiceIdAuditPost(context.Background(), ss.Id(), report); err != nil {
+		t.Fatalf("Failed to run audit: %v", err)
+	}
+
+	if !report.Consistent || len(report.UnknownVolumes) != 0 {
+		t.Errorf("Audit report inconsistent after storage pool create: %+v", report)
+	}
+
+	if err := ss.StorageServiceIdStoragePoolIdDelete(context.Background(), ss.Id(), sp.Id); err != nil {
+		t.Fatalf("Failed to delete storage pool ID %s Error: %v", sp.Id, err)
+	}
+
+	// A volume outside of any storage pool is reported, but never deleted, by the audit
+	storage := nvme.GetStorage()[0]
+	volume, err := nvme.CreateVolume(context.Background(), storage, 1024*1024)
+	if err != nil {
+		t.Fatalf("Failed to create volume: %v", err)
+	}
+	defer volume.Delete(context.Background())
+
+	nsid := strconv.Itoa(int(volume.GetNamespaceId()))
+
+	last := lastEventId(t)
+	if err := ss.StorageServiceIdAuditPost(context.Background(), ss.Id(), report); err != nil {
+		t.Fatalf("Failed to run audit: %v", err)
+	}
+
+	if report.Consistent || len(report.UnknownVolumes) != 1 {
+		t.Fatalf("Audit report did not find the unknown volume: %+v", report)
+	}
+
+	if uv := report.UnknownVolumes[0]; uv.SerialNumber != storage.SerialNumber() || uv.NamespaceId != nsid || uv.CapacityBytes != volume.GetCapacityBytes() {
+		t.Errorf("Unknown volume incorrect: Expected: %s/%s Actual: %+v", storage.SerialNumber(), nsid, uv)
+	}
+
+	expectEvent(t, last, "Nnf.1.0.0.UnknownVolumeFound", storage.SerialNumber(), nsid)
+
+	if storage.FindVolume(volume.Id()) == nil {
+		t.Errorf("Audit deleted unknown volume %s", nsid)
+	}
+}
+
+func TestStorageServiceQuarantinedVolumes(t *testing.T) {
+	c := ec.NewController(ec.NewMockOptions(false))
+	defer c.Close()
+
+	if err := c.Init(nil); err != nil {
+		t.Fatalf("Failed to start nnf controller")
+	}
+
+	ss := nnf.NewDefaultStorageService(false /* deleteUnknownVolumes */, false /* replaceMissingVolumes */, nnf.WithQuarantineUnknownVolumes(true))
+
+	qvc := &nnf.QuarantinedVolumeCollection{}
+	if err := ss.StorageServiceIdQuarantinedVolumesGet(context.Background(), ss.Id(), qvc); err != nil {
+		t.Fatalf("Failed to retrieve quarantined volumes: %v", err)
+	}
+
+	if qvc.MembersOdataCount != 0 {
+		t.Errorf("Quarantined volumes found on an empty storage service: %+v", qvc)
+	}
+
+	if err := ss.StorageServiceIdQuarantinedVolumeIdDelete(context.Background(), ss.Id(), "does-not-exist"); err == nil {
+		t.Errorf("Delete of a non-existent quarantined volume succeeded")
+	}
+}
+
+func TestStorageServiceQuarantineUnknownVolume(t *testing.T) {
+	// The mock drives and the storage service are persisted in the working directory so the unknown
+	// namespace is found when the element controller restarts
+	t.Chdir(t.TempDir())
+
+	c := ec.NewController(ec.NewMockOptions(true))
+	if err := c.Init(nil); err != nil {
+		t.Fatalf("Failed to start nnf controller")
+	}
+
+	// Create a volume outside of any storage pool, as left behind after the loss of the controller state
+	storage := nvme.GetStorage()[0]
+	volume, err := nvme.CreateVolume(context.Background(), storage, 1024*1024)
+	if err != nil {
+		t.Fatalf("Failed to create volume: %v", err)
+	}
+
+	serialNumber := storage.SerialNumber()
+	namespaceId := volume.GetNamespaceId()
+	nsid := strconv.Itoa(int(namespaceId))
+	capacityBytes := volume.GetCapacityBytes()
+
+	// A second volume is deleted outside of the element controller once quarantined
+	removed, err := nvme.CreateVolume(context.Background(), storage, 1024*1024)
+	if err != nil {
+		t.Fatalf("Failed to create volume: %v", err)
+	}
+
+	removedNamespaceId := removed.GetNamespaceId()
+	removedId := strings.TrimSpace(serialNumber) + "-" + strconv.Itoa(int(removedNamespaceId))
+
+	c.Close()
+
+	// Restart with quarantine enabled; the storage service is configured before the controller is
+	// initialized as the unknown volumes are quarantined once the fabric is ready.
+	c = ec.NewController(ec.NewMockOptions(true))
+	ss := nnf.NewDefaultStorageService(false /* deleteUnknownVolumes */, false /* replaceMissingVolumes */, nnf.WithQuarantineUnknownVolumes(true))
+	if err := c.Init(nil); err != nil {
+		t.Fatalf("Failed to restart nnf controller")
+	}
+	defer c.Close()
+
+	// The event history starts anew with the element controller
+	expectEvent(t, -1, "Nnf.1.0.0.UnknownVolumeFound", serialNumber, nsid)
+	expectEvent(t, -1, "Nnf.1.0.0.VolumeQuarantined", serialNumber, nsid)
+
+	qvc := &nnf.QuarantinedVolumeCollection{}
+	if err := ss.StorageServiceIdQuarantinedVolumesGet(context.Background(), ss.Id(), qvc); err != nil {
+		t.Fatalf("Failed to retrieve quarantined volumes: %v", err)
+	}
+
+	id := strings.TrimSpace(serialNumber) + "-" + nsid
+	if qvc.MembersOdataCount != 2 || !strings.HasSuffix(qvc.Members[0].OdataId, "/"+id) || !strings.HasSuffix(qvc.Members[1].OdataId, "/"+removedId) {
+		t.Fatalf("Quarantined volumes: Expected: %s, %s Actual: %+v", id, removedId, qvc)
+	}
+
+	// A quarantined volume whose namespace is gone is not found, and is removed from quarantine by the next audit
+	removed, err = nvme.GetStorage()[0].FindVolumeByNamespaceId(removedNamespaceId)
+	if err != nil {
+		t.Fatalf("Failed to find volume %s: %v", removedId, err)
+	}
+
+	if err := removed.Delete(context.Background()); err != nil {
+		t.Fatalf("Failed to delete volume %s: %v", removedId, err)
+	}
+
+	if err := ss.StorageServiceIdQuarantinedVolumeIdGet(context.Background(), ss.Id(), removedId, &nnf.QuarantinedVolume{}); err == nil {
+		t.Errorf("Get of quarantined volume %s succeeded after its namespace was deleted", removedId)
+	}
+
+	if err := ss.StorageServiceIdQuarantinedVolumesGet(context.Background(), ss.Id(), qvc); err != nil || qvc.MembersOdataCount != 2 {
+		t.Errorf("Quarantined volumes changed by a get: Expected: 2 Actual: %+v %v", qvc, err)
+	}
+
+	if err := ss.StorageServiceIdAuditPost(context.Background(), ss.Id(), &nnf.AuditReport{}); err != nil {
+		t.Fatalf("Failed to audit storage service: %v", err)
+	}
+
+	if err := ss.StorageServiceIdQuarantinedVolumesGet(context.Background(), ss.Id(), qvc); err != nil || qvc.MembersOdataCount != 1 {
+		t.Errorf("Quarantined volumes after audit: Expected: 1 Actual: %+v %v", qvc, err)
+	}
+
+	qv := &nnf.QuarantinedVolume{}
+	if err := ss.StorageServiceIdQuarantinedVolumeIdGet(context.Background(), ss.Id(), id, qv); err != nil {
+		t.Fatalf("Failed to retrieve quarantined volume %s: %v", id, err)
+	}
+
+	if qv.SerialNumber != serialNumber || qv.NamespaceId != nsid || qv.CapacityBytes != capacityBytes || qv.Metadata != nil {
+		t.Errorf("Quarantined volume incorrect: Expected: %s/%s %d bytes Actual: %+v", serialNumber, nsid, capacityBytes, qv)
+	}
+
+	// Deleting the quarantined volume destroys the namespace
+	if err := ss.StorageServiceIdQuarantinedVolumeIdDelete(context.Background(), ss.Id(), id); err != nil {
+		t.Fatalf("Failed to delete quarantined volume %s: %v", id, err)
+	}
+
+	if err := ss.StorageServiceIdQuarantinedVolumesGet(context.Background(), ss.Id(), qvc); err != nil || qvc.MembersOdataCount != 0 {
+		t.Errorf("Quarantined volumes after delete: Expected: 0 Actual: %+v %v", qvc, err)
+	}
+
+	if _, err := nvme.GetStorage()[0].FindVolumeByNamespaceId(namespaceId); err == nil {
+		t.Errorf("Quarantined volume %s not deleted", id)
+	}
+}
+
+func TestStoragePoolAdopt(t *testing.T) {
+	c := ec.NewController(ec.NewMockOptions(false))
+	defer c.Close()
+
+	if err := c.Init(nil); err != nil {
+		t.Fatalf("Failed to start nnf controller")
+	}
+
+	ss := nnf.NewDefaultStorageService(false /* deleteUnknownVolumes */, false /* replaceMissingVolumes */)
+
+	// Create a volume outside of any storage pool to simulate a namespace left behind after the loss of
+	// the controller state.
+	storage := nvme.GetStorage()[0]
+	volume, err := nvme.CreateVolume(context.Background(), storage, 1024*1024)
+	if err != nil {
+		t.Fatalf("Failed to create volume: %v", err)
+	}
+
+	adopt := nnf.StoragePoolAdoptOem{
+		Adopt: nnf.StoragePoolAdopt{
+			Volumes: []nnf.StoragePoolAdoptVolume{
+				{SerialNumber: storage.SerialNumber(), NamespaceId: int(volume.GetNamespaceId())},
+			},
+		},
+	}
+
+	sp := &sf.StoragePoolV150StoragePool{Oem: openapi.MarshalOem(adopt)}
+	if err := ss.StorageServiceIdStoragePoolsPost(context.Background(), ss.Id(), sp); err != nil {
+		t.Fatalf("Failed to adopt storage pool: %v", err)
+	}
+
+	if sp.CapacityBytes != int64(volume.GetCapacityBytes()) {
+		t.Errorf("Adopted storage pool capacity mismatch: Expected: %d Actual: %d", volume.GetCapacityBytes(), sp.CapacityBytes)
+	}
+
+	// A volume owned by a storage pool cannot be adopted a second time
+	if err := ss.StorageServiceIdStoragePoolsPost(context.Background(), ss.Id(), &sf.StoragePoolV150StoragePool{Oem: openapi.MarshalOem(adopt)}); err == nil {
+		t.Errorf("Adopt of a volume owned by storage pool %s succeeded", sp.Id)
+	}
+
+	// An adopt request that cannot be unmarshaled is rejected rather than treated as an allocation
+	for _, oem := range []map[string]interface{}{
+		{"Adopt": "volumes"},
+		{"Adopt": map[string]interface{}{"Volumes": []interface{}{map[string]interface{}{"NamespaceId": "1"}}}},
+	} {
+		err := ss.StorageServiceIdStoragePoolsPost(context.Background(), ss.Id(), &sf.StoragePoolV150StoragePool{CapacityBytes: 1024 * 1024, Oem: oem})
+
+		var cerr *elementcontroller.ControllerError
+		if !errors.As(err, &cerr) || cerr.StatusCode() != http.StatusBadRequest {
+			t.Errorf("Malformed adopt %v: Expected status %d: Actual: %v", oem, http.StatusBadRequest, err)
+		}
+	}
+
+	pools := &sf.StoragePoolCollectionStoragePoolCollection{}
+	if err := ss.StorageServiceIdStoragePoolsGet(context.Background(), ss.Id(), pools); err != nil {
+		t.Fatalf("Failed to list storage pools: %v", err)
+	}
+
+	if pools.MembersodataCount != 1 {
+		t.Errorf("Storage pools after malformed adopt: Expected: 1 Actual: %d", pools.MembersodataCount)
+	}
+
+	if err := ss.StorageServiceIdStoragePoolIdDelete(context.Background(), ss.Id(), sp.Id); err != nil {
+		t.Fatalf("Failed to delete storage pool ID %s Error: %v", sp.Id, err)
+	}
+}
+
+func TestStoragePoolAdoptReplay(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	start := func() (*elementcontroller.Controller, nnf.StorageServiceApi) {
+		c := ec.NewController(ec.NewMockOptions(true))
+		ss := nnf.NewDefaultStorageService(false /* deleteUnknownVolumes */, false /* replaceMissingVolumes */)
+		if err := c.Init(nil); err != nil {
+			t.Fatalf("Failed to start nnf controller")
+		}
+
+		return c, ss
+	}
+
+	c, ss := start()
+
+	// Adopt two storage pools by their list of volumes; each must be given its own UUID
+	uids := map[string]string{}
+	for idx, storage := range nvme.GetStorage()[:2] {
+		volume, err := nvme.CreateVolume(context.Background(), storage, 1024*1024)
+		if err != nil {
+			t.Fatalf("Failed to create volume: %v", err)
+		}
+
+		adopt := nnf.StoragePoolAdoptOem{
+			Adopt: nnf.StoragePoolAdopt{
+				Volumes: []nnf.StoragePoolAdoptVolume{
+					{SerialNumber: storage.SerialNumber(), NamespaceId: int(volume.GetNamespaceId())},
+				},
+			},
+		}
+
+		sp := &sf.StoragePoolV150StoragePool{Oem: openapi.MarshalOem(adopt)}
+		if err := ss.StorageServiceIdStoragePoolsPost(context.Background(), ss.Id(), sp); err != nil {
+			t.Fatalf("Failed to adopt storage pool %d: %v", idx, err)
+		}
+
+		uid, err := uuid.Parse(sp.Identifier.DurableName)
+		if err != nil || uid == uuid.Nil {
+			t.Fatalf("Adopted storage pool %s has no UUID: %s", sp.Id, sp.Identifier.DurableName)
+		}
+
+		for id, other := range uids {
+			if other == sp.Identifier.DurableName {
+				t.Errorf("Adopted storage pools %s and %s share UUID %s", id, sp.Id, other)
+			}
+		}
+
+		uids[sp.Id] = sp.Identifier.DurableName
+
+		// The namespace metadata records the storage pool's UUID
+		data, err := volume.GetFeature(context.Background())
+		if err != nil {
+			t.Fatalf("Failed to read namespace metadata: %v", err)
+		}
+
+		md, err := common.DecodeNamespaceMetadata(data)
+		if err != nil {
+			t.Fatalf("Failed to decode namespace metadata: %v", err)
+		}
+
+		if md.Id != uid || md.Index != 0 || md.Count != 1 {
+			t.Errorf("Namespace metadata incorrect: Expected: %s 0/1 Actual: %s %d/%d", uid, md.Id, md.Index, md.Count)
+		}
+	}
+
+	c.Close()
+
+	// After a restart the adopted storage pools are replayed with the same UUIDs
+	c, ss = start()
+	defer c.Close()
+
+	for id, uid := range uids {
+		sp := &sf.StoragePoolV150StoragePool{}
+		if err := ss.StorageServiceIdStoragePoolIdGet(context.Background(), ss.Id(), id, sp); err != nil {
+			t.Fatalf("Failed to retrieve storage pool %s after restart: %v", id, err)
+		}
+
+		if sp.Identifier.DurableName != uid {
+			t.Errorf("Storage pool %s UUID after restart: Expected: %s Actual: %s", id, uid, sp.Identifier.DurableName)
+		}
+
+		if err := ss.StorageServiceIdStoragePoolIdDelete(context.Background(), ss.Id(), id); err != nil {
+			t.Errorf("Failed to delete storage pool %s: %v", id, err)
+		}
+	}
+}
+
+func TestStorageServiceInterruptedOperations(t *testing.T) {
+	c := ec.NewController(ec.NewMockOptions(false))
+	defer c.Close()
+
+	if err := c.Init(nil); err != nil {
+		t.Fatalf("Failed to start nnf controller")
+	}
+
+	ss := nnf.NewDefaultStorageService(false /* deleteUnknownVolumes */, false /* replaceMissingVolumes */)
+
+	ioc := &nnf.InterruptedOperationCollection{}
+	if err := ss.StorageServiceIdInterruptedOperationsGet(context.Background(), ss.Id(), ioc); err != nil {
+		t.Fatalf("Failed to retrieve interrupted operations: %v", err)
+	}
+
+	if ioc.MembersOdataCount != 0 {
+		t.Errorf("Interrupted operations found on a new storage service: %+v", ioc)
+	}
+
+	if err := ss.StorageServiceIdInterruptedOperationIdGet(context.Background(), ss.Id(), "0", &nnf.InterruptedOperation{}); err == nil {
+		t.Errorf("Get of a non-existent interrupted operation succeeded")
+	}
+}
+
+// testRegistry permits the ledgers of a registry prefix to be written outside of the element controller
+type testRegistry string
+
+func (r testRegistry) Prefix() string                               { return string(r) }
+func (r testRegistry) NewReplay(id string) persistent.ReplayHandler { return nil }
+
+func TestStorageServiceReplayInterruptedCreate(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	// Record a storage pool ledger ending on the create start entry, as left by a crash part way through
+	// allocating the storage pool's volumes
+	const storagePoolId = "interrupted"
+
+	store, err := persistent.Open("nnf.db", false)
+	if err != nil {
+		t.Fatalf("Failed to open storage database: %v", err)
+	}
+
+	store.Register([]persistent.Registry{testRegistry("SP")})
+
+	ledger, err := store.NewKey("SP"+storagePoolId, []byte(`{"Name": "Interrupted", "Uid": "`+uuid.New().String()+`"}`))
+	if err != nil {
+		t.Fatalf("Failed to create storage pool ledger: %v", err)
+	}
+
+	if err := ledger.Log(0 /* create start */, nil); err != nil {
+		t.Fatalf("Failed to log create start: %v", err)
+	}
+
+	ledger.Close(false)
+	store.Close()
+
+	c := ec.NewController(ec.NewMockOptions(true))
+	ss := nnf.NewDefaultStorageService(false /* deleteUnknownVolumes */, false /* replaceMissingVolumes */)
+	if err := c.Init(nil); err != nil {
+		t.Fatalf("Failed to start nnf controller")
+	}
+	defer c.Close()
+
+	object := "/redfish/v1/StorageServices/" + ss.Id() + "/StoragePools/" + storagePoolId
+
+	expectEvent(t, -1, "Nnf.1.0.0.InterruptedOperationFound", object, "Create", "Retained")
+
+	ioc := &nnf.InterruptedOperationCollection{}
+	if err := ss.StorageServiceIdInterruptedOperationsGet(context.Background(), ss.Id(), ioc); err != nil {
+		t.Fatalf("Failed to retrieve interrupted operations: %v", err)
+	}
+
+	if ioc.MembersOdataCount != 1 {
+		t.Fatalf("Interrupted operations: Expected: 1 Actual: %+v", ioc)
+	}
+
+	op := &nnf.InterruptedOperation{}
+	if err := ss.StorageServiceIdInterruptedOperationIdGet(context.Background(), ss.Id(), "0", op); err != nil {
+		t.Fatalf("Failed to retrieve interrupted operation: %v", err)
+	}
+
+	if op.Object.OdataId != object || op.Operation != "Create" || op.LastEntry != "CreateStart" || op.Action != "Retained" {
+		t.Errorf("Interrupted operation incorrect: %+v", op)
+	}
+
+	// The storage pool is retained so the client can retry or delete it
+	pools := &sf.StoragePoolCollectionStoragePoolCollection{}
+	if err := ss.StorageServiceIdStoragePoolsGet(context.Background(), ss.Id(), pools); err != nil {
+		t.Fatalf("Failed to list storage pools: %v", err)
+	}
+
+	if pools.MembersodataCount != 1 || pools.Members[0].OdataId != object {
+		t.Errorf("Storage pools after replay: Expected: %s Actual: %+v", object, pools.Members)
+	}
+
+	if err := ss.StorageServiceIdStoragePoolIdDelete(context.Background(), ss.Id(), storagePoolId); err != nil {
+		t.Errorf("Failed to delete interrupted storage pool: %v", err)
+	}
+}
+
+// lastEventId returns the identifier of the most recently published event, or -1 if none were published
+func lastEventId(t *testing.T) int {
+	model := sf.EventCollectionEventCollection{}
+	if err := event.EventManager.EventsGet(&model); err != nil {
+		t.Fatalf("Failed to retrieve events: %v", err)
+	}
+
+	last := -1
+	for _, member := range model.Members {
+		id, _ := strconv.Atoi(member.OdataId[strings.LastIndex(member.OdataId, "/")+1:])
+		if id > last {
+			last = id
+		}
+	}
+
+	return last
+}
+
+// expectEvent checks that an event with the message id and arguments was published after the event
+// identified by last
+func expectEvent(t *testing.T, last int, messageId string, args ...string) {
+	t.Helper()
+
+	count := 0
+	for id := last + 1; id <= lastEventId(t); id++ {
+		e := sf.EventV161Event{}
+		if err := event.EventManager.EventsEventIdGet(strconv.Itoa(id), &e); err != nil {
+			t.Fatalf("Failed to retrieve event %d: %v", id, err)
+		}
+
+		if e.MessageId == messageId && strings.Join(e.MessageArgs, ",") == strings.Join(args, ",") {
+			count++
+		}
+	}
+
+	if count == 0 {
+		t.Errorf("Event %s %v not published", messageId, args)
+	}
+}
@@ -20,6 +20,7 @@
 package recovery_test
 
 import (
+	"context"
 	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -161,12 +162,12 @@ func (o *testStoragePoolObject) CreateObject(ss nnf.StorageServiceApi, objs ...t
 		}),
 	}
 
-	return ss.StorageServiceIdStoragePoolsPost(ss.Id(), &o.sp)
+	return ss.StorageServiceIdStoragePoolsPost(context.Background(), ss.Id(), &o.sp)
 }
 
 func (o *testStoragePoolObject) VerifyObject(ss nnf.StorageServiceApi) error {
 	var sp sf.StoragePoolV150StoragePool
-	if err := ss.StorageServiceIdStoragePoolIdGet(ss.Id(), o.Id(), &sp); err != nil {
+	if err := ss.StorageServiceIdStoragePoolIdGet(context.Background(), ss.Id(), o.Id(), &sp); err != nil {
 		return err
 	}
 
@@ -175,7 +176,7 @@ func (o *testStoragePoolObject) VerifyObject(ss nnf.StorageServiceApi) error {
 }
 
 func (o *testStoragePoolObject) DeleteObject(ss nnf.StorageServiceApi) error {
-	return ss.StorageServiceIdStoragePoolIdDelete(ss.Id(), o.sp.Id)
+	return ss.StorageServiceIdStoragePoolIdDelete(context.Background(), ss.Id(), o.sp.Id)
 }
 
 /*
@@ -196,7 +197,7 @@ func (o *testServerEndpointObject) Id() string      { return o.ep.Id }
 func (o *testServerEndpointObject) OdataId() string { return o.ep.OdataId }
 
 func (o *testServerEndpointObject) CreateObject(ss nnf.StorageServiceApi, objs ...testRecoveryApi) error {
-	return ss.StorageServiceIdEndpointIdGet(ss.Id(), "0", &o.ep)
+	return ss.StorageServiceIdEndpointIdGet(context.Background(), ss.Id(), "0", &o.ep)
 }
 
 func (o *testServerEndpointObject) VerifyObject(nnf.StorageServiceApi) error { return nil }
@@ -234,12 +235,12 @@ func (o *testStorageGroupObject) CreateObject(ss nnf.StorageServiceApi, objs ...
 		},
 	}
 
-	return ss.StorageServiceIdStorageGroupPost(ss.Id(), &o.sg)
+	return ss.StorageServiceIdStorageGroupPost(context.Background(), ss.Id(), &o.sg)
 }
 
 func (o *testStorageGroupObject) VerifyObject(ss nnf.StorageServiceApi) error {
 	var sg sf.StorageGroupV150StorageGroup
-	if err := ss.StorageServiceIdStorageGroupIdGet(ss.Id(), o.sg.Id, &sg); err != nil {
+	if err := ss.StorageServiceIdStorageGroupIdGet(context.Background(), ss.Id(), o.sg.Id, &sg); err != nil {
 		return err
 	}
 
@@ -247,7 +248,7 @@ func (o *testStorageGroupObject) VerifyObject(ss nnf.StorageServiceApi) error {
 }
 
 func (o *testStorageGroupObject) DeleteObject(ss nnf.StorageServiceApi) error {
-	return ss.StorageServiceIdStorageGroupIdDelete(ss.Id(), o.sg.Id)
+	return ss.StorageServiceIdStorageGroupIdDelete(context.Background(), ss.Id(), o.sg.Id)
 }
 
 /*
@@ -280,7 +281,7 @@ func (o *testFileSystemObject) CreateObject(ss nnf.StorageServiceApi, objs ...te
 		),
 	}
 
-	return ss.StorageServiceIdFileSystemsPost(ss.Id(), &o.fs)
+	return ss.StorageServiceIdFileSystemsPost(context.Background(), ss.Id(), &o.fs)
 }
 
 func (o *testFileSystemObject) VerifyObject(ss nnf.StorageServiceApi) error {
@@ -288,7 +289,7 @@ func (o *testFileSystemObject) VerifyObject(ss nnf.StorageServiceApi) error {
 }
 
 func (o *testFileSystemObject) DeleteObject(ss nnf.StorageServiceApi) error {
-	return ss.StorageServiceIdFileSystemIdDelete(ss.Id(), o.fs.Id)
+	return ss.StorageServiceIdFileSystemIdDelete(context.Background(), ss.Id(), o.fs.Id)
 }
 
 /*
@@ -324,11 +325,11 @@ func (o *testFileShareObject) CreateObject(ss nnf.StorageServiceApi, objs ...tes
 
 	o.fsId = objs[3].Id()
 
-	return ss.StorageServiceIdFileSystemIdExportedSharesPost(ss.Id(), o.fsId, &o.sh)
+	return ss.StorageServiceIdFileSystemIdExportedSharesPost(context.Background(), ss.Id(), o.fsId, &o.sh)
 }
 
 func (o *testFileShareObject) VerifyObject(ss nnf.StorageServiceApi) error { return nil }
 
 func (o *testFileShareObject) DeleteObject(ss nnf.StorageServiceApi) error {
-	return ss.StorageServiceIdFileSystemIdExportedShareIdDelete(ss.Id(), o.fsId, o.sh.Id)
+	return ss.StorageServiceIdFileSystemIdExportedShareIdDelete(context.Background(), ss.Id(), o.fsId, o.sh.Id)
 }
@@ -0,0 +1,34 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package tracing
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+)
+
+// Logger returns the logger with the request ID carried by the context, if any, added to every log line
+func Logger(ctx context.Context, log logr.Logger) logr.Logger {
+	if id := RequestId(ctx); len(id) != 0 {
+		return log.WithValues("requestId", id)
+	}
+
+	return log
+}
@@ -0,0 +1,256 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// OTLP Export - Spans are optionally exported to an OpenTelemetry collector using the OTLP/HTTP protocol
+// with JSON encoding, typically a collector running alongside the element controller. Spans are queued
+// and sent in batches; spans are dropped rather than delaying requests if the collector falls behind.
+
+const (
+	exportQueueLength = 1024
+	exportBatchSize   = 256
+	exportInterval    = 5 * time.Second
+	exportTimeout     = 10 * time.Second
+)
+
+var exporter atomic.Pointer[otlpExporter]
+
+type otlpExporter struct {
+	url         string
+	serviceName string
+	client      http.Client
+	log         logr.Logger
+
+	spans   chan *Span
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+// StartExporter starts exporting spans to the OTLP/HTTP collector at the endpoint, such as
+// http://localhost:4318
+func StartExporter(endpoint, serviceName string, log logr.Logger) error {
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		return fmt.Errorf("OTLP endpoint '%s' must be an http or https URL", endpoint)
+	}
+
+	url := strings.TrimSuffix(endpoint, "/")
+	if !strings.HasSuffix(url, "/v1/traces") {
+		url += "/v1/traces"
+	}
+
+	e := &otlpExporter{
+		url:         url,
+		serviceName: serviceName,
+		client:      http.Client{Timeout: exportTimeout},
+		log:         log.WithName("otlp"),
+		spans:       make(chan *Span, exportQueueLength),
+		done:        make(chan struct{}),
+		stopped:     make(chan struct{}),
+	}
+
+	if !exporter.CompareAndSwap(nil, e) {
+		return fmt.Errorf("OTLP exporter already started")
+	}
+
+	go e.run()
+
+	e.log.Info("Exporting spans", "url", url)
+
+	return nil
+}
+
+// ShutdownExporter exports any queued spans and stops the exporter
+func ShutdownExporter(ctx context.Context) error {
+	e := exporter.Swap(nil)
+	if e == nil {
+		return nil
+	}
+
+	close(e.done)
+
+	select {
+	case <-e.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (e *otlpExporter) enqueue(s *Span) {
+	select {
+	case e.spans <- s:
+	default:
+		// The collector is not keeping up; drop the span
+	}
+}
+
+func (e *otlpExporter) run() {
+	defer close(e.stopped)
+
+	ticker := time.NewTicker(exportInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Span, 0, exportBatchSize)
+
+	for {
+		select {
+		case s := <-e.spans:
+			batch = append(batch, s)
+			if len(batch) < exportBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		case <-e.done:
+			for len(e.spans) != 0 {
+				batch = append(batch, <-e.spans)
+			}
+
+			e.export(batch)
+			return
+		}
+
+		e.export(batch)
+		batch = batch[:0]
+	}
+}
+
+func (e *otlpExporter) export(batch []*Span) {
+	if len(batch) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(e.encode(batch))
+	if err != nil {
+		e.log.Error(err, "Failed to encode spans")
+		return
+	}
+
+	rsp, err := e.client.Post(e.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		e.log.Error(err, "Failed to export spans", "count", len(batch))
+		return
+	}
+
+	rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		e.log.Error(fmt.Errorf("status %d", rsp.StatusCode), "Collector rejected spans", "count", len(batch))
+	}
+}
+
+// OTLP/JSON encoding of the trace service export request
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceId           string         `json:"traceId"`
+	SpanId            string         `json:"spanId"`
+	ParentSpanId      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpScopeSpans struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpResourceSpans struct {
+	Resource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	} `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpExportRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+const (
+	otlpStatusOk    = 1
+	otlpStatusError = 2
+)
+
+func (e *otlpExporter) encode(batch []*Span) *otlpExportRequest {
+	scope := otlpScopeSpans{Spans: make([]otlpSpan, len(batch))}
+	scope.Scope.Name = "github.com/NearNodeFlash/nnf-ec"
+
+	for idx, s := range batch {
+		span := &scope.Spans[idx]
+
+		span.TraceId = hex.EncodeToString(s.traceId[:])
+		span.SpanId = hex.EncodeToString(s.spanId[:])
+		if s.parentId != [8]byte{} {
+			span.ParentSpanId = hex.EncodeToString(s.parentId[:])
+		}
+
+		span.Name = s.name
+		span.Kind = int(s.kind)
+		span.StartTimeUnixNano = strconv.FormatInt(s.start.UnixNano(), 10)
+		span.EndTimeUnixNano = strconv.FormatInt(s.end.UnixNano(), 10)
+
+		for key, value := range s.attributes {
+			span.Attributes = append(span.Attributes, otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: value}})
+		}
+
+		span.Status.Code = otlpStatusOk
+		if s.err != nil {
+			span.Status.Code = otlpStatusError
+			span.Status.Message = s.err.Error()
+		}
+	}
+
+	resource := otlpResourceSpans{ScopeSpans: []otlpScopeSpans{scope}}
+	resource.Resource.Attributes = []otlpKeyValue{{Key: "service.name", Value: otlpAnyValue{StringValue: e.serviceName}}}
+
+	return &otlpExportRequest{ResourceSpans: []otlpResourceSpans{resource}}
+}
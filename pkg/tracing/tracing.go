@@ -0,0 +1,142 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package tracing ties together the records of a request as it moves through the element controller.
+// Every request is assigned a request ID, carried in the request context, which is attached to the log
+// lines, command records and spans produced while the request is serviced.
+//
+// The request context is passed from the HTTP handlers through the managers to the device and shell
+// commands that service the request. Work not started by a request, such as the monitors and event
+// handlers, runs with the background context and is recorded without a request ID.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RequestIdHeader is the HTTP header carrying the request ID
+const RequestIdHeader = "X-Request-Id"
+
+const maxRequestIdLength = 128
+
+type requestIdKey struct{}
+type spanKey struct{}
+
+// NewRequestId returns a new, unique request ID
+func NewRequestId() string {
+	return uuid.NewString()
+}
+
+// ValidRequestId returns true if the request ID supplied by a client can be used as is; that is, it is
+// of reasonable length and consists of characters safe to record in logs.
+func ValidRequestId(id string) bool {
+	if len(id) == 0 || len(id) > maxRequestIdLength {
+		return false
+	}
+
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':', c == '/':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// WithRequestId returns a copy of the context carrying the request ID
+func WithRequestId(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIdKey{}, id)
+}
+
+// RequestId returns the request ID carried by the context, or an empty string if there is none
+func RequestId(ctx context.Context) string {
+	id, _ := ctx.Value(requestIdKey{}).(string)
+	return id
+}
+
+// Spans
+
+// SpanKind - The role of a span in a trace, as defined by OpenTelemetry
+type SpanKind int
+
+const (
+	SpanKindInternal SpanKind = 1
+	SpanKindServer   SpanKind = 2
+	SpanKindClient   SpanKind = 3
+)
+
+// Span records a timed operation within a trace. Spans are exported only when an exporter is running.
+type Span struct {
+	traceId  [16]byte
+	spanId   [8]byte
+	parentId [8]byte
+
+	name       string
+	kind       SpanKind
+	start      time.Time
+	end        time.Time
+	attributes map[string]string
+	err        error
+}
+
+// StartSpan starts a span as a child of the span carried by the context, if any, returning a copy of
+// the context carrying the new span. The span is attributed with the request ID of the context.
+func StartSpan(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	s := &Span{name: name, kind: kind, start: time.Now(), attributes: make(map[string]string)}
+
+	if parent, ok := ctx.Value(spanKey{}).(*Span); ok {
+		s.traceId = parent.traceId
+		s.parentId = parent.spanId
+	} else {
+		rand.Read(s.traceId[:])
+	}
+
+	rand.Read(s.spanId[:])
+
+	if id := RequestId(ctx); len(id) != 0 {
+		s.SetAttribute("request.id", id)
+	}
+
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+// SetAttribute records an attribute of the span
+func (s *Span) SetAttribute(key, value string) {
+	s.attributes[key] = value
+}
+
+// TraceId returns the ID of the trace the span belongs to
+func (s *Span) TraceId() string { return hex.EncodeToString(s.traceId[:]) }
+
+// End completes the span, recording the error if the operation failed, and queues it for export
+func (s *Span) End(err error) {
+	s.end = time.Now()
+	s.err = err
+
+	if e := exporter.Load(); e != nil {
+		e.enqueue(s)
+	}
+}
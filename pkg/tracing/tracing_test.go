@@ -0,0 +1,109 @@
+/*
+ * Copyright 2026 Hewlett Packard Enterprise Development LP
+ * Other additional copyright holders may be indicated within.
+ *
+ * The entirety of this work is licensed under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ *
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+)
+
+func TestValidRequestId(t *testing.T) {
+	for id, valid := range map[string]bool{
+		"":                                     false,
+		"b0b5c7e2-1f3a-4b7e-9c1d-2a8f6e0d4c3b": true,
+		"job:42/pool.create_1":                 true,
+		"has space":                            false,
+		"new\nline":                            false,
+		strings.Repeat("x", 129):               false,
+	} {
+		if ValidRequestId(id) != valid {
+			t.Errorf("ValidRequestId(%q): Expected: %t", id, valid)
+		}
+	}
+}
+
+func TestLogger(t *testing.T) {
+	lines := make([]string, 0)
+	log := funcr.New(func(prefix, args string) { lines = append(lines, args) }, funcr.Options{}).WithName("test")
+
+	Logger(context.Background(), log).Info("no request")
+	Logger(WithRequestId(context.Background(), "1234"), log).WithValues("key", "value").Info("request")
+
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 log lines: %v", lines)
+	}
+
+	if strings.Contains(lines[0], "requestId") {
+		t.Errorf("Log line without a request has a request ID: %s", lines[0])
+	}
+
+	if !strings.Contains(lines[1], `"requestId"="1234"`) || !strings.Contains(lines[1], `"key"="value"`) {
+		t.Errorf("Log line lacks the request ID: %s", lines[1])
+	}
+}
+
+func TestExporter(t *testing.T) {
+	requests := make(chan otlpExportRequest, 1)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			t.Errorf("Export path: Expected: /v1/traces Actual: %s", r.URL.Path)
+		}
+
+		req := otlpExportRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode export request: %v", err)
+		}
+
+		requests <- req
+	}))
+	defer collector.Close()
+
+	if err := StartExporter(collector.URL, "test", funcr.New(func(prefix, args string) {}, funcr.Options{})); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, parent := StartSpan(WithRequestId(context.Background(), "1234"), "parent", SpanKindServer)
+	_, child := StartSpan(ctx, "child", SpanKindClient)
+	child.End(nil)
+	parent.End(nil)
+
+	if err := ShutdownExporter(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	req := <-requests
+	spans := req.ResourceSpans[0].ScopeSpans[0].Spans
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans: %+v", spans)
+	}
+
+	if spans[0].TraceId != parent.TraceId() || spans[0].ParentSpanId != spans[1].SpanId {
+		t.Errorf("Child span not parented to the request span: %+v", spans)
+	}
+
+	if spans[1].Attributes[0].Value.StringValue != "1234" {
+		t.Errorf("Span lacks the request ID: %+v", spans[1])
+	}
+}